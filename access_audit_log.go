@@ -39,6 +39,16 @@ type AccessAuditLogFilterOptions struct {
 	Since     *time.Time
 	Until     *time.Time
 	Limit     int
+
+	// Email and AppUID restrict the results to a single user or Access
+	// application.
+	Email  string
+	AppUID string
+
+	// Page and PerPage select a single page of results. When both are unset
+	// ListAccessAuditLogs fetches every page.
+	Page    int
+	PerPage int
 }
 
 // AccessAuditLogs retrieves all audit logs for the Access service.
@@ -61,6 +71,56 @@ func (api *API) AccessAuditLogs(ctx context.Context, accountID string, opts Acce
 	return accessAuditLogListResponse.Result, nil
 }
 
+// ListAccessAuditLogs retrieves the authentication logs for the Access
+// service, fetching all pages unless a specific page is requested.
+//
+// API reference: https://developers.cloudflare.com/api/operations/access-authentication-logs-get-access-authentication-logs
+func (api *API) ListAccessAuditLogs(ctx context.Context, rc *ResourceContainer, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccessAuditLogRecord{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	autoPaginate := true
+	if opts.PerPage >= 1 || opts.Page >= 1 {
+		autoPaginate = false
+	}
+
+	if opts.PerPage < 1 {
+		opts.PerPage = 25
+	}
+
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+
+	var records []AccessAuditLogRecord
+	var r AccessAuditLogListResponse
+	for {
+		uri := fmt.Sprintf("/%s/%s/access/logs/access-requests?%s", rc.Level, rc.Identifier, opts.Encode())
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AccessAuditLogRecord{}, &ResultInfo{}, fmt.Errorf("%s: %w", errMakeRequestError, err)
+		}
+
+		r = AccessAuditLogListResponse{}
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AccessAuditLogRecord{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		records = append(records, r.Result...)
+
+		next := r.ResultInfo.Next()
+		if !autoPaginate || len(r.Result) == 0 || next.Done() {
+			break
+		}
+		opts.Page = next.Page
+	}
+
+	return records, &r.ResultInfo, nil
+}
+
 // Encode is a custom method for encoding the filter options into a usable HTTP
 // query parameter string.
 func (a AccessAuditLogFilterOptions) Encode() string {
@@ -82,5 +142,21 @@ func (a AccessAuditLogFilterOptions) Encode() string {
 		v.Set("until", a.Until.Format(time.RFC3339))
 	}
 
+	if a.Email != "" {
+		v.Set("email", a.Email)
+	}
+
+	if a.AppUID != "" {
+		v.Set("app_uid", a.AppUID)
+	}
+
+	if a.Page > 0 {
+		v.Set("page", strconv.Itoa(a.Page))
+	}
+
+	if a.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(a.PerPage))
+	}
+
 	return v.Encode()
 }
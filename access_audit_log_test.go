@@ -91,3 +91,56 @@ func TestAccessAuditLogsEncodeEmptyValues(t *testing.T) {
 
 	assert.Equal(t, "", opts.Encode())
 }
+
+func TestListAccessAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "michelle@example.com", r.URL.Query().Get("email"))
+		assert.Equal(t, "df7e2w5f-02b7-4d9d-af26-8d1988fca630", r.URL.Query().Get("app_uid"))
+
+		w.Header().Set("content-type", "application/json")
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "user_email": "michelle@example.com",
+      "app_uid": "df7e2w5f-02b7-4d9d-af26-8d1988fca630",
+      "action": "login",
+      "allowed": true,
+      "ray_id": "ray-%s"
+    }
+  ],
+  "result_info": { "page": %s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2 }
+}`, page, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/logs/access-requests", handler)
+
+	opts := AccessAuditLogFilterOptions{
+		Email:  "michelle@example.com",
+		AppUID: "df7e2w5f-02b7-4d9d-af26-8d1988fca630",
+	}
+
+	actual, _, err := client.ListAccessAuditLogs(context.Background(), AccountIdentifier(testAccountID), opts)
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 2)
+		assert.Equal(t, "ray-1", actual[0].RayID)
+		assert.Equal(t, "ray-2", actual[1].RayID)
+	}
+
+	opts.Page = 2
+	actual, resultInfo, err := client.ListAccessAuditLogs(context.Background(), AccountIdentifier(testAccountID), opts)
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 1)
+		assert.Equal(t, 2, resultInfo.Page)
+	}
+
+	_, _, err = client.ListAccessAuditLogs(context.Background(), ZoneIdentifier(testZoneID), opts)
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+}
@@ -0,0 +1,327 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrAccessRulesMissingInclude = errors.New("access rules require at least one include rule")
+)
+
+// AccessRules holds the include, exclude and require rule blocks shared by
+// Access groups and policies.
+type AccessRules struct {
+	Include []interface{}
+	Exclude []interface{}
+	Require []interface{}
+}
+
+// AccessRuleBuilder constructs the rule blocks of an Access group or policy
+// and validates the combination of rules when built.
+//
+//	rules, err := cloudflare.NewAccessRuleBuilder().
+//		Include(cloudflare.AccessEmailDomainRule("example.com")).
+//		Require(cloudflare.AccessGeoRule("US")).
+//		Exclude(cloudflare.AccessEmailRule("contractor@example.com")).
+//		Build()
+type AccessRuleBuilder struct {
+	rules AccessRules
+}
+
+// NewAccessRuleBuilder returns an empty AccessRuleBuilder.
+func NewAccessRuleBuilder() *AccessRuleBuilder {
+	return &AccessRuleBuilder{}
+}
+
+// Include adds rules of which the user must satisfy at least one.
+func (b *AccessRuleBuilder) Include(rules ...interface{}) *AccessRuleBuilder {
+	b.rules.Include = append(b.rules.Include, rules...)
+	return b
+}
+
+// Exclude adds rules of which the user must satisfy none.
+func (b *AccessRuleBuilder) Exclude(rules ...interface{}) *AccessRuleBuilder {
+	b.rules.Exclude = append(b.rules.Exclude, rules...)
+	return b
+}
+
+// Require adds rules of which the user must satisfy all.
+func (b *AccessRuleBuilder) Require(rules ...interface{}) *AccessRuleBuilder {
+	b.rules.Require = append(b.rules.Require, rules...)
+	return b
+}
+
+// Build validates the rules and returns them. An error describing the first
+// problem found is returned when no include rule is present, when a rule
+// value is malformed or when rules contradict each other, such as excluding
+// everyone or both including and excluding the same rule.
+func (b *AccessRuleBuilder) Build() (AccessRules, error) {
+	if len(b.rules.Include) == 0 {
+		return AccessRules{}, ErrAccessRulesMissingInclude
+	}
+
+	for _, block := range []struct {
+		name  string
+		rules []interface{}
+	}{
+		{"include", b.rules.Include},
+		{"exclude", b.rules.Exclude},
+		{"require", b.rules.Require},
+	} {
+		for _, r := range block.rules {
+			if err := validateAccessRule(r); err != nil {
+				return AccessRules{}, fmt.Errorf("%s: %w", block.name, err)
+			}
+		}
+
+		if containsAccessRuleType(block.rules, AccessGroupAnyValidServiceToken{}) && containsAccessRuleType(block.rules, AccessGroupServiceToken{}) {
+			return AccessRules{}, fmt.Errorf("%s: any_valid_service_token makes individual service_token rules redundant", block.name)
+		}
+	}
+
+	if containsAccessRuleType(b.rules.Include, AccessGroupEveryone{}) && len(b.rules.Include) > 1 {
+		return AccessRules{}, errors.New("include: everyone cannot be combined with other include rules")
+	}
+
+	if containsAccessRuleType(b.rules.Exclude, AccessGroupEveryone{}) {
+		return AccessRules{}, errors.New("exclude: excluding everyone denies all access")
+	}
+
+	if containsAccessRuleType(b.rules.Require, AccessGroupEveryone{}) {
+		return AccessRules{}, errors.New("require: requiring everyone has no effect")
+	}
+
+	for _, r := range b.rules.Exclude {
+		for _, i := range append(append([]interface{}{}, b.rules.Include...), b.rules.Require...) {
+			if reflect.DeepEqual(r, i) {
+				return AccessRules{}, fmt.Errorf("exclude: rule %+v is also included or required", r)
+			}
+		}
+	}
+
+	return b.rules, nil
+}
+
+func containsAccessRuleType(rules []interface{}, rule interface{}) bool {
+	t := reflect.TypeOf(rule)
+	for _, r := range rules {
+		if reflect.TypeOf(r) == t {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAccessRule(rule interface{}) error {
+	switch r := rule.(type) {
+	case AccessGroupEmail:
+		if !strings.Contains(r.Email.Email, "@") {
+			return fmt.Errorf("invalid email %q", r.Email.Email)
+		}
+	case AccessGroupEmailDomain:
+		if r.EmailDomain.Domain == "" || strings.Contains(r.EmailDomain.Domain, "@") {
+			return fmt.Errorf("invalid email domain %q", r.EmailDomain.Domain)
+		}
+	case AccessGroupIP:
+		if net.ParseIP(r.IP.IP) == nil {
+			if _, _, err := net.ParseCIDR(r.IP.IP); err != nil {
+				return fmt.Errorf("invalid IP or CIDR %q", r.IP.IP)
+			}
+		}
+	case AccessGroupGeo:
+		if len(r.Geo.CountryCode) != 2 {
+			return fmt.Errorf("invalid country code %q", r.Geo.CountryCode)
+		}
+	case AccessGroupServiceToken:
+		if r.ServiceToken.ID == "" {
+			return ErrMissingServiceTokenUUID
+		}
+	case AccessGroupAccessGroup:
+		if r.Group.ID == "" {
+			return errors.New("missing access group ID")
+		}
+	case AccessGroupAzure:
+		if r.AzureAD.ID == "" || r.AzureAD.IdentityProviderID == "" {
+			return errors.New("azureAD rules require a group ID and identity provider ID")
+		}
+	case AccessGroupOkta:
+		if r.Okta.Name == "" || r.Okta.IdentityProviderID == "" {
+			return errors.New("okta rules require a group name and identity provider ID")
+		}
+	case AccessGroupGSuite:
+		if r.Gsuite.Email == "" || r.Gsuite.IdentityProviderID == "" {
+			return errors.New("gsuite rules require a group email and identity provider ID")
+		}
+	case AccessGroupGitHub:
+		if r.GitHubOrganization.Name == "" || r.GitHubOrganization.IdentityProviderID == "" {
+			return errors.New("github-organization rules require an organization name and identity provider ID")
+		}
+	case AccessGroupSAML:
+		if r.Saml.AttributeName == "" || r.Saml.IdentityProviderID == "" {
+			return errors.New("saml rules require an attribute name and identity provider ID")
+		}
+	case AccessGroupDevicePosture:
+		if r.DevicePosture.ID == "" {
+			return errors.New("missing device posture integration UID")
+		}
+	case AccessGroupAuthMethod:
+		if r.AuthMethod.AuthMethod == "" {
+			return errors.New("missing auth method")
+		}
+	case AccessGroupEmailList, AccessGroupIPList, AccessGroupEveryone, AccessGroupAnyValidServiceToken,
+		AccessGroupCertificate, AccessGroupCertificateCommonName, AccessGroupExternalEvaluation,
+		AccessGroupAzureAuthContext, AccessGroupLoginMethod:
+	default:
+		return fmt.Errorf("unsupported rule type %T", rule)
+	}
+
+	return nil
+}
+
+// AccessEmailRule matches a single email address.
+func AccessEmailRule(email string) AccessGroupEmail {
+	var r AccessGroupEmail
+	r.Email.Email = email
+	return r
+}
+
+// AccessEmailListRule matches the addresses in a Zero Trust list.
+func AccessEmailListRule(listID string) AccessGroupEmailList {
+	var r AccessGroupEmailList
+	r.EmailList.ID = listID
+	return r
+}
+
+// AccessEmailDomainRule matches every address of an email domain.
+func AccessEmailDomainRule(domain string) AccessGroupEmailDomain {
+	var r AccessGroupEmailDomain
+	r.EmailDomain.Domain = domain
+	return r
+}
+
+// AccessIPRule matches an IP address or CIDR range.
+func AccessIPRule(ip string) AccessGroupIP {
+	var r AccessGroupIP
+	r.IP.IP = ip
+	return r
+}
+
+// AccessIPListRule matches the IP ranges in a Zero Trust list.
+func AccessIPListRule(listID string) AccessGroupIPList {
+	var r AccessGroupIPList
+	r.IPList.ID = listID
+	return r
+}
+
+// AccessGeoRule matches requests from a country by ISO 3166-1 alpha-2 code.
+func AccessGeoRule(countryCode string) AccessGroupGeo {
+	var r AccessGroupGeo
+	r.Geo.CountryCode = countryCode
+	return r
+}
+
+// AccessEveryoneRule matches everyone.
+func AccessEveryoneRule() AccessGroupEveryone {
+	return AccessGroupEveryone{}
+}
+
+// AccessServiceTokenRule matches a single service token.
+func AccessServiceTokenRule(tokenID string) AccessGroupServiceToken {
+	var r AccessGroupServiceToken
+	r.ServiceToken.ID = tokenID
+	return r
+}
+
+// AccessAnyValidServiceTokenRule matches any valid service token.
+func AccessAnyValidServiceTokenRule() AccessGroupAnyValidServiceToken {
+	return AccessGroupAnyValidServiceToken{}
+}
+
+// AccessGroupRule matches members of another Access group.
+func AccessGroupRule(groupID string) AccessGroupAccessGroup {
+	var r AccessGroupAccessGroup
+	r.Group.ID = groupID
+	return r
+}
+
+// AccessCertificateRule matches any valid mTLS client certificate.
+func AccessCertificateRule() AccessGroupCertificate {
+	return AccessGroupCertificate{}
+}
+
+// AccessCommonNameRule matches an mTLS client certificate common name.
+func AccessCommonNameRule(commonName string) AccessGroupCertificateCommonName {
+	var r AccessGroupCertificateCommonName
+	r.CommonName.CommonName = commonName
+	return r
+}
+
+// AccessAzureGroupRule matches members of an Azure AD group.
+func AccessAzureGroupRule(groupID, identityProviderID string) AccessGroupAzure {
+	var r AccessGroupAzure
+	r.AzureAD.ID = groupID
+	r.AzureAD.IdentityProviderID = identityProviderID
+	return r
+}
+
+// AccessOktaGroupRule matches members of an Okta group.
+func AccessOktaGroupRule(name, identityProviderID string) AccessGroupOkta {
+	var r AccessGroupOkta
+	r.Okta.Name = name
+	r.Okta.IdentityProviderID = identityProviderID
+	return r
+}
+
+// AccessGSuiteGroupRule matches members of a Google Workspace group.
+func AccessGSuiteGroupRule(email, identityProviderID string) AccessGroupGSuite {
+	var r AccessGroupGSuite
+	r.Gsuite.Email = email
+	r.Gsuite.IdentityProviderID = identityProviderID
+	return r
+}
+
+// AccessGitHubOrganizationRule matches members of a GitHub organization and,
+// optionally, a team within it.
+func AccessGitHubOrganizationRule(name, team, identityProviderID string) AccessGroupGitHub {
+	var r AccessGroupGitHub
+	r.GitHubOrganization.Name = name
+	r.GitHubOrganization.Team = team
+	r.GitHubOrganization.IdentityProviderID = identityProviderID
+	return r
+}
+
+// AccessSAMLRule matches SAML users with an attribute value.
+func AccessSAMLRule(attributeName, attributeValue, identityProviderID string) AccessGroupSAML {
+	var r AccessGroupSAML
+	r.Saml.AttributeName = attributeName
+	r.Saml.AttributeValue = attributeValue
+	r.Saml.IdentityProviderID = identityProviderID
+	return r
+}
+
+// AccessDevicePostureRule matches devices passing a device posture rule.
+func AccessDevicePostureRule(integrationUID string) AccessGroupDevicePosture {
+	var r AccessGroupDevicePosture
+	r.DevicePosture.ID = integrationUID
+	return r
+}
+
+// AccessAuthMethodRule matches users who authenticated with an "amr" method
+// such as "mfa" or "hwk".
+func AccessAuthMethodRule(method string) AccessGroupAuthMethod {
+	var r AccessGroupAuthMethod
+	r.AuthMethod.AuthMethod = method
+	return r
+}
+
+// AccessLoginMethodRule matches users who logged in with an identity
+// provider.
+func AccessLoginMethodRule(identityProviderID string) AccessGroupLoginMethod {
+	var r AccessGroupLoginMethod
+	r.LoginMethod.ID = identityProviderID
+	return r
+}
@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRuleBuilder(t *testing.T) {
+	rules, err := NewAccessRuleBuilder().
+		Include(AccessEmailDomainRule("example.com"), AccessOktaGroupRule("engineering", "ea85612a-29c8-46c2-bacb-669d65136971")).
+		Require(AccessGeoRule("US"), AccessAuthMethodRule("mfa"), AccessDevicePostureRule("e6e4ea3b-2a4e-4d7f-bd86-93b5ac0ff2d3")).
+		Exclude(AccessEmailRule("contractor@example.com"), AccessIPRule("192.0.2.0/24")).
+		Build()
+	require.NoError(t, err)
+
+	group := CreateAccessGroupParams{Name: "engineers", Include: rules.Include, Exclude: rules.Exclude, Require: rules.Require}
+	b, err := json.Marshal(group)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"name": "engineers",
+		"include": [
+			{"email_domain": {"domain": "example.com"}},
+			{"okta": {"name": "engineering", "identity_provider_id": "ea85612a-29c8-46c2-bacb-669d65136971"}}
+		],
+		"exclude": [
+			{"email": {"email": "contractor@example.com"}},
+			{"ip": {"ip": "192.0.2.0/24"}}
+		],
+		"require": [
+			{"geo": {"country_code": "US"}},
+			{"auth_method": {"auth_method": "mfa"}},
+			{"device_posture": {"integration_uid": "e6e4ea3b-2a4e-4d7f-bd86-93b5ac0ff2d3"}}
+		]
+	}`, string(b))
+}
+
+func TestAccessRuleBuilder_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		builder *AccessRuleBuilder
+		err     string
+	}{
+		"missing include": {
+			builder: NewAccessRuleBuilder().Require(AccessGeoRule("US")),
+			err:     ErrAccessRulesMissingInclude.Error(),
+		},
+		"invalid ip": {
+			builder: NewAccessRuleBuilder().Include(AccessIPRule("192.0.2.0/33")),
+			err:     `include: invalid IP or CIDR "192.0.2.0/33"`,
+		},
+		"invalid country": {
+			builder: NewAccessRuleBuilder().Include(AccessEveryoneRule()).Exclude(AccessGeoRule("USA")),
+			err:     `exclude: invalid country code "USA"`,
+		},
+		"everyone with other includes": {
+			builder: NewAccessRuleBuilder().Include(AccessEveryoneRule(), AccessEmailRule("a@example.com")),
+			err:     "include: everyone cannot be combined with other include rules",
+		},
+		"exclude everyone": {
+			builder: NewAccessRuleBuilder().Include(AccessEmailRule("a@example.com")).Exclude(AccessEveryoneRule()),
+			err:     "exclude: excluding everyone denies all access",
+		},
+		"redundant service tokens": {
+			builder: NewAccessRuleBuilder().Include(AccessAnyValidServiceTokenRule(), AccessServiceTokenRule("a4a45cb5-1c69-4b55-a1ae-d1bbde0d5fc2")),
+			err:     "include: any_valid_service_token makes individual service_token rules redundant",
+		},
+		"include and exclude same rule": {
+			builder: NewAccessRuleBuilder().Include(AccessEmailRule("a@example.com")).Exclude(AccessEmailRule("a@example.com")),
+			err:     "exclude: rule {Email:{Email:a@example.com}} is also included or required",
+		},
+		"unsupported rule": {
+			builder: NewAccessRuleBuilder().Include(map[string]string{"email": "a@example.com"}),
+			err:     "include: unsupported rule type map[string]string",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -180,3 +180,42 @@ func TestUpdateAccessUsersSeats(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestGetAccessSeatUsageReport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	recent := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "1", "email": "active@example.com", "access_seat": true, "gateway_seat": true, "last_successful_login": "%s"},
+				{"id": "2", "email": "stale@example.com", "access_seat": true, "gateway_seat": false, "last_successful_login": "2020-01-01T00:00:00Z"},
+				{"id": "3", "email": "never@example.com", "access_seat": false, "gateway_seat": true, "last_successful_login": ""},
+				{"id": "4", "email": "seatless@example.com", "access_seat": false, "gateway_seat": false, "last_successful_login": "2020-01-01T00:00:00Z"}
+			],
+			"result_info": {"page": 1, "per_page": 25, "count": 4, "total_count": 4, "total_pages": 1}
+		}`, recent)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/users", handler)
+
+	report, err := client.GetAccessSeatUsageReport(context.Background(), AccountIdentifier(testAccountID), AccessSeatUsageReportParams{
+		StaleAfter:      30 * 24 * time.Hour,
+		AccessSeatLimit: 50,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, report.AccessSeatsUsed)
+		assert.Equal(t, 2, report.GatewaySeatsUsed)
+		assert.Equal(t, 50, report.AccessSeatLimit)
+		if assert.Len(t, report.StaleUsers, 2) {
+			assert.Equal(t, "stale@example.com", report.StaleUsers[0].Email)
+			assert.Equal(t, "never@example.com", report.StaleUsers[1].Email)
+		}
+	}
+}
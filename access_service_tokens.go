@@ -64,6 +64,23 @@ type AccessServiceTokenCreateResponse struct {
 	Duration     string     `json:"duration,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the client secret.
+func (a AccessServiceTokenCreateResponse) String() string {
+	return redactedString(a.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the client secret.
+func (a AccessServiceTokenCreateResponse) GoString() string {
+	return redactedGoString("AccessServiceTokenCreateResponse", a.redacted())
+}
+
+func (a AccessServiceTokenCreateResponse) redacted() interface{} {
+	type redacted AccessServiceTokenCreateResponse
+	r := redacted(a)
+	r.ClientSecret = redact(r.ClientSecret)
+	return r
+}
+
 // AccessServiceTokenRotateResponse is the same API response as the Create
 // operation.
 type AccessServiceTokenRotateResponse struct {
@@ -77,6 +94,23 @@ type AccessServiceTokenRotateResponse struct {
 	Duration     string     `json:"duration,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the client secret.
+func (a AccessServiceTokenRotateResponse) String() string {
+	return redactedString(a.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the client secret.
+func (a AccessServiceTokenRotateResponse) GoString() string {
+	return redactedGoString("AccessServiceTokenRotateResponse", a.redacted())
+}
+
+func (a AccessServiceTokenRotateResponse) redacted() interface{} {
+	type redacted AccessServiceTokenRotateResponse
+	r := redacted(a)
+	r.ClientSecret = redact(r.ClientSecret)
+	return r
+}
+
 // AccessServiceTokensListResponse represents the response from the list
 // Access Service Tokens endpoint.
 type AccessServiceTokensListResponse struct {
@@ -143,6 +177,10 @@ type UpdateAccessServiceTokenParams struct {
 	Duration string `json:"duration,omitempty"`
 }
 
+// ListAccessServiceTokens returns all Access Service Tokens for an account or
+// zone.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-list-access-service-tokens
 func (api *API) ListAccessServiceTokens(ctx context.Context, rc *ResourceContainer, params ListAccessServiceTokensParams) ([]AccessServiceToken, ResultInfo, error) {
 	uri := fmt.Sprintf("/%s/%s/access/service_tokens", rc.Level, rc.Identifier)
 
@@ -160,6 +198,37 @@ func (api *API) ListAccessServiceTokens(ctx context.Context, rc *ResourceContain
 	return accessServiceTokensListResponse.Result, accessServiceTokensListResponse.ResultInfo, nil
 }
 
+// GetAccessServiceToken returns a single Access Service Token. The client
+// secret is never included and is only available from
+// CreateAccessServiceToken or RotateAccessServiceToken.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-get-a-service-token
+func (api *API) GetAccessServiceToken(ctx context.Context, rc *ResourceContainer, id string) (AccessServiceToken, error) {
+	if id == "" {
+		return AccessServiceToken{}, ErrMissingServiceTokenUUID
+	}
+
+	uri := fmt.Sprintf("/%s/%s/access/service_tokens/%s", rc.Level, rc.Identifier, id)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AccessServiceToken{}, err
+	}
+
+	var accessServiceTokenDetail AccessServiceTokensDetailResponse
+	err = json.Unmarshal(res, &accessServiceTokenDetail)
+	if err != nil {
+		return AccessServiceToken{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return accessServiceTokenDetail.Result, nil
+}
+
+// CreateAccessServiceToken creates a new Access Service Token. The returned
+// `ClientSecret` is only ever available in this response and must be stored
+// by the caller.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-create-a-service-token
 func (api *API) CreateAccessServiceToken(ctx context.Context, rc *ResourceContainer, params CreateAccessServiceTokenParams) (AccessServiceTokenCreateResponse, error) {
 	uri := fmt.Sprintf("/%s/%s/access/service_tokens", rc.Level, rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
@@ -177,6 +246,10 @@ func (api *API) CreateAccessServiceToken(ctx context.Context, rc *ResourceContai
 	return accessServiceTokenCreation.Result, nil
 }
 
+// UpdateAccessServiceToken updates the name or duration of an Access Service
+// Token.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-update-a-service-token
 func (api *API) UpdateAccessServiceToken(ctx context.Context, rc *ResourceContainer, params UpdateAccessServiceTokenParams) (AccessServiceTokenUpdateResponse, error) {
 	if params.UUID == "" {
 		return AccessServiceTokenUpdateResponse{}, ErrMissingServiceTokenUUID
@@ -198,7 +271,14 @@ func (api *API) UpdateAccessServiceToken(ctx context.Context, rc *ResourceContai
 	return accessServiceTokenUpdate.Result, nil
 }
 
+// DeleteAccessServiceToken removes an Access Service Token.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-delete-a-service-token
 func (api *API) DeleteAccessServiceToken(ctx context.Context, rc *ResourceContainer, uuid string) (AccessServiceTokenUpdateResponse, error) {
+	if uuid == "" {
+		return AccessServiceTokenUpdateResponse{}, ErrMissingServiceTokenUUID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/service_tokens/%s", rc.Level, rc.Identifier, uuid)
 
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
@@ -220,6 +300,10 @@ func (api *API) DeleteAccessServiceToken(ctx context.Context, rc *ResourceContai
 //
 // API reference: https://api.cloudflare.com/#access-service-tokens-refresh-a-service-token
 func (api *API) RefreshAccessServiceToken(ctx context.Context, rc *ResourceContainer, id string) (AccessServiceTokenRefreshResponse, error) {
+	if id == "" {
+		return AccessServiceTokenRefreshResponse{}, ErrMissingServiceTokenUUID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/service_tokens/%s/refresh", rc.Level, rc.Identifier, id)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
@@ -237,9 +321,15 @@ func (api *API) RefreshAccessServiceToken(ctx context.Context, rc *ResourceConta
 }
 
 // RotateAccessServiceToken rotates the client secret of an Access Service
-// Token in place.
+// Token in place. The new `ClientSecret` is only ever available in this
+// response and the previous secret stops working immediately.
+//
 // API reference: https://api.cloudflare.com/#access-service-tokens-rotate-a-service-token
 func (api *API) RotateAccessServiceToken(ctx context.Context, rc *ResourceContainer, id string) (AccessServiceTokenRotateResponse, error) {
+	if id == "" {
+		return AccessServiceTokenRotateResponse{}, ErrMissingServiceTokenUUID
+	}
+
 	uri := fmt.Sprintf("/%s/%s/access/service_tokens/%s/rotate", rc.Level, rc.Identifier, id)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
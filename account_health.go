@@ -0,0 +1,209 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AccountHealthSnapshot summarises the health of an account's tunnels, load
+// balancer pools, Logpush jobs and edge certificates at a point in time.
+type AccountHealthSnapshot struct {
+	TakenAt      time.Time
+	Tunnels      []TunnelHealth
+	Pools        []LoadBalancerPoolHealthSummary
+	LogpushJobs  []LogpushJobHealth
+	Certificates []CertificateExpiry
+
+	// Errors holds the error of each part of the snapshot that could not
+	// be gathered, keyed by "tunnels", "load_balancer_pools",
+	// "logpush_jobs" or "certificates/<zone ID>". The other parts are still
+	// populated.
+	Errors map[string]error
+}
+
+// TunnelHealth is the status of a Cloudflare Tunnel, one of "healthy",
+// "degraded", "down" or "inactive".
+type TunnelHealth struct {
+	ID          string
+	Name        string
+	Status      string
+	Connections int
+}
+
+// LoadBalancerPoolHealthSummary is the health of a load balancer pool.
+// Healthy is nil if the pool has not been health checked yet.
+type LoadBalancerPoolHealthSummary struct {
+	ID      string
+	Name    string
+	Enabled bool
+	Healthy *bool
+}
+
+// LogpushJobHealth is the delivery status of a Logpush job. A job is
+// failing when its last error is more recent than its last successful push.
+type LogpushJobHealth struct {
+	ID           int
+	Name         string
+	Dataset      string
+	Enabled      bool
+	LastComplete *time.Time
+	LastError    *time.Time
+	ErrorMessage string
+	Failing      bool
+}
+
+// CertificateExpiry is the expiry of a certificate of a certificate pack.
+type CertificateExpiry struct {
+	ZoneID    string
+	PackID    string
+	Hosts     []string
+	ExpiresOn time.Time
+}
+
+// AccountHealthSnapshotParams configures what GetAccountHealthSnapshot
+// gathers.
+type AccountHealthSnapshotParams struct {
+	// ZoneIDs are the zones whose certificate packs are checked for expiry.
+	ZoneIDs []string
+}
+
+// GetAccountHealthSnapshot gathers the health of an account's tunnels, load
+// balancer pools and Logpush jobs, and the expiry of the certificates of the
+// given zones, concurrently. Failures to gather a part are recorded in the
+// snapshot's Errors rather than failing the whole snapshot.
+func (api *API) GetAccountHealthSnapshot(ctx context.Context, rc *ResourceContainer, params AccountHealthSnapshotParams) (AccountHealthSnapshot, error) {
+	if rc.Level != AccountRouteLevel {
+		return AccountHealthSnapshot{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AccountHealthSnapshot{}, ErrMissingAccountID
+	}
+
+	snapshot := AccountHealthSnapshot{
+		TakenAt: time.Now().UTC(),
+		Errors:  make(map[string]error),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+
+	gather := func(key string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				snapshot.Errors[key] = err
+				mu.Unlock()
+			}
+		}()
+	}
+
+	gather("tunnels", func() error {
+		tunnels, _, err := api.ListTunnels(ctx, rc, TunnelListParams{IsDeleted: BoolPtr(false)})
+		if err != nil {
+			return err
+		}
+
+		health := make([]TunnelHealth, 0, len(tunnels))
+		for _, t := range tunnels {
+			health = append(health, TunnelHealth{ID: t.ID, Name: t.Name, Status: t.Status, Connections: len(t.Connections)})
+		}
+
+		mu.Lock()
+		snapshot.Tunnels = health
+		mu.Unlock()
+		return nil
+	})
+
+	gather("load_balancer_pools", func() error {
+		pools, err := api.ListLoadBalancerPools(ctx, rc, ListLoadBalancerPoolParams{})
+		if err != nil {
+			return err
+		}
+
+		health := make([]LoadBalancerPoolHealthSummary, 0, len(pools))
+		for _, p := range pools {
+			health = append(health, LoadBalancerPoolHealthSummary{ID: p.ID, Name: p.Name, Enabled: p.Enabled, Healthy: p.Healthy})
+		}
+
+		mu.Lock()
+		snapshot.Pools = health
+		mu.Unlock()
+		return nil
+	})
+
+	gather("logpush_jobs", func() error {
+		jobs, err := api.ListLogpushJobs(ctx, rc, ListLogpushJobsParams{})
+		if err != nil {
+			return err
+		}
+
+		health := make([]LogpushJobHealth, 0, len(jobs))
+		for _, j := range jobs {
+			health = append(health, LogpushJobHealth{
+				ID:           j.ID,
+				Name:         j.Name,
+				Dataset:      j.Dataset,
+				Enabled:      j.Enabled,
+				LastComplete: j.LastComplete,
+				LastError:    j.LastError,
+				ErrorMessage: j.ErrorMessage,
+				Failing:      j.LastError != nil && (j.LastComplete == nil || j.LastError.After(*j.LastComplete)),
+			})
+		}
+
+		mu.Lock()
+		snapshot.LogpushJobs = health
+		mu.Unlock()
+		return nil
+	})
+
+	for _, zoneID := range params.ZoneIDs {
+		zoneID := zoneID
+		gather(fmt.Sprintf("certificates/%s", zoneID), func() error {
+			packs, err := api.ListCertificatePacks(ctx, zoneID)
+			if err != nil {
+				return err
+			}
+
+			var expiries []CertificateExpiry
+			for _, p := range packs {
+				for _, c := range p.Certificates {
+					expiries = append(expiries, CertificateExpiry{ZoneID: zoneID, PackID: p.ID, Hosts: c.Hosts, ExpiresOn: c.ExpiresOn})
+				}
+			}
+
+			mu.Lock()
+			snapshot.Certificates = append(snapshot.Certificates, expiries...)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	wg.Wait()
+
+	sort.SliceStable(snapshot.Certificates, func(i, j int) bool {
+		return snapshot.Certificates[i].ExpiresOn.Before(snapshot.Certificates[j].ExpiresOn)
+	})
+
+	return snapshot, nil
+}
+
+// ExpiringCertificates returns the certificates expiring within d of when
+// the snapshot was taken, soonest first.
+func (s AccountHealthSnapshot) ExpiringCertificates(d time.Duration) []CertificateExpiry {
+	var expiring []CertificateExpiry
+	for _, c := range s.Certificates {
+		if c.ExpiresOn.Before(s.TakenAt.Add(d)) {
+			expiring = append(expiring, c)
+		}
+	}
+	return expiring
+}
@@ -0,0 +1,92 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountHealthSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("is_deleted"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415", "name": "office", "status": "degraded", "connections": [{"colo_name": "DFW"}]}],
+			"result_info": {"page": 1, "per_page": 1000, "count": 1, "total_count": 1, "total_pages": 1}
+		}`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/pools", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "17b5962d775c646f3f9725cbc7a53df4", "name": "primary", "enabled": true, "healthy": false, "origins": []}]
+		}`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/jobs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": 1, "name": "http", "dataset": "http_requests", "enabled": true, "last_complete": "2024-01-01T00:00:00Z", "last_error": "2024-01-02T00:00:00Z", "error_message": "bucket not found"},
+				{"id": 2, "name": "audit", "dataset": "audit_logs", "enabled": true, "last_complete": "2024-01-02T00:00:00Z"}
+			]
+		}`)
+	})
+
+	expires := time.Now().UTC().Add(7 * 24 * time.Hour).Truncate(time.Second)
+	mux.HandleFunc("/zones/"+testZoneID+"/ssl/certificate_packs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "3822ff90-ea29-44df-9e55-21300bb9419b", "type": "advanced", "hosts": ["example.com"], "certificates": [{"id": "7e7b8deba8538af625850b7b2530034c", "hosts": ["example.com"], "expires_on": "%s"}]}]
+		}`, expires.Format(time.RFC3339))
+	})
+
+	mux.HandleFunc("/zones/broken/ssl/certificate_packs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 10000, "message": "Authentication error"}], "messages": [], "result": null}`)
+	})
+
+	snapshot, err := client.GetAccountHealthSnapshot(context.Background(), AccountIdentifier(testAccountID), AccountHealthSnapshotParams{
+		ZoneIDs: []string{testZoneID, "broken"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []TunnelHealth{{ID: "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415", Name: "office", Status: "degraded", Connections: 1}}, snapshot.Tunnels)
+	assert.Equal(t, []LoadBalancerPoolHealthSummary{{ID: "17b5962d775c646f3f9725cbc7a53df4", Name: "primary", Enabled: true, Healthy: BoolPtr(false)}}, snapshot.Pools)
+
+	if assert.Len(t, snapshot.LogpushJobs, 2) {
+		assert.True(t, snapshot.LogpushJobs[0].Failing)
+		assert.False(t, snapshot.LogpushJobs[1].Failing)
+	}
+
+	if assert.Len(t, snapshot.Certificates, 1) {
+		assert.Equal(t, expires, snapshot.Certificates[0].ExpiresOn)
+	}
+	assert.Len(t, snapshot.ExpiringCertificates(30*24*time.Hour), 1)
+	assert.Len(t, snapshot.ExpiringCertificates(24*time.Hour), 0)
+
+	assert.Len(t, snapshot.Errors, 1)
+	assert.Error(t, snapshot.Errors["certificates/broken"])
+}
@@ -66,6 +66,65 @@ type CreateAccountMemberParams struct {
 	Status       string
 }
 
+// ListAccountMembersParams filters the members returned by
+// ListAccountMembers.
+type ListAccountMembersParams struct {
+	// Status is one of "accepted", "pending" or "rejected".
+	Status string `url:"status,omitempty"`
+
+	ResultInfo
+}
+
+// ListAccountMembers returns the members of an account with their roles or
+// policies. Every page is fetched unless a page or page size is set.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-members-list-members
+func (api *API) ListAccountMembers(ctx context.Context, rc *ResourceContainer, params ListAccountMembersParams) ([]AccountMember, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccountMember{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AccountMember{}, ErrMissingAccountID
+	}
+
+	autoPaginate := true
+	if params.PerPage >= 1 || params.Page >= 1 {
+		autoPaginate = false
+	}
+
+	if params.PerPage < 1 {
+		params.PerPage = 50
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	var members []AccountMember
+	for {
+		uri := buildURI(fmt.Sprintf("/accounts/%s/members", rc.Identifier), params)
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AccountMember{}, err
+		}
+
+		var r AccountMembersListResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AccountMember{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		members = append(members, r.Result...)
+		params.ResultInfo = r.ResultInfo.Next()
+		if params.ResultInfo.Done() || !autoPaginate {
+			break
+		}
+	}
+
+	return members, nil
+}
+
 // AccountMembers returns all members of an account.
 //
 // API reference: https://api.cloudflare.com/#accounts-list-accounts
@@ -198,6 +198,64 @@ func TestAccountMembers(t *testing.T) {
 	}
 }
 
+func TestListAccountMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "pending", r.URL.Query().Get("status"))
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "member-%s",
+					"status": "pending",
+					"user": {"email": "user%s@example.com"},
+					"policies": [
+						{
+							"access": "allow",
+							"permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f", "name": "Zone Read"}],
+							"resource_groups": [{"id": "6d7f2f5f5b1d4a0e9081fdc98d432fd1", "scope": {"key": "com.cloudflare.api.account.%s"}}]
+						}
+					]
+				}
+			],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2}
+		}
+		`, page, page, testAccountID, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/members", handler)
+
+	actual, err := client.ListAccountMembers(context.Background(), AccountIdentifier(testAccountID), ListAccountMembersParams{Status: "pending"})
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 2)
+		assert.Equal(t, "member-2", actual[1].ID)
+		assert.Equal(t, PolicyAccessAllow, actual[0].Policies[0].Access)
+		assert.Equal(t, "Zone Read", actual[0].Policies[0].PermissionGroups[0].Name)
+	}
+
+	_, err = client.ListAccountMembers(context.Background(), ZoneIdentifier(testZoneID), ListAccountMembersParams{})
+	assert.Equal(t, ErrRequiredAccountLevelResourceContainer, err)
+}
+
+func TestNewPolicy(t *testing.T) {
+	rg := NewResourceGroupForAccount(Account{ID: testAccountID})
+	policy := NewPolicy(PolicyAccessAllow, []string{"c8fed203ed3043cba015a93ad1616f1f"}, rg)
+
+	assert.Equal(t, Policy{
+		Access:           "allow",
+		PermissionGroups: []PermissionGroup{{ID: "c8fed203ed3043cba015a93ad1616f1f"}},
+		ResourceGroups:   []ResourceGroup{rg},
+	}, policy)
+}
+
 func TestAccountMembersWithoutAccountID(t *testing.T) {
 	setup()
 	defer teardown()
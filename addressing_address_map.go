@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var ErrMissingAddressMapID = errors.New("required address map ID missing")
+
 // AddressMap contains information about an address map.
 type AddressMap struct {
 	ID           string                 `json:"id"`
@@ -171,6 +174,10 @@ func (api *API) GetAddressMap(ctx context.Context, rc *ResourceContainer, id str
 		return AddressMap{}, ErrRequiredAccountLevelResourceContainer
 	}
 
+	if id == "" {
+		return AddressMap{}, ErrMissingAddressMapID
+	}
+
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s", rc.URLFragment(), id)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -193,6 +200,10 @@ func (api *API) UpdateAddressMap(ctx context.Context, rc *ResourceContainer, par
 		return AddressMap{}, ErrRequiredAccountLevelResourceContainer
 	}
 
+	if params.ID == "" {
+		return AddressMap{}, ErrMissingAddressMapID
+	}
+
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s", rc.URLFragment(), params.ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
 	if err != nil {
@@ -207,6 +218,34 @@ func (api *API) UpdateAddressMap(ctx context.Context, rc *ResourceContainer, par
 	return result.Result, nil
 }
 
+// ClearAddressMapDefaultSNI removes the default SNI of an address map, so
+// that TLS connections without SNI to its IPs are no longer served.
+// UpdateAddressMap can only set a default SNI.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-update-address-map
+func (api *API) ClearAddressMapDefaultSNI(ctx context.Context, rc *ResourceContainer, id string) (AddressMap, error) {
+	if rc.Level != AccountRouteLevel {
+		return AddressMap{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if id == "" {
+		return AddressMap{}, ErrMissingAddressMapID
+	}
+
+	uri := fmt.Sprintf("/%s/addressing/address_maps/%s", rc.URLFragment(), id)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, map[string]interface{}{"default_sni": nil})
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	result := GetAddressMapResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return AddressMap{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
 // DeleteAddressMap deletes a particular address map owned by the account.
 //
 // API reference: https://developers.cloudflare.com/api/operations/ip-address-management-address-maps-delete-address-map
@@ -215,6 +254,10 @@ func (api *API) DeleteAddressMap(ctx context.Context, rc *ResourceContainer, id
 		return ErrRequiredAccountLevelResourceContainer
 	}
 
+	if id == "" {
+		return ErrMissingAddressMapID
+	}
+
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s", rc.URLFragment(), id)
 	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	return err
@@ -228,6 +271,10 @@ func (api *API) CreateIPAddressToAddressMap(ctx context.Context, rc *ResourceCon
 		return ErrRequiredAccountLevelResourceContainer
 	}
 
+	if params.ID == "" {
+		return ErrMissingAddressMapID
+	}
+
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s/ips/%s", rc.URLFragment(), params.ID, params.IP)
 	_, err := api.makeRequestContext(ctx, http.MethodPut, uri, nil)
 	return err
@@ -241,6 +288,10 @@ func (api *API) DeleteIPAddressFromAddressMap(ctx context.Context, rc *ResourceC
 		return ErrRequiredAccountLevelResourceContainer
 	}
 
+	if params.ID == "" {
+		return ErrMissingAddressMapID
+	}
+
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s/ips/%s", rc.URLFragment(), params.ID, params.IP)
 	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	return err
@@ -256,8 +307,12 @@ func (api *API) CreateMembershipToAddressMap(ctx context.Context, rc *ResourceCo
 		return ErrRequiredAccountLevelResourceContainer
 	}
 
+	if params.ID == "" {
+		return ErrMissingAddressMapID
+	}
+
 	if params.Membership.Kind != AddressMapMembershipZone && params.Membership.Kind != AddressMapMembershipAccount {
-		return fmt.Errorf("requested membershp kind (%q) is not supported", params.Membership.Kind)
+		return fmt.Errorf("requested membership kind (%q) is not supported", params.Membership.Kind)
 	}
 
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s/%s", rc.URLFragment(), params.ID, params.Membership.URLFragment())
@@ -275,8 +330,12 @@ func (api *API) DeleteMembershipFromAddressMap(ctx context.Context, rc *Resource
 		return ErrRequiredAccountLevelResourceContainer
 	}
 
+	if params.ID == "" {
+		return ErrMissingAddressMapID
+	}
+
 	if params.Membership.Kind != AddressMapMembershipZone && params.Membership.Kind != AddressMapMembershipAccount {
-		return fmt.Errorf("requested membershp kind (%q) is not supported", params.Membership.Kind)
+		return fmt.Errorf("requested membership kind (%q) is not supported", params.Membership.Kind)
 	}
 
 	uri := fmt.Sprintf("/%s/addressing/address_maps/%s/%s", rc.URLFragment(), params.ID, params.Membership.URLFragment())
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -234,6 +235,52 @@ func TestUpdateAddressMap(t *testing.T) {
 	}
 }
 
+func TestClearAddressMapDefaultSNI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"default_sni": null}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+			  "id": "9a7806061c88ada191ed06f989cc3dac",
+			  "default_sni": null,
+			  "enabled": true
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/addressing/address_maps/9a7806061c88ada191ed06f989cc3dac", handler)
+
+	actual, err := client.ClearAddressMapDefaultSNI(context.Background(), AccountIdentifier(testAccountID), "9a7806061c88ada191ed06f989cc3dac")
+	if assert.NoError(t, err) {
+		assert.Nil(t, actual.DefaultSNI)
+	}
+
+	_, err = client.ClearAddressMapDefaultSNI(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.Equal(t, ErrMissingAddressMapID, err)
+}
+
+func TestAddressMapMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.GetAddressMap(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.Equal(t, ErrMissingAddressMapID, err)
+
+	err = client.CreateMembershipToAddressMap(context.Background(), AccountIdentifier(testAccountID), CreateMembershipToAddressMapParams{
+		Membership: AddressMapMembershipContainer{Identifier: testZoneID, Kind: AddressMapMembershipZone},
+	})
+	assert.Equal(t, ErrMissingAddressMapID, err)
+}
+
 func TestDeleteAddressMap(t *testing.T) {
 	setup()
 	defer teardown()
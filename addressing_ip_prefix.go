@@ -22,6 +22,8 @@ type IPPrefix struct {
 	OnDemandLocked       bool       `json:"on_demand_locked"`
 	Advertised           bool       `json:"advertised"`
 	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at"`
+	ASN                  int        `json:"asn,omitempty"`
+	LOADocumentID        string     `json:"loa_document_id,omitempty"`
 }
 
 // AdvertisementStatus contains information about the BGP status of an IP prefix.
@@ -30,6 +32,30 @@ type AdvertisementStatus struct {
 	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at"`
 }
 
+// IPPrefixDelegation contains information about a part of an IP prefix
+// delegated to another account.
+type IPPrefixDelegation struct {
+	ID                 string     `json:"id,omitempty"`
+	CIDR               string     `json:"cidr"`
+	DelegatedAccountID string     `json:"delegated_account_id"`
+	ParentPrefixID     string     `json:"parent_prefix_id,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	ModifiedAt         *time.Time `json:"modified_at,omitempty"`
+}
+
+// ListIPPrefixDelegationsResponse contains a slice of IP prefix delegations.
+type ListIPPrefixDelegationsResponse struct {
+	Response
+	Result []IPPrefixDelegation `json:"result"`
+}
+
+// GetIPPrefixDelegationResponse contains a specific IP prefix delegation's
+// API Response.
+type GetIPPrefixDelegationResponse struct {
+	Response
+	Result IPPrefixDelegation `json:"result"`
+}
+
 // ListIPPrefixResponse contains a slice of IP prefixes.
 type ListIPPrefixResponse struct {
 	Response
@@ -147,3 +173,80 @@ func (api *API) UpdateAdvertisementStatus(ctx context.Context, accountID, ID str
 
 	return result.Result, nil
 }
+
+// WaitForAdvertisementStatus polls the BGP status of an IP prefix with
+// exponential backoff until it matches advertised, as changes take several
+// minutes to propagate, and returns the final status. An error is returned
+// if it still differs after 16 polls or ctx is done.
+func (api *API) WaitForAdvertisementStatus(ctx context.Context, accountID, ID string, advertised bool) (AdvertisementStatus, error) {
+	var status AdvertisementStatus
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		var err error
+		status, err = api.GetAdvertisementStatus(ctx, accountID, id)
+		if err != nil {
+			return Operation{}, err
+		}
+
+		op := Operation{ID: id, State: OperationStateRunning}
+		if status.Advertised == advertised {
+			op.State = OperationStateSucceeded
+		}
+		return op, nil
+	}
+
+	_, err := api.WaitForOperation(ctx, ID, fetch, WaitForOperationParams{
+		Interval:    5 * time.Second,
+		MaxInterval: time.Minute,
+		MaxAttempts: 16,
+	})
+	return status, err
+}
+
+// ListPrefixDelegations lists the delegations of an IP prefix
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-prefix-delegation-list-prefix-delegations
+func (api *API) ListPrefixDelegations(ctx context.Context, accountID, prefixID string) ([]IPPrefixDelegation, error) {
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations", accountID, prefixID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []IPPrefixDelegation{}, err
+	}
+
+	result := ListIPPrefixDelegationsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []IPPrefixDelegation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
+// CreatePrefixDelegation delegates a CIDR of an IP prefix to another account,
+// which can then use it in its own address maps
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-prefix-delegation-create-prefix-delegation
+func (api *API) CreatePrefixDelegation(ctx context.Context, accountID, prefixID string, delegation IPPrefixDelegation) (IPPrefixDelegation, error) {
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations", accountID, prefixID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, IPPrefixDelegation{
+		CIDR:               delegation.CIDR,
+		DelegatedAccountID: delegation.DelegatedAccountID,
+	})
+	if err != nil {
+		return IPPrefixDelegation{}, err
+	}
+
+	result := GetIPPrefixDelegationResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return IPPrefixDelegation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
+// DeletePrefixDelegation removes a delegation of an IP prefix
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-prefix-delegation-delete-prefix-delegation
+func (api *API) DeletePrefixDelegation(ctx context.Context, accountID, prefixID, delegationID string) error {
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations/%s", accountID, prefixID, delegationID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
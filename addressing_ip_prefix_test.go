@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -235,3 +236,70 @@ func TestUpdateAdvertisementStatus(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestWaitForAdvertisementStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"advertised": true,
+				"advertised_modified_at": "2020-04-24T21:25:55.643771Z"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/addressing/prefixes/f68579455bd947efb65ffa1bcf33b52c/bgp/status", handler)
+
+	actual, err := client.WaitForAdvertisementStatus(context.Background(), testAccountID, "f68579455bd947efb65ffa1bcf33b52c", true)
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Advertised)
+	}
+}
+
+func TestCreatePrefixDelegation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"cidr": "192.0.2.0/26", "delegated_account_id": "b1946ac92492d2347c6235b4d2611184"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "d933b1530bc56c9953cf8ce166da8004",
+				"cidr": "192.0.2.0/26",
+				"delegated_account_id": "b1946ac92492d2347c6235b4d2611184",
+				"parent_prefix_id": "f68579455bd947efb65ffa1bcf33b52c"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/addressing/prefixes/f68579455bd947efb65ffa1bcf33b52c/delegations", handler)
+
+	want := IPPrefixDelegation{
+		ID:                 "d933b1530bc56c9953cf8ce166da8004",
+		CIDR:               "192.0.2.0/26",
+		DelegatedAccountID: "b1946ac92492d2347c6235b4d2611184",
+		ParentPrefixID:     "f68579455bd947efb65ffa1bcf33b52c",
+	}
+
+	actual, err := client.CreatePrefixDelegation(context.Background(), testAccountID, "f68579455bd947efb65ffa1bcf33b52c", IPPrefixDelegation{
+		CIDR:               "192.0.2.0/26",
+		DelegatedAccountID: "b1946ac92492d2347c6235b4d2611184",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingLOADocumentID = errors.New("required LOA document ID missing")
+
+// LOADocument contains information about a Letter of Authorization, which
+// permits Cloudflare to advertise an IP prefix on the account's behalf.
+type LOADocument struct {
+	ID            string     `json:"id"`
+	AccountID     string     `json:"account_id"`
+	Filename      string     `json:"filename"`
+	SizeBytes     int        `json:"size_bytes"`
+	AutoGenerated bool       `json:"auto_generated"`
+	Created       *time.Time `json:"created"`
+}
+
+// LOADocumentResponse contains an LOA document's API Response.
+type LOADocumentResponse struct {
+	Response
+	Result LOADocument `json:"result"`
+}
+
+// UploadLOADocument uploads a Letter of Authorization PDF, whose ID can
+// then be referenced when adding an IP prefix.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-prefixes-upload-loa-document
+func (api *API) UploadLOADocument(ctx context.Context, accountID, filename string, document io.Reader) (LOADocument, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	part, err := w.CreateFormFile("loa_document", filename)
+	if err != nil {
+		return LOADocument{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if _, err := io.Copy(part, document); err != nil {
+		return LOADocument{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return LOADocument{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/loa_documents", accountID)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, &b, http.Header{
+		"Content-Type": []string{w.FormDataContentType()},
+	})
+	if err != nil {
+		return LOADocument{}, err
+	}
+
+	result := LOADocumentResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return LOADocument{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
+// DownloadLOADocument returns the PDF of a Letter of Authorization.
+//
+// API reference: https://developers.cloudflare.com/api/operations/ip-address-management-prefixes-download-loa-document
+func (api *API) DownloadLOADocument(ctx context.Context, accountID, ID string) ([]byte, error) {
+	if ID == "" {
+		return nil, ErrMissingLOADocumentID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/loa_documents/%s/download", accountID, ID)
+	return api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+}
@@ -0,0 +1,76 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadLOADocument(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		file, header, err := r.FormFile("loa_document")
+		require.NoError(t, err)
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "loa.pdf", header.Filename)
+		assert.Equal(t, "%PDF-1.4", string(content))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "d933b1530bc56c9953cf8ce166da8004",
+				"account_id": "`+testAccountID+`",
+				"filename": "loa.pdf",
+				"size_bytes": 8,
+				"auto_generated": false
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/addressing/loa_documents", handler)
+
+	actual, err := client.UploadLOADocument(context.Background(), testAccountID, "loa.pdf", strings.NewReader("%PDF-1.4"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, LOADocument{
+			ID:        "d933b1530bc56c9953cf8ce166da8004",
+			AccountID: testAccountID,
+			Filename:  "loa.pdf",
+			SizeBytes: 8,
+		}, actual)
+	}
+}
+
+func TestDownloadLOADocument(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/pdf")
+		fmt.Fprint(w, "%PDF-1.4")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/addressing/loa_documents/d933b1530bc56c9953cf8ce166da8004/download", handler)
+
+	actual, err := client.DownloadLOADocument(context.Background(), testAccountID, "d933b1530bc56c9953cf8ce166da8004")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("%PDF-1.4"), actual)
+	}
+
+	_, err = client.DownloadLOADocument(context.Background(), testAccountID, "")
+	assert.Equal(t, ErrMissingLOADocumentID, err)
+}
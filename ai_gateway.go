@@ -0,0 +1,435 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingAIGatewayID    = errors.New("required AI Gateway id is missing")
+	ErrMissingAIGatewayLogID = errors.New("required AI Gateway log id is missing")
+)
+
+// AIGatewayRateLimitingTechnique is how requests are counted against the
+// rate limit of a gateway.
+type AIGatewayRateLimitingTechnique string
+
+const (
+	AIGatewayRateLimitingFixed   AIGatewayRateLimitingTechnique = "fixed"
+	AIGatewayRateLimitingSliding AIGatewayRateLimitingTechnique = "sliding"
+)
+
+// AIGatewaySettings are the caching, rate limiting and logging settings of
+// an AI Gateway. All settings are sent on create and update, so unset
+// fields disable the corresponding feature.
+type AIGatewaySettings struct {
+	// CacheTTL is how long responses are cached, in seconds. Zero disables
+	// caching.
+	CacheTTL                int  `json:"cache_ttl"`
+	CacheInvalidateOnUpdate bool `json:"cache_invalidate_on_update"`
+
+	CollectLogs bool `json:"collect_logs"`
+
+	// RateLimitingLimit requests are allowed per RateLimitingInterval
+	// seconds. Zero disables rate limiting.
+	RateLimitingInterval  int                            `json:"rate_limiting_interval"`
+	RateLimitingLimit     int                            `json:"rate_limiting_limit"`
+	RateLimitingTechnique AIGatewayRateLimitingTechnique `json:"rate_limiting_technique"`
+
+	// Authentication requires requests to the gateway to carry a
+	// cf-aig-authorization token.
+	Authentication bool `json:"authentication"`
+}
+
+// AIGateway is a gateway proxying requests to AI providers.
+type AIGateway struct {
+	ID string `json:"id"`
+	AIGatewaySettings
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	ModifiedAt *time.Time `json:"modified_at,omitempty"`
+}
+
+// AIGatewayResponse is the API response, containing an AI Gateway.
+type AIGatewayResponse struct {
+	Response
+	Result AIGateway `json:"result"`
+}
+
+// AIGatewaysResponse is the API response, containing AI Gateways.
+type AIGatewaysResponse struct {
+	Response
+	ResultInfo `json:"result_info"`
+	Result     []AIGateway `json:"result"`
+}
+
+// ListAIGatewaysParams filters the gateways returned by ListAIGateways.
+type ListAIGatewaysParams struct {
+	Search string `url:"search,omitempty"`
+	ResultInfo
+}
+
+// CreateAIGatewayParams creates a gateway. ID is the gateway slug used in
+// its URL.
+type CreateAIGatewayParams struct {
+	ID string `json:"id"`
+	AIGatewaySettings
+}
+
+// UpdateAIGatewayParams replaces the settings of a gateway.
+type UpdateAIGatewayParams struct {
+	ID string `json:"-"`
+	AIGatewaySettings
+}
+
+// ListAIGateways returns the AI Gateways of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-list-gateway
+func (api *API) ListAIGateways(ctx context.Context, rc *ResourceContainer, params ListAIGatewaysParams) ([]AIGateway, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AIGateway{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AIGateway{}, &ResultInfo{}, ErrMissingAccountID
+	}
+
+	autoPaginate := true
+	if params.PerPage >= 1 || params.Page >= 1 {
+		autoPaginate = false
+	}
+	if params.PerPage < 1 {
+		params.PerPage = 50
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	var gateways []AIGateway
+	var r AIGatewaysResponse
+	for {
+		r = AIGatewaysResponse{}
+		uri := buildURI(fmt.Sprintf("/accounts/%s/ai-gateway/gateways", rc.Identifier), params)
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AIGateway{}, &ResultInfo{}, err
+		}
+
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AIGateway{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		gateways = append(gateways, r.Result...)
+		params.ResultInfo = r.ResultInfo.Next()
+
+		if params.ResultInfo.Done() || !autoPaginate {
+			break
+		}
+	}
+
+	return gateways, &r.ResultInfo, nil
+}
+
+// GetAIGateway returns a single AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-fetch-gateway
+func (api *API) GetAIGateway(ctx context.Context, rc *ResourceContainer, gatewayID string) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, gatewayID)
+	return api.aiGatewayRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateAIGateway creates an AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-create-gateway
+func (api *API) CreateAIGateway(ctx context.Context, rc *ResourceContainer, params CreateAIGatewayParams) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if params.ID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways", rc.Identifier)
+	return api.aiGatewayRequest(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateAIGateway replaces the settings of an AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-update-gateway
+func (api *API) UpdateAIGateway(ctx context.Context, rc *ResourceContainer, params UpdateAIGatewayParams) (AIGateway, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGateway{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGateway{}, ErrMissingAccountID
+	}
+
+	if params.ID == "" {
+		return AIGateway{}, ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, params.ID)
+	return api.aiGatewayRequest(ctx, http.MethodPut, uri, params)
+}
+
+// DeleteAIGateway deletes an AI Gateway.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-delete-gateway
+func (api *API) DeleteAIGateway(ctx context.Context, rc *ResourceContainer, gatewayID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return ErrMissingAIGatewayID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s", rc.Identifier, gatewayID)
+	_, err := api.aiGatewayRequest(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) aiGatewayRequest(ctx context.Context, method, uri string, params interface{}) (AIGateway, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return AIGateway{}, err
+	}
+
+	var r AIGatewayResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AIGateway{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// AIGatewayLog is a request proxied by an AI Gateway.
+type AIGatewayLog struct {
+	ID         string     `json:"id"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Provider   string     `json:"provider"`
+	Model      string     `json:"model"`
+	ModelType  string     `json:"model_type,omitempty"`
+	Path       string     `json:"path"`
+	Success    bool       `json:"success"`
+	Cached     bool       `json:"cached"`
+	StatusCode int        `json:"status_code,omitempty"`
+
+	// Duration is the time taken by the request, in milliseconds.
+	Duration  int     `json:"duration"`
+	TokensIn  int     `json:"tokens_in"`
+	TokensOut int     `json:"tokens_out"`
+	Cost      float64 `json:"cost,omitempty"`
+
+	// Feedback is -1 for negative, 1 for positive and 0 or nil when no
+	// feedback was given.
+	Feedback *int   `json:"feedback,omitempty"`
+	Score    *int   `json:"score,omitempty"`
+	Metadata string `json:"metadata,omitempty"`
+
+	// Request and Response are only included by GetAIGatewayLog.
+	Request  string `json:"request,omitempty"`
+	Response string `json:"response,omitempty"`
+}
+
+// AIGatewayLogResponse is the API response, containing an AI Gateway log.
+type AIGatewayLogResponse struct {
+	Response
+	Result AIGatewayLog `json:"result"`
+}
+
+// AIGatewayLogsResponse is the API response, containing AI Gateway logs.
+type AIGatewayLogsResponse struct {
+	Response
+	ResultInfo `json:"result_info"`
+	Result     []AIGatewayLog `json:"result"`
+}
+
+// ListAIGatewayLogsParams filters the logs returned by ListAIGatewayLogs.
+type ListAIGatewayLogsParams struct {
+	GatewayID string `url:"-"`
+
+	Search    string     `url:"search,omitempty"`
+	Provider  string     `url:"provider,omitempty"`
+	Model     string     `url:"model,omitempty"`
+	Success   *bool      `url:"success,omitempty"`
+	Cached    *bool      `url:"cached,omitempty"`
+	Feedback  *int       `url:"feedback,omitempty"`
+	StartDate *time.Time `url:"start_date,omitempty"`
+	EndDate   *time.Time `url:"end_date,omitempty"`
+
+	// OrderBy is a log field such as "created_at" or "cost", and
+	// OrderByDirection "asc" or "desc".
+	OrderBy          string `url:"order_by,omitempty"`
+	OrderByDirection string `url:"order_by_direction,omitempty"`
+
+	ResultInfo
+}
+
+// AIGatewayLogFeedbackParams records feedback on a logged request, for
+// evaluating responses. Nil fields are left unchanged.
+type AIGatewayLogFeedbackParams struct {
+	GatewayID string `json:"-"`
+	LogID     string `json:"-"`
+
+	// Feedback is -1 for negative, 1 for positive or 0 to clear it.
+	Feedback *int `json:"feedback,omitempty"`
+
+	// Score is a custom rating between 0 and 100.
+	Score    *int                   `json:"score,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ListAIGatewayLogs returns the requests logged by an AI Gateway, most
+// recent first unless ordered otherwise.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-list-gateway-logs
+func (api *API) ListAIGatewayLogs(ctx context.Context, rc *ResourceContainer, params ListAIGatewayLogsParams) ([]AIGatewayLog, *ResultInfo, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrMissingAccountID
+	}
+
+	if params.GatewayID == "" {
+		return []AIGatewayLog{}, &ResultInfo{}, ErrMissingAIGatewayID
+	}
+
+	autoPaginate := true
+	if params.PerPage >= 1 || params.Page >= 1 {
+		autoPaginate = false
+	}
+	if params.PerPage < 1 {
+		params.PerPage = 50
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	var logs []AIGatewayLog
+	var r AIGatewayLogsResponse
+	for {
+		r = AIGatewayLogsResponse{}
+		uri := buildURI(fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s/logs", rc.Identifier, params.GatewayID), params)
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AIGatewayLog{}, &ResultInfo{}, err
+		}
+
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AIGatewayLog{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		logs = append(logs, r.Result...)
+		params.ResultInfo = r.ResultInfo.Next()
+
+		if params.ResultInfo.Done() || !autoPaginate {
+			break
+		}
+	}
+
+	return logs, &r.ResultInfo, nil
+}
+
+// GetAIGatewayLog returns a single logged request, including its request
+// and response bodies.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-get-gateway-log-detail
+func (api *API) GetAIGatewayLog(ctx context.Context, rc *ResourceContainer, gatewayID, logID string) (AIGatewayLog, error) {
+	if rc.Level != AccountRouteLevel {
+		return AIGatewayLog{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AIGatewayLog{}, ErrMissingAccountID
+	}
+
+	if gatewayID == "" {
+		return AIGatewayLog{}, ErrMissingAIGatewayID
+	}
+
+	if logID == "" {
+		return AIGatewayLog{}, ErrMissingAIGatewayLogID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s/logs/%s", rc.Identifier, gatewayID, logID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AIGatewayLog{}, err
+	}
+
+	var r AIGatewayLogResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AIGatewayLog{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// SetAIGatewayLogFeedback records feedback, a score or metadata on a logged
+// request.
+//
+// API reference: https://developers.cloudflare.com/api/operations/aig-config-patch-gateway-log
+func (api *API) SetAIGatewayLogFeedback(ctx context.Context, rc *ResourceContainer, params AIGatewayLogFeedbackParams) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if params.GatewayID == "" {
+		return ErrMissingAIGatewayID
+	}
+
+	if params.LogID == "" {
+		return ErrMissingAIGatewayLogID
+	}
+
+	if params.Feedback != nil && (*params.Feedback < -1 || *params.Feedback > 1) {
+		return fmt.Errorf("invalid AI Gateway feedback %d, must be -1, 0 or 1", *params.Feedback)
+	}
+
+	if params.Score != nil && (*params.Score < 0 || *params.Score > 100) {
+		return fmt.Errorf("invalid AI Gateway score %d, must be between 0 and 100", *params.Score)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai-gateway/gateways/%s/logs/%s", rc.Identifier, params.GatewayID, params.LogID)
+	_, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	return err
+}
@@ -0,0 +1,164 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAIGateway(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"id": "my-gateway",
+			"cache_ttl": 300,
+			"cache_invalidate_on_update": true,
+			"collect_logs": true,
+			"rate_limiting_interval": 60,
+			"rate_limiting_limit": 100,
+			"rate_limiting_technique": "sliding",
+			"authentication": false
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "my-gateway",
+				"cache_ttl": 300,
+				"cache_invalidate_on_update": true,
+				"collect_logs": true,
+				"rate_limiting_interval": 60,
+				"rate_limiting_limit": 100,
+				"rate_limiting_technique": "sliding",
+				"authentication": false,
+				"created_at": "2024-10-01T00:00:00Z",
+				"modified_at": "2024-10-01T00:00:00Z"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways", handler)
+
+	settings := AIGatewaySettings{
+		CacheTTL:                300,
+		CacheInvalidateOnUpdate: true,
+		CollectLogs:             true,
+		RateLimitingInterval:    60,
+		RateLimitingLimit:       100,
+		RateLimitingTechnique:   AIGatewayRateLimitingSliding,
+	}
+	createdAt := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	want := AIGateway{
+		ID:                "my-gateway",
+		AIGatewaySettings: settings,
+		CreatedAt:         &createdAt,
+		ModifiedAt:        &createdAt,
+	}
+
+	actual, err := client.CreateAIGateway(context.Background(), AccountIdentifier(testAccountID), CreateAIGatewayParams{
+		ID:                "my-gateway",
+		AIGatewaySettings: settings,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreateAIGateway(context.Background(), AccountIdentifier(testAccountID), CreateAIGatewayParams{})
+	assert.Equal(t, ErrMissingAIGatewayID, err)
+}
+
+func TestListAIGatewayLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "openai", r.URL.Query().Get("provider"))
+		assert.Equal(t, "false", r.URL.Query().Get("success"))
+		assert.Equal(t, "2024-10-01T00:00:00Z", r.URL.Query().Get("start_date"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "01J9XYZ",
+				"created_at": "2024-10-01T01:00:00Z",
+				"provider": "openai",
+				"model": "gpt-4o-mini",
+				"path": "chat/completions",
+				"success": false,
+				"cached": false,
+				"status_code": 429,
+				"duration": 120,
+				"tokens_in": 10,
+				"tokens_out": 0
+			}],
+			"result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways/my-gateway/logs", handler)
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	actual, _, err := client.ListAIGatewayLogs(context.Background(), AccountIdentifier(testAccountID), ListAIGatewayLogsParams{
+		GatewayID: "my-gateway",
+		Provider:  "openai",
+		Success:   BoolPtr(false),
+		StartDate: &since,
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, actual, 1)
+		assert.Equal(t, "gpt-4o-mini", actual[0].Model)
+		assert.Equal(t, 429, actual[0].StatusCode)
+	}
+}
+
+func TestSetAIGatewayLogFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"feedback": -1, "score": 20}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai-gateway/gateways/my-gateway/logs/01J9XYZ", handler)
+
+	err := client.SetAIGatewayLogFeedback(context.Background(), AccountIdentifier(testAccountID), AIGatewayLogFeedbackParams{
+		GatewayID: "my-gateway",
+		LogID:     "01J9XYZ",
+		Feedback:  IntPtr(-1),
+		Score:     IntPtr(20),
+	})
+	assert.NoError(t, err)
+
+	err = client.SetAIGatewayLogFeedback(context.Background(), AccountIdentifier(testAccountID), AIGatewayLogFeedbackParams{
+		GatewayID: "my-gateway",
+		LogID:     "01J9XYZ",
+		Feedback:  IntPtr(2),
+	})
+	assert.Error(t, err)
+}
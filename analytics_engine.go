@@ -0,0 +1,115 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingAnalyticsEngineQuery = errors.New("required Analytics Engine SQL query missing")
+
+// AnalyticsEngineSQLColumn is the name and type of a column of an Analytics
+// Engine SQL result.
+type AnalyticsEngineSQLColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AnalyticsEngineSQLResult is the result of an Analytics Engine SQL query.
+// Meta and the row counts are only returned for queries using the default
+// JSON format, not for FORMAT JSONEachRow.
+type AnalyticsEngineSQLResult struct {
+	Meta                   []AnalyticsEngineSQLColumn `json:"meta"`
+	Data                   json.RawMessage            `json:"data"`
+	Rows                   int                        `json:"rows"`
+	RowsBeforeLimitAtLeast int                        `json:"rows_before_limit_at_least"`
+}
+
+// Decode unmarshals the rows of the result into v, which must be a pointer
+// to a slice, for example *[]map[string]interface{} or a slice of structs
+// with a field per selected column.
+func (r AnalyticsEngineSQLResult) Decode(v interface{}) error {
+	data := r.Data
+	if len(data) == 0 {
+		data = []byte("[]")
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return nil
+}
+
+// QueryAnalyticsEngineSQL runs a SQL query against the Analytics Engine
+// datasets of an account. Both the default JSON format and FORMAT
+// JSONEachRow are decoded; other formats are not supported.
+//
+// API reference: https://developers.cloudflare.com/analytics/analytics-engine/sql-api/
+func (api *API) QueryAnalyticsEngineSQL(ctx context.Context, rc *ResourceContainer, query string) (AnalyticsEngineSQLResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return AnalyticsEngineSQLResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return AnalyticsEngineSQLResult{}, ErrMissingAccountID
+	}
+
+	if query == "" {
+		return AnalyticsEngineSQLResult{}, ErrMissingAnalyticsEngineQuery
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/analytics_engine/sql", rc.Identifier)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, []byte(query), http.Header{
+		"Content-Type": []string{"text/plain"},
+	})
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, err
+	}
+
+	return parseAnalyticsEngineSQLResult(res)
+}
+
+// parseAnalyticsEngineSQLResult decodes a JSON result, a single object with
+// meta and data, or a JSONEachRow result, one object per row.
+func parseAnalyticsEngineSQLResult(res []byte) (AnalyticsEngineSQLResult, error) {
+	var values []json.RawMessage
+	dec := json.NewDecoder(bytes.NewReader(res))
+	for {
+		var v json.RawMessage
+		err := dec.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return AnalyticsEngineSQLResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		values = append(values, v)
+	}
+
+	if len(values) == 1 {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(values[0], &fields); err == nil {
+			_, hasMeta := fields["meta"]
+			_, hasData := fields["data"]
+			if hasMeta && hasData {
+				var r AnalyticsEngineSQLResult
+				if err := json.Unmarshal(values[0], &r); err != nil {
+					return AnalyticsEngineSQLResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+				}
+				return r, nil
+			}
+		}
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return AnalyticsEngineSQLResult{Data: data}, nil
+}
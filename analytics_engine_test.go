@@ -0,0 +1,73 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type analyticsEngineTestRow struct {
+	Blob1 string `json:"blob1"`
+	Count uint64 `json:"count,string"`
+}
+
+func TestQueryAnalyticsEngineSQL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "SELECT blob1, count() AS count FROM dataset GROUP BY blob1", string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"meta": [{"name": "blob1", "type": "String"}, {"name": "count", "type": "UInt64"}],
+			"data": [{"blob1": "a", "count": "3"}, {"blob1": "b", "count": "1"}],
+			"rows": 2,
+			"rows_before_limit_at_least": 2
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/analytics_engine/sql", handler)
+
+	actual, err := client.QueryAnalyticsEngineSQL(context.Background(), AccountIdentifier(testAccountID), "SELECT blob1, count() AS count FROM dataset GROUP BY blob1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []AnalyticsEngineSQLColumn{{Name: "blob1", Type: "String"}, {Name: "count", Type: "UInt64"}}, actual.Meta)
+	assert.Equal(t, 2, actual.Rows)
+
+	var rows []analyticsEngineTestRow
+	if assert.NoError(t, actual.Decode(&rows)) {
+		assert.Equal(t, []analyticsEngineTestRow{{"a", 3}, {"b", 1}}, rows)
+	}
+
+	_, err = client.QueryAnalyticsEngineSQL(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.Equal(t, ErrMissingAnalyticsEngineQuery, err)
+}
+
+func TestQueryAnalyticsEngineSQLEachRow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "{\"blob1\":\"a\",\"count\":\"3\"}\n{\"blob1\":\"b\",\"count\":\"1\"}\n")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/analytics_engine/sql", handler)
+
+	actual, err := client.QueryAnalyticsEngineSQL(context.Background(), AccountIdentifier(testAccountID), "SELECT blob1, count() AS count FROM dataset GROUP BY blob1 FORMAT JSONEachRow")
+	require.NoError(t, err)
+	assert.Nil(t, actual.Meta)
+
+	var rows []analyticsEngineTestRow
+	if assert.NoError(t, actual.Decode(&rows)) {
+		assert.Equal(t, []analyticsEngineTestRow{{"a", 3}, {"b", 1}}, rows)
+	}
+}
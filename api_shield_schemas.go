@@ -334,6 +334,61 @@ func (api *API) UpdateAPIShieldSchema(ctx context.Context, rc *ResourceContainer
 	return &asResponse.Result, nil
 }
 
+// ListAPIShieldSchemaOperationsParams represents the parameters to pass when retrieving the operations
+// described by a schema.
+//
+// API documentation: https://developers.cloudflare.com/api/operations/api-shield-schema-validation-extract-operations-from-schema
+type ListAPIShieldSchemaOperationsParams struct {
+	// SchemaID is the schema to extract operations from
+	SchemaID string `url:"-"`
+	// Features represents a set of features to return in `features` object of operations
+	// already saved in Endpoint Management.
+	Features []string `url:"feature,omitempty"`
+	// OperationStatus filters operations to those new to Endpoint Management ("new") or
+	// already saved in it ("existing").
+	OperationStatus string `url:"operation_status,omitempty"`
+	// Filters to only return operations that match filtering criteria, see APIShieldListOperationsFilters
+	APIShieldListOperationsFilters
+	// Pagination options to apply to the request.
+	PaginationOptions
+}
+
+// APIShieldListSchemaOperationsResponse represents the response from the GET
+// api_gateway/user_schemas/{id}/operations endpoint.
+type APIShieldListSchemaOperationsResponse struct {
+	Result     []APIShieldOperation `json:"result"`
+	ResultInfo `json:"result_info"`
+	Response
+}
+
+// ListAPIShieldSchemaOperations retrieves the operations described by a schema. Operations
+// that are not yet saved in Endpoint Management have no ID and can be added with
+// CreateAPIShieldOperations.
+//
+// API documentation: https://developers.cloudflare.com/api/operations/api-shield-schema-validation-extract-operations-from-schema
+func (api *API) ListAPIShieldSchemaOperations(ctx context.Context, rc *ResourceContainer, params ListAPIShieldSchemaOperationsParams) ([]APIShieldOperation, ResultInfo, error) {
+	if params.SchemaID == "" {
+		return nil, ResultInfo{}, fmt.Errorf("schema ID must be provided")
+	}
+
+	path := fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s/operations", rc.Identifier, params.SchemaID)
+
+	uri := buildURI(path, params)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, ResultInfo{}, err
+	}
+
+	var asResponse APIShieldListSchemaOperationsResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return asResponse.Result, asResponse.ResultInfo, nil
+}
+
 // Schema Validation Settings
 
 // APIShieldSchemaValidationSettings represents zone level schema validation settings for
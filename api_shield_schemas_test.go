@@ -222,6 +222,71 @@ func TestListAPIShieldSchemas(t *testing.T) {
 	}
 }
 
+func TestListAPIShieldSchemaOperations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s/operations", testZoneID, testAPIShieldSchemaId)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		require.Equal(t, url.Values{
+			"operation_status": []string{"new"},
+			"host":             []string{"api.example.com"},
+		}, r.URL.Query())
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"method": "GET",
+					"host": "api.example.com",
+					"endpoint": "/pets/{var1}"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}`)
+	}
+
+	mux.HandleFunc(endpoint, handler)
+
+	actual, resultInfo, err := client.ListAPIShieldSchemaOperations(
+		context.Background(),
+		ZoneIdentifier(testZoneID),
+		ListAPIShieldSchemaOperationsParams{
+			SchemaID:        testAPIShieldSchemaId,
+			OperationStatus: "new",
+			APIShieldListOperationsFilters: APIShieldListOperationsFilters{
+				Hosts: []string{"api.example.com"},
+			},
+		},
+	)
+
+	expected := []APIShieldOperation{
+		{
+			APIShieldBasicOperation: APIShieldBasicOperation{
+				Method:   "GET",
+				Host:     "api.example.com",
+				Endpoint: "/pets/{var1}",
+			},
+		},
+	}
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, ResultInfo{Page: 1, PerPage: 20, Count: 1, Total: 1}, resultInfo)
+	}
+
+	_, _, err = client.ListAPIShieldSchemaOperations(context.Background(), ZoneIdentifier(testZoneID), ListAPIShieldSchemaOperationsParams{})
+	assert.Error(t, err)
+}
+
 func TestDeleteAPIShieldSchema(t *testing.T) {
 	setup()
 	t.Cleanup(teardown)
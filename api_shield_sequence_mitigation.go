@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingAPIShieldSequence = errors.New("sequence mitigation rule requires at least two operations")
+
+// Kinds of sequence mitigation rules.
+const (
+	// APIShieldSequenceKindAllow only allows the operations of the sequence to
+	// be requested in order, acting on requests that deviate from it.
+	APIShieldSequenceKindAllow = "allow"
+	// APIShieldSequenceKindBlock acts on requests that complete the sequence.
+	APIShieldSequenceKindBlock = "block"
+)
+
+// APIShieldSequenceMitigationRule acts on clients requesting API Shield
+// operations in, or out of, an expected order.
+type APIShieldSequenceMitigationRule struct {
+	// ID represents the ID of the rule, formatted as UUID
+	ID string `json:"id,omitempty"`
+	// Title is a human readable name of the rule
+	Title string `json:"title"`
+	// Kind is one of the APIShieldSequenceKind values
+	Kind string `json:"kind"`
+	// Action to take on matching requests, either "log" or "block"
+	Action string `json:"action"`
+	// Sequence are the IDs of the operations, in the order they are
+	// requested
+	Sequence []string `json:"sequence"`
+	// Priority orders the rules of a zone, lower values are evaluated first
+	Priority *int `json:"priority,omitempty"`
+	// Enabled controls if the rule is applied
+	Enabled *bool `json:"enabled,omitempty"`
+	// CreatedAt is the time the rule was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// LastUpdated is the time the rule was last updated
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// UpdateAPIShieldSequenceMitigationRuleParams represents the parameters to
+// pass to patch a sequence mitigation rule. Only the fields set are changed.
+type UpdateAPIShieldSequenceMitigationRuleParams struct {
+	// RuleID is the rule to be patched
+	RuleID   string   `json:"-"`
+	Title    string   `json:"title,omitempty"`
+	Kind     string   `json:"kind,omitempty"`
+	Action   string   `json:"action,omitempty"`
+	Sequence []string `json:"sequence,omitempty"`
+	Priority *int     `json:"priority,omitempty"`
+	Enabled  *bool    `json:"enabled,omitempty"`
+}
+
+// APIShieldSequenceMitigationRuleResponse represents the response from the
+// api_gateway/sequence_mitigation/rules/{id} endpoint.
+type APIShieldSequenceMitigationRuleResponse struct {
+	Result APIShieldSequenceMitigationRule `json:"result"`
+	Response
+}
+
+// APIShieldListSequenceMitigationRulesResponse represents the response from
+// the api_gateway/sequence_mitigation/rules endpoint.
+type APIShieldListSequenceMitigationRulesResponse struct {
+	Result     []APIShieldSequenceMitigationRule `json:"result"`
+	ResultInfo `json:"result_info"`
+	Response
+}
+
+// ListAPIShieldSequenceMitigationRules retrieves all sequence mitigation rules of a zone.
+//
+// API documentation: https://developers.cloudflare.com/api-shield/security/sequence-mitigation/api/
+func (api *API) ListAPIShieldSequenceMitigationRules(ctx context.Context, rc *ResourceContainer, params PaginationOptions) ([]APIShieldSequenceMitigationRule, ResultInfo, error) {
+	uri := buildURI(fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules", rc.Identifier), params)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, ResultInfo{}, err
+	}
+
+	var asResponse APIShieldListSequenceMitigationRulesResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return asResponse.Result, asResponse.ResultInfo, nil
+}
+
+// GetAPIShieldSequenceMitigationRule retrieves a single sequence mitigation rule.
+//
+// API documentation: https://developers.cloudflare.com/api-shield/security/sequence-mitigation/api/
+func (api *API) GetAPIShieldSequenceMitigationRule(ctx context.Context, rc *ResourceContainer, ruleID string) (*APIShieldSequenceMitigationRule, error) {
+	if ruleID == "" {
+		return nil, fmt.Errorf("rule ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules/%s", rc.Identifier, ruleID)
+
+	return api.apiShieldSequenceMitigationRuleRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateAPIShieldSequenceMitigationRule adds a sequence mitigation rule to a zone.
+//
+// API documentation: https://developers.cloudflare.com/api-shield/security/sequence-mitigation/api/
+func (api *API) CreateAPIShieldSequenceMitigationRule(ctx context.Context, rc *ResourceContainer, params APIShieldSequenceMitigationRule) (*APIShieldSequenceMitigationRule, error) {
+	if len(params.Sequence) < 2 {
+		return nil, ErrMissingAPIShieldSequence
+	}
+
+	uri := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules", rc.Identifier)
+
+	return api.apiShieldSequenceMitigationRuleRequest(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateAPIShieldSequenceMitigationRule updates certain fields on a sequence mitigation rule.
+//
+// API documentation: https://developers.cloudflare.com/api-shield/security/sequence-mitigation/api/
+func (api *API) UpdateAPIShieldSequenceMitigationRule(ctx context.Context, rc *ResourceContainer, params UpdateAPIShieldSequenceMitigationRuleParams) (*APIShieldSequenceMitigationRule, error) {
+	if params.RuleID == "" {
+		return nil, fmt.Errorf("rule ID must be provided")
+	}
+
+	if params.Sequence != nil && len(params.Sequence) < 2 {
+		return nil, ErrMissingAPIShieldSequence
+	}
+
+	uri := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules/%s", rc.Identifier, params.RuleID)
+
+	return api.apiShieldSequenceMitigationRuleRequest(ctx, http.MethodPatch, uri, params)
+}
+
+// DeleteAPIShieldSequenceMitigationRule deletes a sequence mitigation rule.
+//
+// API documentation: https://developers.cloudflare.com/api-shield/security/sequence-mitigation/api/
+func (api *API) DeleteAPIShieldSequenceMitigationRule(ctx context.Context, rc *ResourceContainer, ruleID string) error {
+	if ruleID == "" {
+		return fmt.Errorf("rule ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules/%s", rc.Identifier, ruleID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) apiShieldSequenceMitigationRuleRequest(ctx context.Context, method, uri string, params interface{}) (*APIShieldSequenceMitigationRule, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var asResponse APIShieldSequenceMitigationRuleResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return &asResponse.Result, nil
+}
@@ -0,0 +1,115 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIShieldSequenceMitigationRuleID = "5b0e6e8c-5ba7-4a2d-9a1e-0a6d5b1f4b2c"
+
+func TestCreateAPIShieldSequenceMitigationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules", testZoneID)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"title": "Checkout after cart",
+			"kind": "allow",
+			"action": "block",
+			"sequence": ["9def2cb0-3ed0-4737-92ca-f09efa4718fd", "0f7e7b54-8f76-4fd9-9b88-1e5d0ab1c0c5"],
+			"priority": 10
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"title": "Checkout after cart",
+				"kind": "allow",
+				"action": "block",
+				"sequence": ["9def2cb0-3ed0-4737-92ca-f09efa4718fd", "0f7e7b54-8f76-4fd9-9b88-1e5d0ab1c0c5"],
+				"priority": 10,
+				"enabled": true
+			}
+		}`, testAPIShieldSequenceMitigationRuleID)
+	}
+
+	mux.HandleFunc(endpoint, handler)
+
+	actual, err := client.CreateAPIShieldSequenceMitigationRule(context.Background(), ZoneIdentifier(testZoneID), APIShieldSequenceMitigationRule{
+		Title:    "Checkout after cart",
+		Kind:     APIShieldSequenceKindAllow,
+		Action:   "block",
+		Sequence: []string{"9def2cb0-3ed0-4737-92ca-f09efa4718fd", "0f7e7b54-8f76-4fd9-9b88-1e5d0ab1c0c5"},
+		Priority: IntPtr(10),
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAPIShieldSequenceMitigationRuleID, actual.ID)
+		assert.Equal(t, BoolPtr(true), actual.Enabled)
+	}
+
+	_, err = client.CreateAPIShieldSequenceMitigationRule(context.Background(), ZoneIdentifier(testZoneID), APIShieldSequenceMitigationRule{
+		Sequence: []string{"9def2cb0-3ed0-4737-92ca-f09efa4718fd"},
+	})
+	assert.Equal(t, ErrMissingAPIShieldSequence, err)
+}
+
+func TestUpdateAPIShieldSequenceMitigationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf("/zones/%s/api_gateway/sequence_mitigation/rules/%s", testZoneID, testAPIShieldSequenceMitigationRuleID)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"action": "log", "enabled": false}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"title": "Checkout after cart",
+				"kind": "allow",
+				"action": "log",
+				"sequence": ["9def2cb0-3ed0-4737-92ca-f09efa4718fd", "0f7e7b54-8f76-4fd9-9b88-1e5d0ab1c0c5"],
+				"enabled": false
+			}
+		}`, testAPIShieldSequenceMitigationRuleID)
+	}
+
+	mux.HandleFunc(endpoint, handler)
+
+	actual, err := client.UpdateAPIShieldSequenceMitigationRule(context.Background(), ZoneIdentifier(testZoneID), UpdateAPIShieldSequenceMitigationRuleParams{
+		RuleID:  testAPIShieldSequenceMitigationRuleID,
+		Action:  "log",
+		Enabled: BoolPtr(false),
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "log", actual.Action)
+		assert.Equal(t, BoolPtr(false), actual.Enabled)
+	}
+
+	_, err = client.UpdateAPIShieldSequenceMitigationRule(context.Background(), ZoneIdentifier(testZoneID), UpdateAPIShieldSequenceMitigationRuleParams{})
+	assert.Error(t, err)
+}
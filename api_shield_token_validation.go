@@ -0,0 +1,285 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// APIShieldTokenValidationConfig describes how API Shield finds and verifies JSON Web Tokens
+// sent to a zone.
+type APIShieldTokenValidationConfig struct {
+	// ID represents the ID of the configuration, formatted as UUID
+	ID string `json:"id,omitempty"`
+	// Title is a human readable name of the configuration
+	Title string `json:"title"`
+	// Description of the configuration
+	Description string `json:"description,omitempty"`
+	// TokenType is the type of token validated. This is always JWT.
+	TokenType string `json:"token_type"`
+	// TokenSources are the request locations tokens are read from, e.g.
+	// http.request.headers["authorization"][0]
+	TokenSources []string `json:"token_sources"`
+	// Credentials are the keys tokens are verified with
+	Credentials APIShieldTokenValidationCredentials `json:"credentials"`
+	// CreatedAt is the time the configuration was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// LastUpdated is the time the configuration was last updated
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// APIShieldTokenValidationCredentials are the public keys, as JSON Web Keys, that tokens are
+// verified with.
+type APIShieldTokenValidationCredentials struct {
+	Keys []map[string]any `json:"keys"`
+}
+
+// APIShieldTokenValidationRule applies token validation to the operations it selects.
+type APIShieldTokenValidationRule struct {
+	// ID represents the ID of the rule, formatted as UUID
+	ID string `json:"id,omitempty"`
+	// Title is a human readable name of the rule
+	Title string `json:"title"`
+	// Description of the rule
+	Description string `json:"description,omitempty"`
+	// Action to take on requests that fail validation, either "log" or "block"
+	Action string `json:"action"`
+	// Enabled controls if the rule is applied
+	Enabled *bool `json:"enabled,omitempty"`
+	// Expression references configurations by ID and defines when a token is valid, e.g.
+	// is_jwt_valid("52973293-cb04-4a97-8f55-e7d2ad1107dd")
+	Expression string `json:"expression"`
+	// Selector chooses the operations the rule applies to
+	Selector APIShieldTokenValidationSelector `json:"selector"`
+	// CreatedAt is the time the rule was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// LastUpdated is the time the rule was last updated
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// APIShieldTokenValidationSelector chooses operations by host or operation ID. Excluded
+// operations take precedence over included ones.
+type APIShieldTokenValidationSelector struct {
+	Include []APIShieldTokenValidationSelectorItem `json:"include,omitempty"`
+	Exclude []APIShieldTokenValidationSelectorItem `json:"exclude,omitempty"`
+}
+
+// APIShieldTokenValidationSelectorItem matches operations on any of the hosts or with any of
+// the operation IDs.
+type APIShieldTokenValidationSelectorItem struct {
+	Hosts        []string `json:"host,omitempty"`
+	OperationIDs []string `json:"operation_ids,omitempty"`
+}
+
+// UpdateAPIShieldTokenValidationConfigParams represents the parameters to pass to patch a
+// token validation configuration. Only the fields set are changed.
+type UpdateAPIShieldTokenValidationConfigParams struct {
+	// ConfigID is the configuration to be patched
+	ConfigID     string                               `json:"-"`
+	Title        string                               `json:"title,omitempty"`
+	Description  string                               `json:"description,omitempty"`
+	TokenSources []string                             `json:"token_sources,omitempty"`
+	Credentials  *APIShieldTokenValidationCredentials `json:"credentials,omitempty"`
+}
+
+// UpdateAPIShieldTokenValidationRuleParams represents the parameters to pass to patch a token
+// validation rule. Only the fields set are changed.
+type UpdateAPIShieldTokenValidationRuleParams struct {
+	// RuleID is the rule to be patched
+	RuleID      string                            `json:"-"`
+	Title       string                            `json:"title,omitempty"`
+	Description string                            `json:"description,omitempty"`
+	Action      string                            `json:"action,omitempty"`
+	Enabled     *bool                             `json:"enabled,omitempty"`
+	Expression  string                            `json:"expression,omitempty"`
+	Selector    *APIShieldTokenValidationSelector `json:"selector,omitempty"`
+}
+
+// APIShieldTokenValidationConfigResponse represents the response from the token_validation/config/{id} endpoint.
+type APIShieldTokenValidationConfigResponse struct {
+	Result APIShieldTokenValidationConfig `json:"result"`
+	Response
+}
+
+// APIShieldListTokenValidationConfigsResponse represents the response from the token_validation/config endpoint.
+type APIShieldListTokenValidationConfigsResponse struct {
+	Result     []APIShieldTokenValidationConfig `json:"result"`
+	ResultInfo `json:"result_info"`
+	Response
+}
+
+// APIShieldTokenValidationRuleResponse represents the response from the token_validation/rules/{id} endpoint.
+type APIShieldTokenValidationRuleResponse struct {
+	Result APIShieldTokenValidationRule `json:"result"`
+	Response
+}
+
+// APIShieldListTokenValidationRulesResponse represents the response from the token_validation/rules endpoint.
+type APIShieldListTokenValidationRulesResponse struct {
+	Result     []APIShieldTokenValidationRule `json:"result"`
+	ResultInfo `json:"result_info"`
+	Response
+}
+
+// ListAPIShieldTokenValidationConfigs retrieves all token validation configurations of a zone.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/configuration/methods/list/
+func (api *API) ListAPIShieldTokenValidationConfigs(ctx context.Context, rc *ResourceContainer, params PaginationOptions) ([]APIShieldTokenValidationConfig, ResultInfo, error) {
+	uri := buildURI(fmt.Sprintf("/zones/%s/token_validation/config", rc.Identifier), params)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, ResultInfo{}, err
+	}
+
+	var asResponse APIShieldListTokenValidationConfigsResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return asResponse.Result, asResponse.ResultInfo, nil
+}
+
+// GetAPIShieldTokenValidationConfig retrieves a single token validation configuration.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/configuration/methods/get/
+func (api *API) GetAPIShieldTokenValidationConfig(ctx context.Context, rc *ResourceContainer, configID string) (*APIShieldTokenValidationConfig, error) {
+	if configID == "" {
+		return nil, fmt.Errorf("config ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/config/%s", rc.Identifier, configID)
+
+	return api.apiShieldTokenValidationConfigRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateAPIShieldTokenValidationConfig adds a token validation configuration to a zone.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/configuration/methods/create/
+func (api *API) CreateAPIShieldTokenValidationConfig(ctx context.Context, rc *ResourceContainer, params APIShieldTokenValidationConfig) (*APIShieldTokenValidationConfig, error) {
+	if params.TokenType == "" {
+		params.TokenType = "JWT"
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/config", rc.Identifier)
+
+	return api.apiShieldTokenValidationConfigRequest(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateAPIShieldTokenValidationConfig updates certain fields on a token validation configuration.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/configuration/methods/edit/
+func (api *API) UpdateAPIShieldTokenValidationConfig(ctx context.Context, rc *ResourceContainer, params UpdateAPIShieldTokenValidationConfigParams) (*APIShieldTokenValidationConfig, error) {
+	if params.ConfigID == "" {
+		return nil, fmt.Errorf("config ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/config/%s", rc.Identifier, params.ConfigID)
+
+	return api.apiShieldTokenValidationConfigRequest(ctx, http.MethodPatch, uri, params)
+}
+
+// DeleteAPIShieldTokenValidationConfig deletes a token validation configuration. Rules that
+// reference it must be updated or deleted first.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/configuration/methods/delete/
+func (api *API) DeleteAPIShieldTokenValidationConfig(ctx context.Context, rc *ResourceContainer, configID string) error {
+	if configID == "" {
+		return fmt.Errorf("config ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/config/%s", rc.Identifier, configID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) apiShieldTokenValidationConfigRequest(ctx context.Context, method, uri string, params interface{}) (*APIShieldTokenValidationConfig, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var asResponse APIShieldTokenValidationConfigResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return &asResponse.Result, nil
+}
+
+// ListAPIShieldTokenValidationRules retrieves all token validation rules of a zone.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/rules/methods/list/
+func (api *API) ListAPIShieldTokenValidationRules(ctx context.Context, rc *ResourceContainer, params PaginationOptions) ([]APIShieldTokenValidationRule, ResultInfo, error) {
+	uri := buildURI(fmt.Sprintf("/zones/%s/token_validation/rules", rc.Identifier), params)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, ResultInfo{}, err
+	}
+
+	var asResponse APIShieldListTokenValidationRulesResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return asResponse.Result, asResponse.ResultInfo, nil
+}
+
+// CreateAPIShieldTokenValidationRule adds a token validation rule to a zone.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/rules/methods/create/
+func (api *API) CreateAPIShieldTokenValidationRule(ctx context.Context, rc *ResourceContainer, params APIShieldTokenValidationRule) (*APIShieldTokenValidationRule, error) {
+	uri := fmt.Sprintf("/zones/%s/token_validation/rules", rc.Identifier)
+
+	return api.apiShieldTokenValidationRuleRequest(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateAPIShieldTokenValidationRule updates certain fields on a token validation rule.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/rules/methods/edit/
+func (api *API) UpdateAPIShieldTokenValidationRule(ctx context.Context, rc *ResourceContainer, params UpdateAPIShieldTokenValidationRuleParams) (*APIShieldTokenValidationRule, error) {
+	if params.RuleID == "" {
+		return nil, fmt.Errorf("rule ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/rules/%s", rc.Identifier, params.RuleID)
+
+	return api.apiShieldTokenValidationRuleRequest(ctx, http.MethodPatch, uri, params)
+}
+
+// DeleteAPIShieldTokenValidationRule deletes a token validation rule.
+//
+// API documentation: https://developers.cloudflare.com/api/resources/token_validation/subresources/rules/methods/delete/
+func (api *API) DeleteAPIShieldTokenValidationRule(ctx context.Context, rc *ResourceContainer, ruleID string) error {
+	if ruleID == "" {
+		return fmt.Errorf("rule ID must be provided")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/token_validation/rules/%s", rc.Identifier, ruleID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) apiShieldTokenValidationRuleRequest(ctx context.Context, method, uri string, params interface{}) (*APIShieldTokenValidationRule, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var asResponse APIShieldTokenValidationRuleResponse
+	err = json.Unmarshal(res, &asResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return &asResponse.Result, nil
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIShieldTokenValidationConfigID = "52973293-cb04-4a97-8f55-e7d2ad1107dd"
+
+func TestCreateAPIShieldTokenValidationConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf("/zones/%s/token_validation/config", testZoneID)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var v map[string]any
+		require.NoError(t, json.Unmarshal(body, &v))
+		assert.Equal(t, "JWT", v["token_type"])
+		assert.Equal(t, []any{`http.request.headers["authorization"][0]`}, v["token_sources"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"title": "Auth0",
+				"token_type": "JWT",
+				"token_sources": ["http.request.headers[\"authorization\"][0]"],
+				"credentials": {"keys": [{"kty": "EC", "crv": "P-256", "kid": "a"}]}
+			}
+		}`, testAPIShieldTokenValidationConfigID)
+	}
+
+	mux.HandleFunc(endpoint, handler)
+
+	actual, err := client.CreateAPIShieldTokenValidationConfig(context.Background(), ZoneIdentifier(testZoneID), APIShieldTokenValidationConfig{
+		Title:        "Auth0",
+		TokenSources: []string{`http.request.headers["authorization"][0]`},
+		Credentials: APIShieldTokenValidationCredentials{
+			Keys: []map[string]any{{"kty": "EC", "crv": "P-256", "kid": "a"}},
+		},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAPIShieldTokenValidationConfigID, actual.ID)
+		assert.Equal(t, "P-256", actual.Credentials.Keys[0]["crv"])
+	}
+}
+
+func TestUpdateAPIShieldTokenValidationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf("/zones/%s/token_validation/rules/%s", testZoneID, "0d9bf70c-92e1-4bb3-9411-34a3bcc59003")
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"action": "block", "selector": {"include": [{"host": ["api.example.com"]}]}}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "0d9bf70c-92e1-4bb3-9411-34a3bcc59003",
+				"title": "Require JWT",
+				"action": "block",
+				"enabled": true,
+				"expression": "is_jwt_valid(\"%s\")",
+				"selector": {"include": [{"host": ["api.example.com"]}]}
+			}
+		}`, testAPIShieldTokenValidationConfigID)
+	}
+
+	mux.HandleFunc(endpoint, handler)
+
+	actual, err := client.UpdateAPIShieldTokenValidationRule(context.Background(), ZoneIdentifier(testZoneID), UpdateAPIShieldTokenValidationRuleParams{
+		RuleID: "0d9bf70c-92e1-4bb3-9411-34a3bcc59003",
+		Action: "block",
+		Selector: &APIShieldTokenValidationSelector{
+			Include: []APIShieldTokenValidationSelectorItem{{Hosts: []string{"api.example.com"}}},
+		},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "block", actual.Action)
+		assert.Equal(t, BoolPtr(true), actual.Enabled)
+		assert.Equal(t, []string{"api.example.com"}, actual.Selector.Include[0].Hosts)
+	}
+
+	_, err = client.UpdateAPIShieldTokenValidationRule(context.Background(), ZoneIdentifier(testZoneID), UpdateAPIShieldTokenValidationRuleParams{})
+	assert.Error(t, err)
+}
@@ -23,6 +23,23 @@ type APIToken struct {
 	Value      string             `json:"value,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the token value.
+func (a APIToken) String() string {
+	return redactedString(a.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the token value.
+func (a APIToken) GoString() string {
+	return redactedGoString("APIToken", a.redacted())
+}
+
+func (a APIToken) redacted() interface{} {
+	type redacted APIToken
+	r := redacted(a)
+	r.Value = redact(r.Value)
+	return r
+}
+
 // APITokenPermissionGroups is the permission groups associated with API tokens.
 type APITokenPermissionGroups struct {
 	ID     string   `json:"id"`
@@ -90,13 +107,46 @@ type APITokenVerifyBody struct {
 	ExpiresOn time.Time `json:"expires_on"`
 }
 
+// apiTokensURI returns the base URI of the user owned tokens for a nil rc and
+// of the account owned tokens for an account level rc.
+func apiTokensURI(rc *ResourceContainer) (string, error) {
+	if rc == nil {
+		return "/user/tokens", nil
+	}
+
+	if rc.Level != AccountRouteLevel {
+		return "", ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return "", ErrMissingAccountID
+	}
+
+	return fmt.Sprintf("/accounts/%s/tokens", rc.Identifier), nil
+}
+
 // GetAPIToken returns a single API token based on the ID.
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-token-details
 func (api *API) GetAPIToken(ctx context.Context, tokenID string) (APIToken, error) {
-	uri := fmt.Sprintf("/user/tokens/%s", tokenID)
+	return api.getAPIToken(ctx, nil, tokenID)
+}
+
+// GetAccountAPIToken returns a single account owned API token based on the
+// ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-token-details
+func (api *API) GetAccountAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) (APIToken, error) {
+	return api.getAPIToken(ctx, rc, tokenID)
+}
+
+func (api *API) getAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) (APIToken, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return APIToken{}, err
+	}
 
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, baseURI+"/"+tokenID, nil)
 	if err != nil {
 		return APIToken{}, err
 	}
@@ -114,7 +164,23 @@ func (api *API) GetAPIToken(ctx context.Context, tokenID string) (APIToken, erro
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-list-tokens
 func (api *API) APITokens(ctx context.Context) ([]APIToken, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodGet, "/user/tokens", nil)
+	return api.listAPITokens(ctx, nil)
+}
+
+// ListAccountAPITokens returns the API tokens owned by an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-list-tokens
+func (api *API) ListAccountAPITokens(ctx context.Context, rc *ResourceContainer) ([]APIToken, error) {
+	return api.listAPITokens(ctx, rc)
+}
+
+func (api *API) listAPITokens(ctx context.Context, rc *ResourceContainer) ([]APIToken, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return []APIToken{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, baseURI, nil)
 	if err != nil {
 		return []APIToken{}, err
 	}
@@ -137,7 +203,24 @@ func (api *API) APITokens(ctx context.Context) ([]APIToken, error) {
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-create-token
 func (api *API) CreateAPIToken(ctx context.Context, token APIToken) (APIToken, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodPost, "/user/tokens", token)
+	return api.createAPIToken(ctx, nil, token)
+}
+
+// CreateAccountAPIToken creates a new account owned token. As with
+// CreateAPIToken, the token value is only returned once.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-create-token
+func (api *API) CreateAccountAPIToken(ctx context.Context, rc *ResourceContainer, token APIToken) (APIToken, error) {
+	return api.createAPIToken(ctx, rc, token)
+}
+
+func (api *API) createAPIToken(ctx context.Context, rc *ResourceContainer, token APIToken) (APIToken, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, baseURI, token)
 	if err != nil {
 		return APIToken{}, err
 	}
@@ -155,7 +238,23 @@ func (api *API) CreateAPIToken(ctx context.Context, token APIToken) (APIToken, e
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-update-token
 func (api *API) UpdateAPIToken(ctx context.Context, tokenID string, token APIToken) (APIToken, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodPut, "/user/tokens/"+tokenID, token)
+	return api.updateAPIToken(ctx, nil, tokenID, token)
+}
+
+// UpdateAccountAPIToken updates an existing account owned API token.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-update-token
+func (api *API) UpdateAccountAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string, token APIToken) (APIToken, error) {
+	return api.updateAPIToken(ctx, rc, tokenID, token)
+}
+
+func (api *API) updateAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string, token APIToken) (APIToken, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, baseURI+"/"+tokenID, token)
 	if err != nil {
 		return APIToken{}, err
 	}
@@ -173,7 +272,24 @@ func (api *API) UpdateAPIToken(ctx context.Context, tokenID string, token APITok
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-roll-token
 func (api *API) RollAPIToken(ctx context.Context, tokenID string) (string, error) {
-	uri := fmt.Sprintf("/user/tokens/%s/value", tokenID)
+	return api.rollAPIToken(ctx, nil, tokenID)
+}
+
+// RollAccountAPIToken rolls the credential associated with an account owned
+// token and returns the new value.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-roll-token
+func (api *API) RollAccountAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) (string, error) {
+	return api.rollAPIToken(ctx, rc, tokenID)
+}
+
+func (api *API) rollAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) (string, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return "", err
+	}
+
+	uri := fmt.Sprintf("%s/%s/value", baseURI, tokenID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, nil)
 	if err != nil {
@@ -193,7 +309,24 @@ func (api *API) RollAPIToken(ctx context.Context, tokenID string) (string, error
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-verify-token
 func (api *API) VerifyAPIToken(ctx context.Context) (APITokenVerifyBody, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodGet, "/user/tokens/verify", nil)
+	return api.verifyAPIToken(ctx, nil)
+}
+
+// VerifyAccountAPIToken tests the validity of the account owned token the
+// client authenticates with.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-verify-token
+func (api *API) VerifyAccountAPIToken(ctx context.Context, rc *ResourceContainer) (APITokenVerifyBody, error) {
+	return api.verifyAPIToken(ctx, rc)
+}
+
+func (api *API) verifyAPIToken(ctx context.Context, rc *ResourceContainer) (APITokenVerifyBody, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return APITokenVerifyBody{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, baseURI+"/verify", nil)
 	if err != nil {
 		return APITokenVerifyBody{}, err
 	}
@@ -211,7 +344,23 @@ func (api *API) VerifyAPIToken(ctx context.Context) (APITokenVerifyBody, error)
 //
 // API reference: https://api.cloudflare.com/#user-api-tokens-delete-token
 func (api *API) DeleteAPIToken(ctx context.Context, tokenID string) error {
-	_, err := api.makeRequestContext(ctx, http.MethodDelete, "/user/tokens/"+tokenID, nil)
+	return api.deleteAPIToken(ctx, nil, tokenID)
+}
+
+// DeleteAccountAPIToken deletes a single account owned API token.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-delete-token
+func (api *API) DeleteAccountAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) error {
+	return api.deleteAPIToken(ctx, rc, tokenID)
+}
+
+func (api *API) deleteAPIToken(ctx context.Context, rc *ResourceContainer, tokenID string) error {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, baseURI+"/"+tokenID, nil)
 	if err != nil {
 		return err
 	}
@@ -223,8 +372,25 @@ func (api *API) DeleteAPIToken(ctx context.Context, tokenID string) error {
 //
 // API reference: https://api.cloudflare.com/#permission-groups-list-permission-groups
 func (api *API) ListAPITokensPermissionGroups(ctx context.Context) ([]APITokenPermissionGroups, error) {
+	return api.listAPITokensPermissionGroups(ctx, nil)
+}
+
+// ListAccountAPITokensPermissionGroups returns the permission groups
+// available to account owned API tokens.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-api-tokens-list-permission-groups
+func (api *API) ListAccountAPITokensPermissionGroups(ctx context.Context, rc *ResourceContainer) ([]APITokenPermissionGroups, error) {
+	return api.listAPITokensPermissionGroups(ctx, rc)
+}
+
+func (api *API) listAPITokensPermissionGroups(ctx context.Context, rc *ResourceContainer) ([]APITokenPermissionGroups, error) {
+	baseURI, err := apiTokensURI(rc)
+	if err != nil {
+		return []APITokenPermissionGroups{}, err
+	}
+
 	var r APITokenPermissionGroupsResponse
-	res, err := api.makeRequestContext(ctx, http.MethodGet, "/user/tokens/permission_groups", nil)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, baseURI+"/permission_groups", nil)
 	if err != nil {
 		return []APITokenPermissionGroups{}, err
 	}
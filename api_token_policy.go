@@ -0,0 +1,68 @@
+package cloudflare
+
+import "time"
+
+// APITokenResource is a resource an API token policy applies to. Use the
+// APIToken...Resource functions to build one.
+type APITokenResource struct {
+	key   string
+	value interface{}
+}
+
+// APITokenAccountResource is the account with accountID.
+func APITokenAccountResource(accountID string) APITokenResource {
+	return APITokenResource{key: apiTokenAccountResourcePrefix + accountID, value: "*"}
+}
+
+// APITokenZoneResource is the zone with zoneID.
+func APITokenZoneResource(zoneID string) APITokenResource {
+	return APITokenResource{key: apiTokenZoneResourcePrefix + zoneID, value: "*"}
+}
+
+// APITokenAllZonesResource is every zone the token owner has access to.
+func APITokenAllZonesResource() APITokenResource {
+	return APITokenResource{key: apiTokenZoneResourcePrefix + "*", value: "*"}
+}
+
+// APITokenAccountZonesResource is every zone of the account with accountID.
+func APITokenAccountZonesResource(accountID string) APITokenResource {
+	return APITokenResource{
+		key:   apiTokenAccountResourcePrefix + accountID,
+		value: map[string]interface{}{apiTokenZoneResourcePrefix + "*": "*"},
+	}
+}
+
+// NewAPITokenPolicy builds a policy that allows or denies, according to
+// effect, PolicyAccessAllow or PolicyAccessDeny, the permission groups with permissionGroupIDs on resources.
+func NewAPITokenPolicy(effect string, permissionGroupIDs []string, resources ...APITokenResource) APITokenPolicies {
+	permissionGroups := make([]APITokenPermissionGroups, 0, len(permissionGroupIDs))
+	for _, id := range permissionGroupIDs {
+		permissionGroups = append(permissionGroups, APITokenPermissionGroups{ID: id})
+	}
+
+	r := make(map[string]interface{}, len(resources))
+	for _, resource := range resources {
+		r[resource.key] = resource.value
+	}
+
+	return APITokenPolicies{
+		Effect:           effect,
+		Resources:        r,
+		PermissionGroups: permissionGroups,
+	}
+}
+
+// NewAPITokenIPCondition restricts the use of a token to clients in the IP
+// ranges of in and outside those of notIn. Either may be empty.
+func NewAPITokenIPCondition(in, notIn []string) *APITokenCondition {
+	return &APITokenCondition{
+		RequestIP: &APITokenRequestIPCondition{In: in, NotIn: notIn},
+	}
+}
+
+// APITokenExpiresIn returns an expiry for APIToken.ExpiresOn ttl from now,
+// truncated to the second as the API does not accept fractional seconds.
+func APITokenExpiresIn(ttl time.Duration) *time.Time {
+	t := time.Now().Add(ttl).UTC().Truncate(time.Second)
+	return &t
+}
@@ -0,0 +1,172 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	apiTokenAccountResourcePrefix = "com.cloudflare.api.account."
+	apiTokenZoneResourcePrefix    = "com.cloudflare.api.account.zone."
+)
+
+var (
+	ErrPreflightRequiresAPIToken = errors.New("API token preflight requires the client to authenticate with an API token")
+	ErrAPITokenNotActive         = errors.New("API token is not active")
+)
+
+// APITokenCapability maps a group of SDK methods to the API token permission
+// group required to call them.
+type APITokenCapability struct {
+	Name            string
+	PermissionGroup string
+}
+
+// Common capabilities checked by PreflightAPIToken when none are provided.
+var (
+	APITokenCapabilityZoneRead          = APITokenCapability{Name: "zone read", PermissionGroup: "Zone Read"}
+	APITokenCapabilityZoneEdit          = APITokenCapability{Name: "zone edit", PermissionGroup: "Zone Write"}
+	APITokenCapabilityZoneSettingsEdit  = APITokenCapability{Name: "zone settings edit", PermissionGroup: "Zone Settings Write"}
+	APITokenCapabilityDNSRead           = APITokenCapability{Name: "dns read", PermissionGroup: "DNS Read"}
+	APITokenCapabilityDNSEdit           = APITokenCapability{Name: "dns edit", PermissionGroup: "DNS Write"}
+	APITokenCapabilityCachePurge        = APITokenCapability{Name: "cache purge", PermissionGroup: "Cache Purge"}
+	APITokenCapabilityFirewallEdit      = APITokenCapability{Name: "firewall edit", PermissionGroup: "Firewall Services Write"}
+	APITokenCapabilitySSLEdit           = APITokenCapability{Name: "ssl and certificates edit", PermissionGroup: "SSL and Certificates Write"}
+	APITokenCapabilityWorkersEdit       = APITokenCapability{Name: "workers scripts edit", PermissionGroup: "Workers Scripts Write"}
+	APITokenCapabilityAccountRead       = APITokenCapability{Name: "account settings read", PermissionGroup: "Account Settings Read"}
+	APITokenCapabilityLoadBalancersEdit = APITokenCapability{Name: "load balancers edit", PermissionGroup: "Load Balancers Write"}
+
+	DefaultAPITokenCapabilities = []APITokenCapability{
+		APITokenCapabilityZoneRead,
+		APITokenCapabilityZoneEdit,
+		APITokenCapabilityZoneSettingsEdit,
+		APITokenCapabilityDNSRead,
+		APITokenCapabilityDNSEdit,
+		APITokenCapabilityCachePurge,
+		APITokenCapabilityFirewallEdit,
+		APITokenCapabilitySSLEdit,
+		APITokenCapabilityWorkersEdit,
+		APITokenCapabilityAccountRead,
+		APITokenCapabilityLoadBalancersEdit,
+	}
+)
+
+// APITokenPreflightResult is the outcome of checking a single capability.
+type APITokenPreflightResult struct {
+	APITokenCapability
+	Allowed bool
+}
+
+// PreflightAPIToken verifies the configured API token and reports, for each
+// capability, whether the token's policies permit it on rc. When no
+// capabilities are provided, DefaultAPITokenCapabilities are checked.
+//
+// Reading the token's own policies requires the token to include the "API
+// Tokens Read" permission group.
+func (api *API) PreflightAPIToken(ctx context.Context, rc *ResourceContainer, capabilities ...APITokenCapability) ([]APITokenPreflightResult, error) {
+	if api.authType&AuthToken == 0 {
+		return []APITokenPreflightResult{}, ErrPreflightRequiresAPIToken
+	}
+
+	verify, err := api.VerifyAPIToken(ctx)
+	if err != nil {
+		return []APITokenPreflightResult{}, err
+	}
+
+	if verify.Status != "active" {
+		return []APITokenPreflightResult{}, fmt.Errorf("%w: %s", ErrAPITokenNotActive, verify.Status)
+	}
+
+	token, err := api.GetAPIToken(ctx, verify.ID)
+	if err != nil {
+		return []APITokenPreflightResult{}, fmt.Errorf("failed to read API token policies: %w", err)
+	}
+
+	if len(capabilities) == 0 {
+		capabilities = DefaultAPITokenCapabilities
+	}
+
+	results := make([]APITokenPreflightResult, 0, len(capabilities))
+	for _, c := range capabilities {
+		results = append(results, APITokenPreflightResult{
+			APITokenCapability: c,
+			Allowed:            APITokenPermits(token.Policies, c.PermissionGroup, rc),
+		})
+	}
+
+	return results, nil
+}
+
+// APITokenPermits reports whether policies grant permissionGroup on the
+// resource identified by rc. Any matching deny policy takes precedence over
+// allow policies.
+//
+// Account wide grants are assumed to cover a zone level rc as the account
+// owning a zone is not known from its identifier alone.
+func APITokenPermits(policies []APITokenPolicies, permissionGroup string, rc *ResourceContainer) bool {
+	allowed := false
+
+	for _, p := range policies {
+		if !apiTokenPolicyHasPermissionGroup(p, permissionGroup) || !apiTokenResourcesMatch(p.Resources, rc) {
+			continue
+		}
+
+		switch strings.ToLower(p.Effect) {
+		case PolicyAccessDeny:
+			return false
+		case PolicyAccessAllow:
+			allowed = true
+		}
+	}
+
+	return allowed
+}
+
+func apiTokenPolicyHasPermissionGroup(p APITokenPolicies, permissionGroup string) bool {
+	for _, pg := range p.PermissionGroups {
+		if strings.EqualFold(pg.Name, permissionGroup) || pg.ID == permissionGroup {
+			return true
+		}
+	}
+
+	return false
+}
+
+func apiTokenResourcesMatch(resources map[string]interface{}, rc *ResourceContainer) bool {
+	for key, value := range resources {
+		switch {
+		case strings.HasPrefix(key, apiTokenZoneResourcePrefix):
+			if rc.Level != ZoneRouteLevel {
+				continue
+			}
+
+			id := strings.TrimPrefix(key, apiTokenZoneResourcePrefix)
+			if id == "*" || id == rc.Identifier {
+				return true
+			}
+		case strings.HasPrefix(key, apiTokenAccountResourcePrefix):
+			id := strings.TrimPrefix(key, apiTokenAccountResourcePrefix)
+
+			if rc.Level == AccountRouteLevel && (id == "*" || id == rc.Identifier) {
+				return true
+			}
+
+			if rc.Level == ZoneRouteLevel {
+				if nested, ok := value.(map[string]interface{}); ok {
+					if apiTokenResourcesMatch(nested, rc) {
+						return true
+					}
+					continue
+				}
+
+				if value == "*" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
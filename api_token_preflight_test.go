@@ -0,0 +1,135 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokenPermits(t *testing.T) {
+	policies := []APITokenPolicies{
+		{
+			Effect: "allow",
+			Resources: map[string]interface{}{
+				"com.cloudflare.api.account.zone." + testZoneID: "*",
+			},
+			PermissionGroups: []APITokenPermissionGroups{
+				{ID: "c8fed203ed3043cba015a93ad1616f1f", Name: "Zone Read"},
+				{ID: "4755a26eedb94da69e1066d98aa820be", Name: "DNS Write"},
+			},
+		},
+		{
+			Effect: "allow",
+			Resources: map[string]interface{}{
+				"com.cloudflare.api.account." + testAccountID: map[string]interface{}{
+					"com.cloudflare.api.account.zone.*": "*",
+				},
+			},
+			PermissionGroups: []APITokenPermissionGroups{
+				{ID: "e17beae8b8cb423a99b1730f21238bed", Name: "Cache Purge"},
+			},
+		},
+		{
+			Effect: "allow",
+			Resources: map[string]interface{}{
+				"com.cloudflare.api.account." + testAccountID: "*",
+			},
+			PermissionGroups: []APITokenPermissionGroups{
+				{ID: "e086da7e2179491d91ee5f35b3ca210a", Name: "Workers Scripts Write"},
+			},
+		},
+		{
+			Effect: "deny",
+			Resources: map[string]interface{}{
+				"com.cloudflare.api.account.zone." + testZoneID: "*",
+			},
+			PermissionGroups: []APITokenPermissionGroups{
+				{ID: "e17beae8b8cb423a99b1730f21238bed", Name: "Cache Purge"},
+			},
+		},
+	}
+
+	assert.True(t, APITokenPermits(policies, "Zone Read", testZoneRC))
+	assert.True(t, APITokenPermits(policies, "dns write", testZoneRC))
+	assert.False(t, APITokenPermits(policies, "Zone Read", ZoneIdentifier("9a7806061c88ada191ed06f989cc3dac")))
+	assert.False(t, APITokenPermits(policies, "Zone Read", testAccountRC))
+	assert.False(t, APITokenPermits(policies, "Cache Purge", testZoneRC), "deny policy should take precedence")
+	assert.True(t, APITokenPermits(policies, "Cache Purge", ZoneIdentifier("9a7806061c88ada191ed06f989cc3dac")))
+	assert.True(t, APITokenPermits(policies, "Workers Scripts Write", testAccountRC))
+	assert.False(t, APITokenPermits(policies, "Workers Scripts Write", AccountIdentifier("9a7806061c88ada191ed06f989cc3dac")))
+}
+
+func TestPreflightAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.APIToken = "token"
+	client.SetAuthType(AuthToken)
+
+	mux.HandleFunc("/user/tokens/verify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ed17574386854bf78a67040be0a770b0",
+				"status": "active"
+			}
+		}`)
+	})
+
+	mux.HandleFunc("/user/tokens/ed17574386854bf78a67040be0a770b0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ed17574386854bf78a67040be0a770b0",
+				"name": "readonly token",
+				"status": "active",
+				"policies": [
+					{
+						"id": "f267e341f3dd4697bd3b9f71dd96247f",
+						"effect": "allow",
+						"resources": {
+							"com.cloudflare.api.account.zone.%s": "*"
+						},
+						"permission_groups": [
+							{ "id": "c8fed203ed3043cba015a93ad1616f1f", "name": "Zone Read" },
+							{ "id": "82e64a83756745bbbb1c9c2701bf816b", "name": "DNS Read" }
+						]
+					}
+				]
+			}
+		}`, testZoneID)
+	})
+
+	actual, err := client.PreflightAPIToken(context.Background(), testZoneRC, APITokenCapabilityZoneRead, APITokenCapabilityDNSRead, APITokenCapabilityDNSEdit)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []APITokenPreflightResult{
+			{APITokenCapability: APITokenCapabilityZoneRead, Allowed: true},
+			{APITokenCapability: APITokenCapabilityDNSRead, Allowed: true},
+			{APITokenCapability: APITokenCapabilityDNSEdit, Allowed: false},
+		}, actual)
+	}
+
+	actual, err = client.PreflightAPIToken(context.Background(), testZoneRC)
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, len(DefaultAPITokenCapabilities))
+	}
+}
+
+func TestPreflightAPIToken_RequiresAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.PreflightAPIToken(context.Background(), testZoneRC)
+	assert.ErrorIs(t, err, ErrPreflightRequiresAPIToken)
+}
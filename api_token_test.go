@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -520,3 +521,84 @@ func TestListAPITokensPermissionGroups(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestCreateAccountAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, fmt.Sprintf(`{
+			"name": "ci deploys",
+			"policies": [{
+				"effect": "allow",
+				"resources": {"com.cloudflare.api.account.%s": {"com.cloudflare.api.account.zone.*": "*"}},
+				"permission_groups": [{"id": "82e64a83756745bbbb1c9c2701bf816b"}]
+			}],
+			"condition": {"request.ip": {"in": ["192.0.2.0/24"]}}
+		}`, testAccountID), string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "ed17574386854bf78a67040be0a770b0", "name": "ci deploys", "status": "active", "value": "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/tokens", handler)
+
+	actual, err := client.CreateAccountAPIToken(context.Background(), AccountIdentifier(testAccountID), APIToken{
+		Name: "ci deploys",
+		Policies: []APITokenPolicies{
+			NewAPITokenPolicy(PolicyAccessAllow, []string{"82e64a83756745bbbb1c9c2701bf816b"}, APITokenAccountZonesResource(testAccountID)),
+		},
+		Condition: NewAPITokenIPCondition([]string{"192.0.2.0/24"}, nil),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T", actual.Value)
+	}
+
+	_, err = client.CreateAccountAPIToken(context.Background(), ZoneIdentifier(testZoneID), APIToken{})
+	assert.Equal(t, ErrRequiredAccountLevelResourceContainer, err)
+}
+
+func TestRollAccountAPIToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/tokens/ed17574386854bf78a67040be0a770b0/value", handler)
+
+	actual, err := client.RollAccountAPIToken(context.Background(), AccountIdentifier(testAccountID), "ed17574386854bf78a67040be0a770b0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T", actual)
+	}
+}
+
+func TestNewAPITokenPolicy(t *testing.T) {
+	policy := NewAPITokenPolicy(PolicyAccessDeny, []string{"c8fed203ed3043cba015a93ad1616f1f"},
+		APITokenZoneResource(testZoneID),
+		APITokenAccountResource(testAccountID),
+	)
+
+	assert.Equal(t, APITokenPolicies{
+		Effect: "deny",
+		Resources: map[string]interface{}{
+			"com.cloudflare.api.account.zone." + testZoneID: "*",
+			"com.cloudflare.api.account." + testAccountID:   "*",
+		},
+		PermissionGroups: []APITokenPermissionGroups{{ID: "c8fed203ed3043cba015a93ad1616f1f"}},
+	}, policy)
+
+	expiresOn := APITokenExpiresIn(time.Hour)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *expiresOn, time.Second)
+	assert.Zero(t, expiresOn.Nanosecond())
+}
@@ -71,7 +71,7 @@ func (api *API) UpdateArgoSmartRouting(ctx context.Context, zoneID, settingValue
 
 // ArgoTieredCaching returns the current settings for tiered caching.
 //
-// API reference: TBA.
+// API reference: https://developers.cloudflare.com/api/operations/tiered-caching-get-tiered-caching-setting
 func (api *API) ArgoTieredCaching(ctx context.Context, zoneID string) (ArgoFeatureSetting, error) {
 	uri := fmt.Sprintf("/zones/%s/argo/tiered_caching", zoneID)
 
@@ -90,7 +90,7 @@ func (api *API) ArgoTieredCaching(ctx context.Context, zoneID string) (ArgoFeatu
 
 // UpdateArgoTieredCaching updates the setting for tiered caching.
 //
-// API reference: TBA.
+// API reference: https://developers.cloudflare.com/api/operations/tiered-caching-patch-tiered-caching-setting
 func (api *API) UpdateArgoTieredCaching(ctx context.Context, zoneID, settingValue string) (ArgoFeatureSetting, error) {
 	if !contains(validSettingValues, settingValue) {
 		return ArgoFeatureSetting{}, fmt.Errorf("invalid setting value '%s'. must be 'on' or 'off'", settingValue)
@@ -111,6 +111,66 @@ func (api *API) UpdateArgoTieredCaching(ctx context.Context, zoneID, settingValu
 	return argoDetailsResponse.Result, nil
 }
 
+// ArgoAnalyticsParams configures the Argo latency histograms.
+type ArgoAnalyticsParams struct {
+	// Bins is the number of histogram bins, defaulting to the API's choice.
+	Bins int `url:"bins,omitempty"`
+}
+
+// ArgoAnalytics is the time to first byte of requests routed by Argo
+// compared with those routed directly, used to estimate the latency
+// improvement. Data is returned as is since its layout is not documented
+// by the API.
+type ArgoAnalytics struct {
+	ArgoSmartRouting bool            `json:"argoSmartRouting"`
+	Data             json.RawMessage `json:"data"`
+}
+
+// ArgoAnalyticsResponse is the API response, containing Argo analytics.
+type ArgoAnalyticsResponse struct {
+	Response
+	Result ArgoAnalytics `json:"result"`
+}
+
+// ArgoAnalytics returns the latency histograms of a zone with and without
+// Argo Smart Routing.
+//
+// API reference: https://developers.cloudflare.com/api/operations/argo-analytics-for-zone-argo-analytics-for-a-zone
+func (api *API) ArgoAnalytics(ctx context.Context, rc *ResourceContainer, params ArgoAnalyticsParams) (ArgoAnalytics, error) {
+	return api.argoAnalytics(ctx, rc, "/analytics/latency", params)
+}
+
+// ArgoAnalyticsByColo returns the latency histograms of a zone with and
+// without Argo Smart Routing, split by data center.
+//
+// API reference: https://developers.cloudflare.com/api/operations/argo-analytics-for-geolocation-argo-analytics-for-a-zone-at-different-po-ps
+func (api *API) ArgoAnalyticsByColo(ctx context.Context, rc *ResourceContainer) (ArgoAnalytics, error) {
+	return api.argoAnalytics(ctx, rc, "/analytics/latency/colos", nil)
+}
+
+func (api *API) argoAnalytics(ctx context.Context, rc *ResourceContainer, path string, params interface{}) (ArgoAnalytics, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ArgoAnalytics{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ArgoAnalytics{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s%s", rc.Identifier, path), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ArgoAnalytics{}, err
+	}
+
+	var r ArgoAnalyticsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ArgoAnalytics{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
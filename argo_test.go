@@ -177,3 +177,33 @@ func TestUpdateArgoTieredCachingWithInvalidValue(t *testing.T) {
 		assert.Equal(t, "invalid setting value 'notreal'. must be 'on' or 'off'", err.Error())
 	}
 }
+
+func TestArgoAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "10", r.URL.Query().Get("bins"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"argoSmartRouting": true,
+				"data": {"argo": [1, 2], "direct": [3, 4]}
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/01a7362d577a6c3019a474fd6f485823/analytics/latency", handler)
+
+	actual, err := client.ArgoAnalytics(context.Background(), ZoneIdentifier("01a7362d577a6c3019a474fd6f485823"), ArgoAnalyticsParams{Bins: 10})
+
+	if assert.NoError(t, err) {
+		assert.True(t, actual.ArgoSmartRouting)
+		assert.JSONEq(t, `{"argo": [1, 2], "direct": [3, 4]}`, string(actual.Data))
+	}
+}
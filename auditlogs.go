@@ -2,6 +2,8 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
@@ -63,6 +65,7 @@ type AuditLogFilter struct {
 	ID           string
 	ActorIP      string
 	ActorEmail   string
+	ActionType   string
 	HideUserLogs bool
 	Direction    string
 	ZoneName     string
@@ -87,6 +90,9 @@ func (a AuditLogFilter) ToQuery() url.Values {
 	if a.ActorEmail != "" {
 		v.Add("actor.email", a.ActorEmail)
 	}
+	if a.ActionType != "" {
+		v.Add("action.type", a.ActionType)
+	}
 	if a.HideUserLogs {
 		v.Add("hide_user_logs", "true")
 	}
@@ -156,3 +162,152 @@ func (api *API) GetUserAuditLogs(ctx context.Context, a AuditLogFilter) (AuditLo
 	}
 	return unmarshalReturn(res)
 }
+
+var ErrMissingAuditLogsTimeRange = errors.New("required audit logs time range (since and before) is missing")
+
+// AccountAuditLog is an entry of the account audit logs.
+type AccountAuditLog struct {
+	ID      string `json:"id"`
+	Account struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"account"`
+	Action struct {
+		Description string    `json:"description"`
+		Result      string    `json:"result"`
+		Time        time.Time `json:"time"`
+		Type        string    `json:"type"`
+	} `json:"action"`
+	Actor struct {
+		ID        string `json:"id"`
+		Context   string `json:"context"`
+		Email     string `json:"email"`
+		IPAddress string `json:"ip_address"`
+		TokenID   string `json:"token_id"`
+		TokenName string `json:"token_name"`
+		Type      string `json:"type"`
+	} `json:"actor"`
+	Resource struct {
+		ID       string          `json:"id"`
+		Product  string          `json:"product"`
+		Scope    json.RawMessage `json:"scope,omitempty"`
+		Type     string          `json:"type"`
+		Request  json.RawMessage `json:"request,omitempty"`
+		Response json.RawMessage `json:"response,omitempty"`
+	} `json:"resource"`
+	Zone struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"zone"`
+}
+
+// ListAccountAuditLogsParams filters the account audit logs. Since and
+// Before are required, the other filters match any of their values.
+type ListAccountAuditLogsParams struct {
+	Since  time.Time `url:"since"`
+	Before time.Time `url:"before"`
+
+	ActorEmail   []string `url:"actor_email,omitempty"`
+	ActorIP      []string `url:"actor_ip_address,omitempty"`
+	ActorType    []string `url:"actor_type,omitempty"`
+	ActionType   []string `url:"action_type,omitempty"`
+	ActionResult []string `url:"action_result,omitempty"`
+	ResourceID   []string `url:"resource_id,omitempty"`
+	ResourceType []string `url:"resource_type,omitempty"`
+	ZoneName     []string `url:"zone_name,omitempty"`
+
+	// Direction orders the logs by time, "desc" (the default) or "asc".
+	Direction string `url:"direction,omitempty"`
+
+	// Limit is the number of logs fetched per request.
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor resumes listing from a previous page. Every following page is
+	// fetched regardless.
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// AccountAuditLogsResponse is the API response, containing a page of account
+// audit logs. Cursor is empty on the last page.
+type AccountAuditLogsResponse struct {
+	Response
+	Result     []AccountAuditLog `json:"result"`
+	ResultInfo struct {
+		Cursor string `json:"cursor"`
+	} `json:"result_info"`
+}
+
+// ListAccountAuditLogs returns the audit logs of an account matching the
+// filters, following the cursor until every page has been fetched.
+//
+// API reference: https://developers.cloudflare.com/api/operations/audit-logs-v2-get-account-audit-logs
+func (api *API) ListAccountAuditLogs(ctx context.Context, rc *ResourceContainer, params ListAccountAuditLogsParams) ([]AccountAuditLog, error) {
+	if rc.Level != AccountRouteLevel {
+		return []AccountAuditLog{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []AccountAuditLog{}, ErrMissingAccountID
+	}
+
+	if params.Since.IsZero() || params.Before.IsZero() {
+		return []AccountAuditLog{}, ErrMissingAuditLogsTimeRange
+	}
+
+	params.Since = params.Since.UTC()
+	params.Before = params.Before.UTC()
+
+	var logs []AccountAuditLog
+	for {
+		uri := buildURI(fmt.Sprintf("/accounts/%s/logs/audit", rc.Identifier), params)
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []AccountAuditLog{}, err
+		}
+
+		var r AccountAuditLogsResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []AccountAuditLog{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		logs = append(logs, r.Result...)
+		if r.ResultInfo.Cursor == "" || len(r.Result) == 0 {
+			break
+		}
+		params.Cursor = r.ResultInfo.Cursor
+	}
+
+	return logs, nil
+}
+
+// ListUserAuditLogs returns every audit log of your user matching the
+// filter, fetching the following pages from the page set in the filter
+// onwards.
+//
+// API Reference: https://api.cloudflare.com/#audit-logs-list-user-audit-logs
+func (api *API) ListUserAuditLogs(ctx context.Context, a AuditLogFilter) ([]AuditLog, error) {
+	if a.PerPage < 1 {
+		a.PerPage = 100
+	}
+	if a.Page < 1 {
+		a.Page = 1
+	}
+
+	var logs []AuditLog
+	for {
+		r, err := api.GetUserAuditLogs(ctx, a)
+		if err != nil {
+			return []AuditLog{}, err
+		}
+
+		logs = append(logs, r.Result...)
+		if len(r.Result) == 0 || !r.ResultInfo.HasMorePages() {
+			break
+		}
+		a.Page = r.ResultInfo.Page + 1
+	}
+
+	return logs, nil
+}
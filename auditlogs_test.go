@@ -1,8 +1,15 @@
 package cloudflare
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAuditLogFilterToQuery(t *testing.T) {
@@ -58,3 +65,85 @@ func TestAuditLogFilterToQuery(t *testing.T) {
 		t.Fatalf("Did not properly stringify the page field: %s", filter.ToQuery().Encode())
 	}
 }
+
+func TestListAccountAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "2024-10-01T00:00:00Z", q.Get("since"))
+		assert.Equal(t, "2024-10-02T00:00:00Z", q.Get("before"))
+		assert.Equal(t, []string{"create", "delete"}, q["action_type"])
+		assert.Equal(t, "admin@example.com", q.Get("actor_email"))
+
+		cursor, result := "Q1buH-__DQqqig7SVYXT-SsMOTGY2Z3Y80W-fGgva7yaDdmPKveucH5ddOcHsJRhNb-xUK8agZQqkJSMAENGO8NU6g==", "0a8bd3b8-2d5f-4a7d-9ec5-cb37e0b0a4b6"
+		if q.Get("cursor") != "" {
+			assert.Equal(t, cursor, q.Get("cursor"))
+			cursor, result = "", "43c1d8e0-b8f9-4bf7-8b6c-7a05c1af8c05"
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"result": [
+				{
+					"id": "%s",
+					"account": {"id": "%s", "name": "Example"},
+					"action": {"description": "Add Member", "result": "success", "time": "2024-10-01T12:00:00Z", "type": "create"},
+					"actor": {"email": "admin@example.com", "ip_address": "192.0.2.1", "type": "user"},
+					"resource": {"id": "4536bcfad5faccb111b47003c79917fa", "product": "members", "type": "member"}
+				}
+			],
+			"result_info": {"count": "1", "cursor": "%s"}
+		}`, result, testAccountID, cursor)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logs/audit", handler)
+
+	logs, err := client.ListAccountAuditLogs(context.Background(), AccountIdentifier(testAccountID), ListAccountAuditLogsParams{
+		Since:      time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		Before:     time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC),
+		ActorEmail: []string{"admin@example.com"},
+		ActionType: []string{"create", "delete"},
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, logs, 2)
+		assert.Equal(t, "43c1d8e0-b8f9-4bf7-8b6c-7a05c1af8c05", logs[1].ID)
+		assert.Equal(t, "members", logs[0].Resource.Product)
+		assert.Equal(t, "192.0.2.1", logs[0].Actor.IPAddress)
+	}
+
+	_, err = client.ListAccountAuditLogs(context.Background(), AccountIdentifier(testAccountID), ListAccountAuditLogsParams{})
+	assert.Equal(t, ErrMissingAuditLogsTimeRange, err)
+}
+
+func TestListUserAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "delete", r.URL.Query().Get("action.type"))
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "log-%s", "action": {"type": "delete", "result": true}}],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2}
+		}`, page, page)
+	}
+
+	mux.HandleFunc("/user/audit_logs", handler)
+
+	logs, err := client.ListUserAuditLogs(context.Background(), AuditLogFilter{ActionType: "delete", PerPage: 1})
+	if assert.NoError(t, err) {
+		require.Len(t, logs, 2)
+		assert.Equal(t, "log-2", logs[1].ID)
+	}
+}
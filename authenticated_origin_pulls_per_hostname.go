@@ -63,6 +63,23 @@ type PerHostnameAuthenticatedOriginPullsCertificateParams struct {
 	PrivateKey  string `json:"private_key"`
 }
 
+// String implements fmt.Stringer, redacting the private key.
+func (p PerHostnameAuthenticatedOriginPullsCertificateParams) String() string {
+	return redactedString(p.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the private key.
+func (p PerHostnameAuthenticatedOriginPullsCertificateParams) GoString() string {
+	return redactedGoString("PerHostnameAuthenticatedOriginPullsCertificateParams", p.redacted())
+}
+
+func (p PerHostnameAuthenticatedOriginPullsCertificateParams) redacted() interface{} {
+	type redacted PerHostnameAuthenticatedOriginPullsCertificateParams
+	r := redacted(p)
+	r.PrivateKey = redact(r.PrivateKey)
+	return r
+}
+
 // PerHostnameAuthenticatedOriginPullsConfig represents the config state for Per Hostname AuthenticatedOriginPulls applied on a hostname.
 type PerHostnameAuthenticatedOriginPullsConfig struct {
 	Hostname string `json:"hostname"`
@@ -49,6 +49,23 @@ type PerZoneAuthenticatedOriginPullsCertificateParams struct {
 	PrivateKey  string `json:"private_key"`
 }
 
+// String implements fmt.Stringer, redacting the private key.
+func (p PerZoneAuthenticatedOriginPullsCertificateParams) String() string {
+	return redactedString(p.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the private key.
+func (p PerZoneAuthenticatedOriginPullsCertificateParams) GoString() string {
+	return redactedGoString("PerZoneAuthenticatedOriginPullsCertificateParams", p.redacted())
+}
+
+func (p PerZoneAuthenticatedOriginPullsCertificateParams) redacted() interface{} {
+	type redacted PerZoneAuthenticatedOriginPullsCertificateParams
+	r := redacted(p)
+	r.PrivateKey = redact(r.PrivateKey)
+	return r
+}
+
 // GetPerZoneAuthenticatedOriginPullsStatus returns whether per zone AuthenticatedOriginPulls is enabled or not. It is false by default.
 //
 // API reference: https://api.cloudflare.com/#zone-level-authenticated-origin-pulls-get-enablement-setting-for-zone
@@ -0,0 +1,59 @@
+package cloudflare
+
+import "context"
+
+// Statuses of Authenticated Origin Pulls client certificates.
+const (
+	AuthenticatedOriginPullsCertificateStatusInitializing       = "initializing"
+	AuthenticatedOriginPullsCertificateStatusPendingDeployment  = "pending_deployment"
+	AuthenticatedOriginPullsCertificateStatusActive             = "active"
+	AuthenticatedOriginPullsCertificateStatusPendingDeletion    = "pending_deletion"
+	AuthenticatedOriginPullsCertificateStatusDeleted            = "deleted"
+	AuthenticatedOriginPullsCertificateStatusDeploymentTimedOut = "deployment_timed_out"
+	AuthenticatedOriginPullsCertificateStatusDeletionTimedOut   = "deletion_timed_out"
+)
+
+// authenticatedOriginPullsCertificateDeployment maps the status of a
+// certificate to the state of its deployment.
+func authenticatedOriginPullsCertificateDeployment(id, status string) Operation {
+	op := Operation{ID: id, State: OperationStateRunning}
+	switch status {
+	case AuthenticatedOriginPullsCertificateStatusActive:
+		op.State = OperationStateSucceeded
+	case AuthenticatedOriginPullsCertificateStatusDeploymentTimedOut,
+		AuthenticatedOriginPullsCertificateStatusPendingDeletion,
+		AuthenticatedOriginPullsCertificateStatusDeleted,
+		AuthenticatedOriginPullsCertificateStatusDeletionTimedOut:
+		op.State = OperationStateFailed
+		op.Error = "certificate " + id + " is " + status
+	}
+	return op
+}
+
+// PerZoneAuthenticatedOriginPullsCertificateDeployment returns an
+// OperationFetcher following the deployment of an uploaded per zone client
+// certificate for use with WaitForOperation. The deployment succeeds once
+// the certificate is active.
+func (api *API) PerZoneAuthenticatedOriginPullsCertificateDeployment(zoneID string) OperationFetcher {
+	return func(ctx context.Context, certificateID string) (Operation, error) {
+		cert, err := api.GetPerZoneAuthenticatedOriginPullsCertificateDetails(ctx, zoneID, certificateID)
+		if err != nil {
+			return Operation{}, err
+		}
+		return authenticatedOriginPullsCertificateDeployment(certificateID, cert.Status), nil
+	}
+}
+
+// PerHostnameAuthenticatedOriginPullsCertificateDeployment returns an
+// OperationFetcher following the deployment of an uploaded per hostname
+// client certificate for use with WaitForOperation. The deployment succeeds
+// once the certificate is active.
+func (api *API) PerHostnameAuthenticatedOriginPullsCertificateDeployment(zoneID string) OperationFetcher {
+	return func(ctx context.Context, certificateID string) (Operation, error) {
+		cert, err := api.GetPerHostnameAuthenticatedOriginPullsCertificate(ctx, zoneID, certificateID)
+		if err != nil {
+			return Operation{}, err
+		}
+		return authenticatedOriginPullsCertificateDeployment(certificateID, cert.Status), nil
+	}
+}
@@ -8,6 +8,23 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Actions Super Bot Fight Mode takes for a category of traffic. The values are
+// untyped so they can be used with StringPtr for the SBFM fields of
+// UpdateBotManagementParams. The API validates the values, so new ones can be
+// used before they are added here.
+const (
+	SuperBotFightModeActionAllow            = "allow"
+	SuperBotFightModeActionBlock            = "block"
+	SuperBotFightModeActionManagedChallenge = "managed_challenge"
+)
+
+// Values of the AI bots protection setting.
+const (
+	AIBotsProtectionBlock         = "block"
+	AIBotsProtectionDisabled      = "disabled"
+	AIBotsProtectionOnlyOnAdPages = "only_on_ad_pages"
+)
+
 // BotManagement represents the bots config for a zone.
 type BotManagement struct {
 	EnableJS                     *bool   `json:"enable_js,omitempty"`
@@ -19,8 +36,15 @@ type BotManagement struct {
 	OptimizeWordpress            *bool   `json:"optimize_wordpress,omitempty"`
 	SuppressSessionScore         *bool   `json:"suppress_session_score,omitempty"`
 	AutoUpdateModel              *bool   `json:"auto_update_model,omitempty"`
-	UsingLatestModel             *bool   `json:"using_latest_model,omitempty"`
 	AIBotsProtection             *string `json:"ai_bots_protection,omitempty"`
+	IsRobotsTXTManaged           *bool   `json:"is_robots_txt_managed,omitempty"`
+
+	// UsingLatestModel and StaleZoneConfiguration are read-only.
+	// StaleZoneConfiguration holds settings that are stored for the zone
+	// but not applied by its current plan, for example after a downgrade
+	// from Bot Management to Super Bot Fight Mode.
+	UsingLatestModel       *bool                  `json:"using_latest_model,omitempty"`
+	StaleZoneConfiguration map[string]interface{} `json:"stale_zone_configuration,omitempty"`
 }
 
 // BotManagementResponse represents the response from the bot_management endpoint.
@@ -30,16 +54,22 @@ type BotManagementResponse struct {
 }
 
 type UpdateBotManagementParams struct {
-	EnableJS                     *bool   `json:"enable_js,omitempty"`
-	FightMode                    *bool   `json:"fight_mode,omitempty"`
-	SBFMDefinitelyAutomated      *string `json:"sbfm_definitely_automated,omitempty"`
-	SBFMLikelyAutomated          *string `json:"sbfm_likely_automated,omitempty"`
-	SBFMVerifiedBots             *string `json:"sbfm_verified_bots,omitempty"`
+	EnableJS  *bool `json:"enable_js,omitempty"`
+	FightMode *bool `json:"fight_mode,omitempty"`
+
+	// SBFMDefinitelyAutomated and SBFMLikelyAutomated are usually one of
+	// the SuperBotFightModeAction values. SBFMVerifiedBots can only be
+	// allowed or blocked.
+	SBFMDefinitelyAutomated *string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated     *string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots        *string `json:"sbfm_verified_bots,omitempty"`
+
 	SBFMStaticResourceProtection *bool   `json:"sbfm_static_resource_protection,omitempty"`
 	OptimizeWordpress            *bool   `json:"optimize_wordpress,omitempty"`
 	SuppressSessionScore         *bool   `json:"suppress_session_score,omitempty"`
 	AutoUpdateModel              *bool   `json:"auto_update_model,omitempty"`
 	AIBotsProtection             *string `json:"ai_bots_protection,omitempty"`
+	IsRobotsTXTManaged           *bool   `json:"is_robots_txt_managed,omitempty"`
 }
 
 // GetBotManagement gets a zone API shield configuration.
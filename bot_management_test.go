@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
@@ -25,7 +26,11 @@ func TestGetBotManagement(t *testing.T) {
 		"enable_js": false,
 		"fight_mode": true,
 		"using_latest_model": true,
-        "ai_bots_protection": "disabled"
+        "ai_bots_protection": "disabled",
+		"is_robots_txt_managed": true,
+		"stale_zone_configuration": {
+			"sbfm_likely_automated": "block"
+		}
 	}
 }
 		`)
@@ -34,10 +39,14 @@ func TestGetBotManagement(t *testing.T) {
 	mux.HandleFunc("/zones/"+testZoneID+"/bot_management", handler)
 
 	want := BotManagement{
-		EnableJS:         BoolPtr(false),
-		FightMode:        BoolPtr(true),
-		UsingLatestModel: BoolPtr(true),
-		AIBotsProtection: StringPtr("disabled"),
+		EnableJS:           BoolPtr(false),
+		FightMode:          BoolPtr(true),
+		UsingLatestModel:   BoolPtr(true),
+		AIBotsProtection:   StringPtr("disabled"),
+		IsRobotsTXTManaged: BoolPtr(true),
+		StaleZoneConfiguration: map[string]interface{}{
+			"sbfm_likely_automated": "block",
+		},
 	}
 
 	actual, err := client.GetBotManagement(context.Background(), ZoneIdentifier(testZoneID))
@@ -90,3 +99,44 @@ func TestUpdateBotManagement(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestUpdateBotManagement_SuperBotFightMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"fight_mode": false,
+			"sbfm_definitely_automated": "block",
+			"sbfm_likely_automated": "managed_challenge",
+			"sbfm_verified_bots": "allow",
+			"sbfm_static_resource_protection": true,
+			"optimize_wordpress": true
+		}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+	"success" : true,
+	"errors": [],
+	"messages": [],
+	"result": %s
+}
+		`, body)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/bot_management", handler)
+
+	actual, err := client.UpdateBotManagement(context.Background(), ZoneIdentifier(testZoneID), UpdateBotManagementParams{
+		FightMode:                    BoolPtr(false),
+		SBFMDefinitelyAutomated:      StringPtr(SuperBotFightModeActionBlock),
+		SBFMLikelyAutomated:          StringPtr(SuperBotFightModeActionManagedChallenge),
+		SBFMVerifiedBots:             StringPtr(SuperBotFightModeActionAllow),
+		SBFMStaticResourceProtection: BoolPtr(true),
+		OptimizeWordpress:            BoolPtr(true),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, StringPtr(SuperBotFightModeActionManagedChallenge), actual.SBFMLikelyAutomated)
+		assert.Equal(t, BoolPtr(true), actual.OptimizeWordpress)
+	}
+}
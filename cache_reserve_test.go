@@ -80,3 +80,41 @@ func TestUpdateCacheReserve(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestCacheReserveClear(t *testing.T) {
+	setup()
+	defer teardown()
+
+	state := "In-progress"
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			defer func() { state = "Completed" }()
+		} else {
+			assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		}
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "cache_reserve_clear",
+				"state": "%s",
+				"start_ts": "%s"
+			}
+		}`, state, cacheReserveTimestampString)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/cache_reserve_clear", handler)
+
+	started, err := client.StartCacheReserveClear(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, CacheReserveClearInProgress, started.State)
+		assert.Equal(t, &cacheReserveTimestamp, started.StartTs)
+	}
+
+	done, err := client.WaitForCacheReserveClear(context.Background(), ZoneIdentifier(testZoneID), time.Millisecond)
+	if assert.NoError(t, err) {
+		assert.Equal(t, CacheReserveClearCompleted, done.State)
+	}
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,22 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Certificate authorities issuing certificate packs and Total TLS
+// certificates.
+const (
+	CertificateAuthorityLetsEncrypt = "lets_encrypt"
+	CertificateAuthorityGoogle      = "google"
+	CertificateAuthorityDigiCert    = "digicert"
+	CertificateAuthoritySSLCom      = "ssl_com"
+)
+
+// Domain control validation methods of certificate packs.
+const (
+	CertificatePackValidationMethodTXT   = "txt"
+	CertificatePackValidationMethodHTTP  = "http"
+	CertificatePackValidationMethodEmail = "email"
+)
+
 // CertificatePackGeoRestrictions is for the structure of the geographic
 // restrictions for a TLS certificate.
 type CertificatePackGeoRestrictions struct {
@@ -58,6 +75,44 @@ type CertificatePackRequest struct {
 	CloudflareBranding   bool     `json:"cloudflare_branding"`
 }
 
+// Validate checks the request has at least one host. The validity period,
+// validation method and certificate authority are left for the API to check.
+func (r CertificatePackRequest) Validate() error {
+	if len(r.Hosts) == 0 {
+		return errors.New("certificate pack requires at least one host")
+	}
+
+	return nil
+}
+
+// CertificatePackQuota is the number of certificate packs a zone may order.
+type CertificatePackQuota struct {
+	Advanced CertificatePackQuotaUsage `json:"advanced"`
+}
+
+// CertificatePackQuotaUsage is the allocated and used number of certificate
+// packs of a type.
+type CertificatePackQuotaUsage struct {
+	Allocated int `json:"allocated"`
+	Used      int `json:"used"`
+}
+
+// Remaining returns the number of certificate packs that can still be
+// ordered.
+func (u CertificatePackQuotaUsage) Remaining() int {
+	if u.Used >= u.Allocated {
+		return 0
+	}
+	return u.Allocated - u.Used
+}
+
+// CertificatePackQuotaResponse contains the certificate pack quota of a
+// zone.
+type CertificatePackQuotaResponse struct {
+	Response
+	Result CertificatePackQuota `json:"result"`
+}
+
 // CertificatePacksResponse is for responses where multiple certificates are
 // expected.
 type CertificatePacksResponse struct {
@@ -114,6 +169,10 @@ func (api *API) CertificatePack(ctx context.Context, zoneID, certificatePackID s
 //
 // API Reference: https://api.cloudflare.com/#certificate-packs-order-advanced-certificate-manager-certificate-pack
 func (api *API) CreateCertificatePack(ctx context.Context, zoneID string, cert CertificatePackRequest) (CertificatePack, error) {
+	if err := cert.Validate(); err != nil {
+		return CertificatePack{}, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/ssl/certificate_packs/order", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, cert)
 	if err != nil {
@@ -161,3 +220,27 @@ func (api *API) RestartCertificateValidation(ctx context.Context, zoneID, certif
 
 	return certificatePackResponse.Result, nil
 }
+
+// CertificatePackQuota returns the number of advanced certificate packs a
+// zone may order and has ordered.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/certificate-packs-get-certificate-pack-quotas
+func (api *API) CertificatePackQuota(ctx context.Context, zoneID string) (CertificatePackQuota, error) {
+	if zoneID == "" {
+		return CertificatePackQuota{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/ssl/certificate_packs/quota", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return CertificatePackQuota{}, err
+	}
+
+	var r CertificatePackQuotaResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CertificatePackQuota{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
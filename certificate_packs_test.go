@@ -283,3 +283,60 @@ func TestDeleteCertificatePack(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestCertificatePackRequestValidate(t *testing.T) {
+	valid := CertificatePackRequest{
+		Type:                 "advanced",
+		Hosts:                []string{"example.com"},
+		ValidationMethod:     CertificatePackValidationMethodTXT,
+		ValidityDays:         90,
+		CertificateAuthority: CertificateAuthorityGoogle,
+	}
+	assert.NoError(t, valid.Validate())
+
+	noHosts := valid
+	noHosts.Hosts = nil
+	assert.Error(t, noHosts.Validate())
+
+	_, err := client.CreateCertificatePack(context.Background(), testZoneID, noHosts)
+	assert.Error(t, err)
+
+	newValues := valid
+	newValues.ValidityDays = 60
+	newValues.ValidationMethod = "cname"
+	newValues.CertificateAuthority = "comodo"
+	assert.NoError(t, newValues.Validate())
+}
+
+func TestCertificatePackQuota(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "advanced": {
+      "allocated": 10,
+      "used": 3
+    }
+  }
+}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ssl/certificate_packs/quota", handler)
+
+	_, err := client.CertificatePackQuota(context.Background(), "")
+	assert.Equal(t, ErrMissingZoneID, err)
+
+	actual, err := client.CertificatePackQuota(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, CertificatePackQuota{Advanced: CertificatePackQuotaUsage{Allocated: 10, Used: 3}}, actual)
+		assert.Equal(t, 7, actual.Advanced.Remaining())
+	}
+}
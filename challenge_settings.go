@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	challengeSettingTTL   = "challenge_ttl"
+	challengeSettingLevel = "security_level"
+)
+
+var (
+	challengeTTLValues  = []int{300, 900, 1800, 2700, 3600, 7200, 10800, 14400, 28800, 57600, 86400, 604800, 2592000, 31536000}
+	securityLevelValues = []string{"off", "essentially_off", "low", "medium", "high", "under_attack"}
+)
+
+// ChallengeSettings controls when visitors to a zone are challenged and for
+// how long a solved challenge is honoured.
+type ChallengeSettings struct {
+	// ChallengeTTL is the number of seconds a visitor who passed a challenge
+	// is allowed through before being challenged again.
+	ChallengeTTL int
+
+	// SecurityLevel sets the threat score above which visitors are shown an
+	// interactive challenge, one of "off", "essentially_off", "low",
+	// "medium", "high" or "under_attack".
+	SecurityLevel string
+}
+
+// UpdateChallengeSettingsParams holds the challenge settings to change. Nil
+// values are left unchanged.
+type UpdateChallengeSettingsParams struct {
+	ChallengeTTL  *int
+	SecurityLevel *string
+}
+
+// GetChallengeSettings returns the challenge settings of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-challenge-ttl-setting
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-security-level-setting
+func (api *API) GetChallengeSettings(ctx context.Context, rc *ResourceContainer) (ChallengeSettings, error) {
+	var settings ChallengeSettings
+
+	ttl, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: challengeSettingTTL})
+	if err != nil {
+		return ChallengeSettings{}, err
+	}
+	if settings.ChallengeTTL, err = challengeTTLFromSetting(ttl); err != nil {
+		return ChallengeSettings{}, err
+	}
+
+	level, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: challengeSettingLevel})
+	if err != nil {
+		return ChallengeSettings{}, err
+	}
+	settings.SecurityLevel, _ = level.Value.(string)
+
+	return settings, nil
+}
+
+// UpdateChallengeSettings changes the challenge settings of a zone and
+// returns the resulting settings.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-challenge-ttl-setting
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-change-security-level-setting
+func (api *API) UpdateChallengeSettings(ctx context.Context, rc *ResourceContainer, params UpdateChallengeSettingsParams) (ChallengeSettings, error) {
+	if params.ChallengeTTL != nil && !containsInt(challengeTTLValues, *params.ChallengeTTL) {
+		return ChallengeSettings{}, fmt.Errorf("invalid challenge TTL %d", *params.ChallengeTTL)
+	}
+
+	if params.SecurityLevel != nil && !contains(securityLevelValues, *params.SecurityLevel) {
+		return ChallengeSettings{}, fmt.Errorf("invalid security level %q, must be one of %s", *params.SecurityLevel, strings.Join(securityLevelValues, ", "))
+	}
+
+	if params.ChallengeTTL != nil {
+		if _, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: challengeSettingTTL, Value: *params.ChallengeTTL}); err != nil {
+			return ChallengeSettings{}, err
+		}
+	}
+
+	if params.SecurityLevel != nil {
+		if _, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: challengeSettingLevel, Value: *params.SecurityLevel}); err != nil {
+			return ChallengeSettings{}, err
+		}
+	}
+
+	return api.GetChallengeSettings(ctx, rc)
+}
+
+func challengeTTLFromSetting(setting ZoneSetting) (int, error) {
+	switch v := setting.Value.(type) {
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case uint64:
+		return int(v), nil
+	}
+
+	return 0, fmt.Errorf("unexpected challenge TTL value %v", setting.Value)
+}
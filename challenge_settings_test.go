@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateChallengeSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ttl, level := 1800, "medium"
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/challenge_ttl", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"value": 3600}`, string(body))
+			ttl = 3600
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "challenge_ttl", "value": %d, "editable": true}}`, ttl)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/security_level", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "security_level", "value": "%s", "editable": true}}`, level)
+	})
+
+	actual, err := client.GetChallengeSettings(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, ChallengeSettings{ChallengeTTL: 1800, SecurityLevel: "medium"}, actual)
+	}
+
+	actual, err = client.UpdateChallengeSettings(context.Background(), ZoneIdentifier(testZoneID), UpdateChallengeSettingsParams{ChallengeTTL: IntPtr(3600)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ChallengeSettings{ChallengeTTL: 3600, SecurityLevel: "medium"}, actual)
+	}
+
+	_, err = client.UpdateChallengeSettings(context.Background(), ZoneIdentifier(testZoneID), UpdateChallengeSettingsParams{ChallengeTTL: IntPtr(60)})
+	assert.EqualError(t, err, "invalid challenge TTL 60")
+
+	_, err = client.UpdateChallengeSettings(context.Background(), ZoneIdentifier(testZoneID), UpdateChallengeSettingsParams{SecurityLevel: StringPtr("paranoid")})
+	assert.EqualError(t, err, `invalid security level "paranoid", must be one of off, essentially_off, low, medium, high, under_attack`)
+}
@@ -2,12 +2,21 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/goccy/go-json"
 )
 
+// Providers Cloud Connector rules can route traffic to.
+const (
+	CloudConnectorProviderAWSS3        = "aws_s3"
+	CloudConnectorProviderR2           = "r2"
+	CloudConnectorProviderGCPStorage   = "gcp_storage"
+	CloudConnectorProviderAzureStorage = "azure_storage"
+)
+
 type CloudConnectorRulesResponse struct {
 	Response
 	Result []CloudConnectorRule `json:"result"`
@@ -26,6 +35,64 @@ type CloudConnectorRule struct {
 	Description string                       `json:"description"`
 }
 
+// Validate checks the rule has an expression, a provider and a host.
+func (r CloudConnectorRule) Validate() error {
+	if r.Expression == "" {
+		return errors.New("cloud connector rule requires an expression")
+	}
+
+	if r.Provider == "" {
+		return errors.New("cloud connector rule requires a provider")
+	}
+
+	if r.Parameters.Host == "" {
+		return errors.New("cloud connector rule requires a host")
+	}
+
+	return nil
+}
+
+// NewCloudConnectorS3Rule returns a rule routing requests matching expression
+// to an S3 bucket.
+func NewCloudConnectorS3Rule(expression, bucket, region string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Expression: expression,
+		Provider:   CloudConnectorProviderAWSS3,
+		Parameters: CloudConnectorRuleParameters{Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", bucket, region)},
+	}
+}
+
+// NewCloudConnectorR2Rule returns a rule routing requests matching expression
+// to an R2 bucket through its public hostname, such as the bucket's r2.dev
+// subdomain.
+func NewCloudConnectorR2Rule(expression, bucketHost string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Expression: expression,
+		Provider:   CloudConnectorProviderR2,
+		Parameters: CloudConnectorRuleParameters{Host: bucketHost},
+	}
+}
+
+// NewCloudConnectorGCSRule returns a rule routing requests matching
+// expression to a Google Cloud Storage bucket.
+func NewCloudConnectorGCSRule(expression, bucket string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Expression: expression,
+		Provider:   CloudConnectorProviderGCPStorage,
+		Parameters: CloudConnectorRuleParameters{Host: fmt.Sprintf("%s.storage.googleapis.com", bucket)},
+	}
+}
+
+// NewCloudConnectorAzureRule returns a rule routing requests matching
+// expression to an Azure Blob Storage account.
+func NewCloudConnectorAzureRule(expression, storageAccount string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Expression: expression,
+		Provider:   CloudConnectorProviderAzureStorage,
+		Parameters: CloudConnectorRuleParameters{Host: fmt.Sprintf("%s.blob.core.windows.net", storageAccount)},
+	}
+}
+
 func (api *API) ListZoneCloudConnectorRules(ctx context.Context, rc *ResourceContainer) ([]CloudConnectorRule, error) {
 	if rc.Identifier == "" {
 		return nil, ErrMissingZoneID
@@ -50,6 +117,12 @@ func (api *API) UpdateZoneCloudConnectorRules(ctx context.Context, rc *ResourceC
 		return nil, ErrMissingZoneID
 	}
 
+	for _, r := range params {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	uri := fmt.Sprintf("/zones/%s/cloud_connector/rules", rc.Identifier)
 
 	payload, err := json.Marshal(params)
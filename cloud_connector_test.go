@@ -159,3 +159,21 @@ func TestUpdateCloudConnectorRules(t *testing.T) {
 		assert.Equal(t, want, zoneActual)
 	}
 }
+
+func TestCloudConnectorRule_Validate(t *testing.T) {
+	rule := NewCloudConnectorS3Rule(`http.request.uri.path wildcard "/assets/*"`, "assets", "eu-west-1")
+	assert.Equal(t, "assets.s3.eu-west-1.amazonaws.com", rule.Parameters.Host)
+	assert.NoError(t, rule.Validate())
+
+	assert.Equal(t, "media.storage.googleapis.com", NewCloudConnectorGCSRule("true", "media").Parameters.Host)
+	assert.Equal(t, "media.blob.core.windows.net", NewCloudConnectorAzureRule("true", "media").Parameters.Host)
+
+	rule.Provider = "ftp"
+	assert.NoError(t, rule.Validate())
+
+	rule.Provider = ""
+	assert.EqualError(t, rule.Validate(), "cloud connector rule requires a provider")
+
+	_, err := client.UpdateZoneCloudConnectorRules(context.Background(), ZoneIdentifier(testZoneID), []CloudConnectorRule{NewCloudConnectorR2Rule("true", "")})
+	assert.EqualError(t, err, "cloud connector rule requires a host")
+}
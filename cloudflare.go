@@ -7,12 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"math"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -52,14 +50,18 @@ type API struct {
 	authType          int
 	rateLimiter       *rate.Limiter
 	retryPolicy       RetryPolicy
-	logger            Logger
+	logger            StructuredLogger
 	Debug             bool
+
+	deprecationHandler DeprecationHandler
+	warningHandler     WarningHandler
+	requestMiddleware  []RequestMiddleware
+	tracer             Tracer
+	metrics            MetricsRecorder
 }
 
 // newClient provides shared logic for New and NewWithUserServiceKey.
 func newClient(opts ...Option) (*API, error) {
-	silentLogger := log.New(io.Discard, "", log.LstdFlags)
-
 	api := &API{
 		BaseURL:     fmt.Sprintf("%s://%s%s", defaultScheme, defaultHostname, defaultBasePath),
 		UserAgent:   userAgent + "/" + Version,
@@ -70,7 +72,6 @@ func newClient(opts ...Option) (*API, error) {
 			MinRetryDelay: 1 * time.Second,
 			MaxRetryDelay: 30 * time.Second,
 		},
-		logger: silentLogger,
 	}
 
 	err := api.parseOptions(opts...)
@@ -206,10 +207,157 @@ func (api *API) makeRequestContextWithHeadersComplete(ctx context.Context, metho
 }
 
 func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header) (*APIResponse, error) {
+	ctx, observation := api.observeRequest(ctx, method, uri)
+
+	var attempt requestAttempt
+	res, err := api.makeRequestAttempts(ctx, method, uri, params, authType, headers, &attempt)
+	observation.end(attempt.resp, attempt.retries, err)
+
+	return res, err
+}
+
+// requestObservation is an API call being traced, logged and measured.
+type requestObservation struct {
+	api          *API
+	method       string
+	uri          string
+	pathTemplate string
+	start        time.Time
+	span         TraceSpan
+}
+
+// observeRequest starts observing an API call, starting its span if there
+// is a tracer. The returned context is used for the call.
+func (api *API) observeRequest(ctx context.Context, method, uri string) (context.Context, *requestObservation) {
+	o := &requestObservation{api: api, method: method, uri: uri}
+	if api.tracer != nil || api.metrics != nil {
+		o.pathTemplate = pathTemplate(uri)
+	}
+
+	if api.tracer != nil {
+		ctx, o.span = api.tracer.Start(ctx, TraceRequest{Method: method, PathTemplate: o.pathTemplate})
+	}
+
+	o.start = time.Now()
+	return ctx, o
+}
+
+// end reports the outcome of the call, with resp the last response
+// received, if any.
+func (o *requestObservation) end(resp *http.Response, retries int, err error) {
+	duration := time.Since(o.start)
+
+	var statusCode int
+	var respHeader http.Header
+	if resp != nil {
+		statusCode = resp.StatusCode
+		respHeader = resp.Header
+	}
+
+	if o.span != nil {
+		o.span.End(TraceResult{
+			StatusCode: statusCode,
+			Retries:    retries,
+			RayID:      respHeader.Get("cf-ray"),
+			Err:        err,
+		})
+	}
+
+	if o.api.logger != nil {
+		args := []any{"method", o.method, "uri", o.uri, "status", statusCode, "duration", duration, "retries", retries}
+		if err != nil {
+			o.api.logger.Warn("cloudflare API request failed", append(args, "error", err)...)
+		} else {
+			o.api.logger.Debug("cloudflare API request", args...)
+		}
+	}
+
+	if o.api.metrics != nil {
+		o.api.metrics.ObserveRequest(RequestMetrics{
+			Method:             o.method,
+			PathTemplate:       o.pathTemplate,
+			StatusCode:         statusCode,
+			Duration:           duration,
+			Retries:            retries,
+			Err:                err,
+			RateLimitRemaining: rateLimitRemaining(respHeader),
+		})
+	}
+}
+
+// requestAttempt records the last attempt of a request made by
+// makeRequestAttempts.
+type requestAttempt struct {
+	retries int
+	resp    *http.Response
+}
+
+// makeRequestAttempts makes a request, retrying it according to the retry
+// policy, and records the last attempt in attempt.
+func (api *API) makeRequestAttempts(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header, attempt *requestAttempt) (*APIResponse, error) {
+	resp, err := api.requestWithRetries(ctx, method, uri, params, authType, headers, attempt)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, responseError(resp, respBody)
+	}
+
+	if api.warningHandler != nil {
+		for _, w := range parseWarnings(method, uri, respBody) {
+			api.warningHandler(w)
+		}
+	}
+
+	return &APIResponse{
+		Body:       respBody,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Headers:    resp.Header,
+	}, nil
+}
+
+// makeStreamingRequest makes a request whose response body is read
+// incrementally by the caller, which must close it. The request is retried,
+// traced, logged and measured like other requests, up to receiving a
+// successful response.
+func (api *API) makeStreamingRequest(ctx context.Context, method, uri string, params interface{}, headers http.Header) (*http.Response, error) {
+	ctx, observation := api.observeRequest(ctx, method, uri)
+
+	var attempt requestAttempt
+	resp, err := api.requestWithRetries(ctx, method, uri, params, api.authType, headers, &attempt)
+	if err == nil && resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+
+		var respBody []byte
+		respBody, err = io.ReadAll(resp.Body)
+		if err != nil {
+			err = fmt.Errorf("could not read response body: %w", err)
+		} else {
+			err = responseError(resp, respBody)
+		}
+		resp = nil
+	}
+
+	observation.end(attempt.resp, attempt.retries, err)
+
+	return resp, err
+}
+
+// requestWithRetries sends a request, retrying it according to the retry
+// policy while it fails or is rate limited, and records the last attempt in
+// attempt. The body of the returned response is left unread.
+func (api *API) requestWithRetries(ctx context.Context, method, uri string, params interface{}, authType int, headers http.Header, attempt *requestAttempt) (*http.Response, error) {
 	var err error
 	var resp *http.Response
 	var respErr error
-	var respBody []byte
 
 	for i := 0; i <= api.retryPolicy.MaxRetries; i++ {
 		var reqBody io.Reader
@@ -237,8 +385,9 @@ func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, m
 			if sleepDuration > api.retryPolicy.MaxRetryDelay {
 				sleepDuration = api.retryPolicy.MaxRetryDelay
 			}
-			// useful to do some simple logging here, maybe introduce levels later
-			api.logger.Printf("Sleeping %s before retry attempt number %d for request %s %s", sleepDuration.String(), i, method, uri)
+			if api.logger != nil {
+				api.logger.Info("retrying cloudflare API request", "method", method, "uri", uri, "attempt", i, "delay", sleepDuration)
+			}
 
 			select {
 			case <-time.After(sleepDuration):
@@ -253,32 +402,38 @@ func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, m
 		}
 
 		resp, respErr = api.request(ctx, method, uri, reqBody, authType, headers)
+		attempt.retries = i
+		attempt.resp = resp
 
 		// short circuit processing on context timeouts
 		if respErr != nil && errors.Is(respErr, context.DeadlineExceeded) {
 			return nil, respErr
 		}
 
+		// retrying cannot fix a failing middleware
+		var middlewareErr *requestMiddlewareError
+		if errors.As(respErr, &middlewareErr) {
+			return nil, respErr
+		}
+
 		// retry if the server is rate limiting us or if it failed
 		// assumes server operations are rolled back on failure
 		if respErr != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
-			if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-				respErr = errors.New("exceeded available rate limit retries")
+			if resp != nil {
+				resp.Body.Close()
+				if resp.StatusCode == http.StatusTooManyRequests {
+					respErr = errors.New("exceeded available rate limit retries")
+				}
 			}
 
 			if respErr == nil {
 				respErr = fmt.Errorf("received %s response (HTTP %d), please try again later", strings.ToLower(http.StatusText(resp.StatusCode)), resp.StatusCode)
 			}
 			continue
-		} else {
-			respBody, err = io.ReadAll(resp.Body)
-			defer resp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("could not read response body: %w", err)
-			}
-
-			break
 		}
+
+		respErr = nil
+		break
 	}
 
 	// still had an error after all retries
@@ -286,68 +441,70 @@ func (api *API) makeRequestWithAuthTypeAndHeadersComplete(ctx context.Context, m
 		return nil, respErr
 	}
 
-	if resp.StatusCode >= http.StatusBadRequest {
-		if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
-			return nil, fmt.Errorf("%s", respBody)
-		}
-
-		if resp.StatusCode >= http.StatusInternalServerError {
-			return nil, &ServiceError{cloudflareError: &Error{
-				StatusCode: resp.StatusCode,
-				RayID:      resp.Header.Get("cf-ray"),
-				Errors: []ResponseInfo{{
-					Message: errInternalServiceError,
-				}},
-			}}
-		}
-
-		errBody := &Response{}
-		err = json.Unmarshal(respBody, &errBody)
-		if err != nil {
-			return nil, fmt.Errorf(errUnmarshalErrorBody+": %w", err)
-		}
-
-		errCodes := make([]int, 0, len(errBody.Errors))
-		errMsgs := make([]string, 0, len(errBody.Errors))
-		for _, e := range errBody.Errors {
-			errCodes = append(errCodes, e.Code)
-			errMsgs = append(errMsgs, e.Message)
+	if api.deprecationHandler != nil {
+		if notice, ok := parseDeprecationNotice(method, uri, resp.Header); ok {
+			api.deprecationHandler(notice)
 		}
+	}
 
-		err := &Error{
-			StatusCode:    resp.StatusCode,
-			RayID:         resp.Header.Get("cf-ray"),
-			Errors:        errBody.Errors,
-			ErrorCodes:    errCodes,
-			ErrorMessages: errMsgs,
-			Messages:      errBody.Messages,
-		}
+	return resp, nil
+}
 
-		switch resp.StatusCode {
-		case http.StatusUnauthorized:
-			err.Type = ErrorTypeAuthorization
-			return nil, &AuthorizationError{cloudflareError: err}
-		case http.StatusForbidden:
-			err.Type = ErrorTypeAuthentication
-			return nil, &AuthenticationError{cloudflareError: err}
-		case http.StatusNotFound:
-			err.Type = ErrorTypeNotFound
-			return nil, &NotFoundError{cloudflareError: err}
-		case http.StatusTooManyRequests:
-			err.Type = ErrorTypeRateLimit
-			return nil, &RatelimitError{cloudflareError: err}
-		default:
-			err.Type = ErrorTypeRequest
-			return nil, &RequestError{cloudflareError: err}
-		}
+// responseError converts an unsuccessful response and its body into the
+// error type matching its status code.
+func responseError(resp *http.Response, respBody []byte) error {
+	if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
+		return fmt.Errorf("%s", respBody)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &ServiceError{cloudflareError: &Error{
+			StatusCode: resp.StatusCode,
+			RayID:      resp.Header.Get("cf-ray"),
+			Errors: []ResponseInfo{{
+				Message: errInternalServiceError,
+			}},
+		}}
+	}
+
+	errBody := &Response{}
+	if err := json.Unmarshal(respBody, &errBody); err != nil {
+		return fmt.Errorf(errUnmarshalErrorBody+": %w", err)
+	}
+
+	errCodes := make([]int, 0, len(errBody.Errors))
+	errMsgs := make([]string, 0, len(errBody.Errors))
+	for _, e := range errBody.Errors {
+		errCodes = append(errCodes, e.Code)
+		errMsgs = append(errMsgs, e.Message)
+	}
+
+	err := &Error{
+		StatusCode:    resp.StatusCode,
+		RayID:         resp.Header.Get("cf-ray"),
+		Errors:        errBody.Errors,
+		ErrorCodes:    errCodes,
+		ErrorMessages: errMsgs,
+		Messages:      errBody.Messages,
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err.Type = ErrorTypeAuthorization
+		return &AuthorizationError{cloudflareError: err}
+	case http.StatusForbidden:
+		err.Type = ErrorTypeAuthentication
+		return &AuthenticationError{cloudflareError: err}
+	case http.StatusNotFound:
+		err.Type = ErrorTypeNotFound
+		return &NotFoundError{cloudflareError: err}
+	case http.StatusTooManyRequests:
+		err.Type = ErrorTypeRateLimit
+		return &RatelimitError{cloudflareError: err}
+	default:
+		err.Type = ErrorTypeRequest
+		return &RequestError{cloudflareError: err}
 	}
-
-	return &APIResponse{
-		Body:       respBody,
-		StatusCode: resp.StatusCode,
-		Status:     resp.Status,
-		Headers:    resp.Header,
-	}, nil
 }
 
 // request makes a HTTP request to the given API endpoint, returning the raw
@@ -362,6 +519,7 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 	combinedHeaders := make(http.Header)
 	copyHeader(combinedHeaders, api.headers)
 	copyHeader(combinedHeaders, headers)
+	copyHeader(combinedHeaders, requestHeadersFromContext(ctx))
 	req.Header = combinedHeaders
 
 	if authType&AuthKeyEmail != 0 {
@@ -383,21 +541,18 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	for _, middleware := range api.requestMiddleware {
+		if err := middleware(req); err != nil {
+			return nil, &requestMiddlewareError{err: err}
+		}
+	}
+
 	if api.Debug {
 		dump, err := httputil.DumpRequestOut(req, true)
 		if err != nil {
 			return nil, err
 		}
-
-		// Strip out any sensitive information from the request payload.
-		sensitiveKeys := []string{api.APIKey, api.APIEmail, api.APIToken, api.APIUserServiceKey}
-		for _, key := range sensitiveKeys {
-			if key != "" {
-				valueRegex := regexp.MustCompile(fmt.Sprintf("(?m)%s", key))
-				dump = valueRegex.ReplaceAll(dump, []byte("[redacted]"))
-			}
-		}
-		log.Printf("\n%s", string(dump))
+		api.logDump("cloudflare API request", dump)
 	}
 
 	resp, err := api.httpClient.Do(req)
@@ -410,7 +565,7 @@ func (api *API) request(ctx context.Context, method, uri string, reqBody io.Read
 		if err != nil {
 			return resp, err
 		}
-		log.Printf("\n%s", string(dump))
+		api.logDump("cloudflare API response", dump)
 	}
 
 	return resp, nil
@@ -478,6 +633,40 @@ func (api *API) Raw(ctx context.Context, method, endpoint string, data interface
 	return r, nil
 }
 
+// Call makes a request to an arbitrary API endpoint using the client's
+// authentication, retry policy and rate limiting, and unmarshals the `result`
+// of the response into out. It is intended for endpoints that are not yet
+// modelled by the library.
+//
+// For GET and DELETE requests params is encoded as the query string using
+// `url` struct tags; for other methods it is sent as the JSON request body.
+// Both params and out may be nil.
+func (api *API) Call(ctx context.Context, method, path string, params, out interface{}) error {
+	var body interface{}
+	if method == http.MethodGet || method == http.MethodDelete {
+		if params != nil {
+			path = buildURI(path, params)
+		}
+	} else {
+		body = params
+	}
+
+	r, err := api.Raw(ctx, method, path, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(r.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Result, out); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
+
 // PaginationOptions can be passed to a list request to configure paging
 // These values will be defaulted if omitted, and PerPage has min/max limits set by resource.
 type PaginationOptions struct {
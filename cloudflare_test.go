@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -121,6 +122,43 @@ func TestClient_Headers(t *testing.T) {
 	teardown()
 }
 
+func TestClient_RequestMiddleware(t *testing.T) {
+	var calls int
+	setup(UsingRequestMiddleware(
+		func(r *http.Request) error {
+			r.Header.Set("X-Correlation-ID", "abc123")
+			return nil
+		},
+		func(r *http.Request) error {
+			calls++
+			if r.Header.Get("X-Fail") != "" {
+				return errors.New("denied")
+			}
+			return nil
+		},
+	))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "abc123", r.Header.Get("X-Correlation-ID"))
+		assert.Equal(t, "audit", r.Header.Get("X-Audit"))
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	ctx := WithRequestHeaders(context.Background(), http.Header{"X-Audit": []string{"audit"}})
+	_, err := client.UserDetails(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	client.retryPolicy.MaxRetries = 2
+	ctx = WithRequestHeaders(ctx, http.Header{"X-Fail": []string{"1"}})
+	_, err = client.UserDetails(ctx)
+	assert.ErrorContains(t, err, "denied")
+	assert.Equal(t, 2, calls, "failing middleware should not be retried")
+}
+
 func TestClient_RetryCanSucceedAfterErrors(t *testing.T) {
 	setup(UsingRetryPolicy(2, 0, 1))
 	defer teardown()
@@ -547,3 +585,46 @@ func TestCheckResultInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestCall(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type widget struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/widgets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "blue", r.URL.Query().Get("color"))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "1", "name": "first"}]}`)
+		case http.MethodPost:
+			var body widget
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "second", body.Name)
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2", "name": "second"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	var list []widget
+	err := client.Call(context.Background(), http.MethodGet, "/accounts/"+testAccountID+"/widgets", struct {
+		Color string `url:"color"`
+	}{Color: "blue"}, &list)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []widget{{ID: "1", Name: "first"}}, list)
+	}
+
+	var created widget
+	err = client.Call(context.Background(), http.MethodPost, "/accounts/"+testAccountID+"/widgets", widget{Name: "second"}, &created)
+	if assert.NoError(t, err) {
+		assert.Equal(t, widget{ID: "2", Name: "second"}, created)
+	}
+
+	err = client.Call(context.Background(), http.MethodPost, "/accounts/"+testAccountID+"/widgets", widget{Name: "second"}, nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,174 @@
+package cloudflaretest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// ErrNoD1QueryHandler is returned by D1.QueryD1Database when no QueryHandler
+// has been configured.
+var ErrNoD1QueryHandler = errors.New("cloudflaretest: D1 has no QueryHandler configured")
+
+// D1QueryHandler answers a query made against an in-memory D1 database.
+type D1QueryHandler func(databaseID, sql string, params []string) ([]cloudflare.D1Result, error)
+
+// D1 is an in-memory implementation of cloudflare.D1Client. Database
+// management is fully emulated; as no SQL engine is embedded, queries are
+// delegated to QueryHandler. The zero value is not usable, use NewD1.
+type D1 struct {
+	// QueryHandler is called for every QueryD1Database call against an
+	// existing database.
+	QueryHandler D1QueryHandler
+
+	mu        sync.Mutex
+	databases map[string]d1Database
+	nextID    int
+}
+
+type d1Database struct {
+	accountID string
+	database  cloudflare.D1Database
+}
+
+var _ cloudflare.D1Client = (*D1)(nil)
+
+// NewD1 returns an empty in-memory D1 with queries answered by handler.
+func NewD1(handler D1QueryHandler) *D1 {
+	return &D1{
+		QueryHandler: handler,
+		databases:    make(map[string]d1Database),
+	}
+}
+
+// ListD1Databases returns the databases in the account ordered by name,
+// optionally filtered by name.
+func (d *D1) ListD1Databases(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListD1DatabasesParams) ([]cloudflare.D1Database, *cloudflare.ResultInfo, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return []cloudflare.D1Database{}, &cloudflare.ResultInfo{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	databases := []cloudflare.D1Database{}
+	for _, db := range d.databases {
+		if db.accountID != rc.Identifier {
+			continue
+		}
+		if params.Name != "" && !strings.Contains(db.database.Name, params.Name) {
+			continue
+		}
+		databases = append(databases, db.database)
+	}
+
+	sort.Slice(databases, func(i, j int) bool { return databases[i].Name < databases[j].Name })
+
+	return databases, &cloudflare.ResultInfo{
+		Page:       1,
+		PerPage:    len(databases),
+		Count:      len(databases),
+		Total:      len(databases),
+		TotalPages: 1,
+	}, nil
+}
+
+// CreateD1Database creates an empty database. Names must be unique within an
+// account.
+func (d *D1) CreateD1Database(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateD1DatabaseParams) (cloudflare.D1Database, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return cloudflare.D1Database{}, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, db := range d.databases {
+		if db.accountID == rc.Identifier && db.database.Name == params.Name {
+			return cloudflare.D1Database{}, requestError(http.StatusBadRequest, 7502, "a database with that name already exists")
+		}
+	}
+
+	d.nextID++
+	now := time.Now().UTC()
+	database := cloudflare.D1Database{
+		Name:      params.Name,
+		UUID:      fmt.Sprintf("00000000-0000-4000-8000-%012x", d.nextID),
+		Version:   "production",
+		CreatedAt: &now,
+	}
+	d.databases[database.UUID] = d1Database{accountID: rc.Identifier, database: database}
+
+	return database, nil
+}
+
+// DeleteD1Database removes a database.
+func (d *D1) DeleteD1Database(ctx context.Context, rc *cloudflare.ResourceContainer, databaseID string) error {
+	if databaseID == "" {
+		return cloudflare.ErrMissingDatabaseID
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, err := d.database(rc, databaseID); err != nil {
+		return err
+	}
+
+	delete(d.databases, databaseID)
+
+	return nil
+}
+
+// GetD1Database returns a single database.
+func (d *D1) GetD1Database(ctx context.Context, rc *cloudflare.ResourceContainer, databaseID string) (cloudflare.D1Database, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.database(rc, databaseID)
+}
+
+// QueryD1Database passes the query to QueryHandler once the database has been
+// found.
+func (d *D1) QueryD1Database(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.QueryD1DatabaseParams) ([]cloudflare.D1Result, error) {
+	if params.DatabaseID == "" {
+		return []cloudflare.D1Result{}, cloudflare.ErrMissingDatabaseID
+	}
+
+	d.mu.Lock()
+	_, err := d.database(rc, params.DatabaseID)
+	handler := d.QueryHandler
+	d.mu.Unlock()
+
+	if err != nil {
+		return []cloudflare.D1Result{}, err
+	}
+
+	if handler == nil {
+		return []cloudflare.D1Result{}, ErrNoD1QueryHandler
+	}
+
+	return handler(params.DatabaseID, params.SQL, params.Parameters)
+}
+
+// database returns the database for id, ensuring it belongs to the account in
+// rc. The caller must hold d.mu.
+func (d *D1) database(rc *cloudflare.ResourceContainer, id string) (cloudflare.D1Database, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return cloudflare.D1Database{}, err
+	}
+
+	db, ok := d.databases[id]
+	if !ok || db.accountID != rc.Identifier {
+		return cloudflare.D1Database{}, notFoundError(7404, "the database could not be found")
+	}
+
+	return db.database, nil
+}
@@ -0,0 +1,66 @@
+package cloudflaretest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestD1(t *testing.T) {
+	ctx := context.Background()
+	rc := cloudflare.AccountIdentifier(testAccountID)
+
+	var queries []string
+	var client cloudflare.D1Client = NewD1(func(databaseID, sql string, params []string) ([]cloudflare.D1Result, error) {
+		queries = append(queries, sql)
+		return []cloudflare.D1Result{{
+			Success: cloudflare.BoolPtr(true),
+			Results: []map[string]any{{"id": 1, "name": params[0]}},
+		}}, nil
+	})
+
+	db, err := client.CreateD1Database(ctx, rc, cloudflare.CreateD1DatabaseParams{Name: "users"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	got, err := client.GetD1Database(ctx, rc, db.UUID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, db, got)
+	}
+
+	dbs, _, err := client.ListD1Databases(ctx, rc, cloudflare.ListD1DatabasesParams{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []cloudflare.D1Database{db}, dbs)
+	}
+
+	res, err := client.QueryD1Database(ctx, rc, cloudflare.QueryD1DatabaseParams{
+		DatabaseID: db.UUID,
+		SQL:        "SELECT * FROM users WHERE name = ?",
+		Parameters: []string{"alice"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "alice", res[0].Results[0]["name"])
+		assert.Equal(t, []string{"SELECT * FROM users WHERE name = ?"}, queries)
+	}
+
+	assert.NoError(t, client.DeleteD1Database(ctx, rc, db.UUID))
+
+	_, err = client.GetD1Database(ctx, rc, db.UUID)
+	var notFound *cloudflare.NotFoundError
+	assert.True(t, errors.As(err, &notFound))
+}
+
+func TestD1_NoQueryHandler(t *testing.T) {
+	ctx := context.Background()
+	rc := cloudflare.AccountIdentifier(testAccountID)
+
+	d1 := NewD1(nil)
+	db, _ := d1.CreateD1Database(ctx, rc, cloudflare.CreateD1DatabaseParams{Name: "users"})
+
+	_, err := d1.QueryD1Database(ctx, rc, cloudflare.QueryD1DatabaseParams{DatabaseID: db.UUID, SQL: "SELECT 1"})
+	assert.ErrorIs(t, err, ErrNoD1QueryHandler)
+}
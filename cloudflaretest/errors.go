@@ -0,0 +1,53 @@
+package cloudflaretest
+
+import (
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+func successResponse() cloudflare.Response {
+	return cloudflare.Response{
+		Success:  true,
+		Errors:   []cloudflare.ResponseInfo{},
+		Messages: []cloudflare.ResponseInfo{},
+	}
+}
+
+func validateAccountContainer(rc *cloudflare.ResourceContainer) error {
+	if rc.Level != cloudflare.AccountRouteLevel {
+		return cloudflare.ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return cloudflare.ErrMissingAccountID
+	}
+
+	return nil
+}
+
+// notFoundError mirrors the error returned by the client for a HTTP 404.
+func notFoundError(code int, message string) error {
+	err := cloudflare.NewNotFoundError(&cloudflare.Error{
+		Type:          cloudflare.ErrorTypeNotFound,
+		StatusCode:    http.StatusNotFound,
+		Errors:        []cloudflare.ResponseInfo{{Code: code, Message: message}},
+		ErrorCodes:    []int{code},
+		ErrorMessages: []string{message},
+	})
+
+	return &err
+}
+
+// requestError mirrors the error returned by the client for a HTTP 4xx.
+func requestError(status, code int, message string) error {
+	err := cloudflare.NewRequestError(&cloudflare.Error{
+		Type:          cloudflare.ErrorTypeRequest,
+		StatusCode:    status,
+		Errors:        []cloudflare.ResponseInfo{{Code: code, Message: message}},
+		ErrorCodes:    []int{code},
+		ErrorMessages: []string{message},
+	})
+
+	return &err
+}
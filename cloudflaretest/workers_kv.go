@@ -0,0 +1,348 @@
+// Package cloudflaretest provides in-memory implementations of cloudflare-go
+// client interfaces for use in unit tests of applications built on the SDK.
+package cloudflaretest
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+const defaultWorkersKVListLimit = 1000
+
+type workersKVEntry struct {
+	value      []byte
+	expiration int64
+	metadata   interface{}
+}
+
+type workersKVNamespace struct {
+	accountID string
+	title     string
+	entries   map[string]workersKVEntry
+}
+
+// WorkersKV is an in-memory implementation of cloudflare.WorkersKVClient.
+// Namespaces are scoped to the account identifier of the resource container
+// they are created with. The zero value is not usable, use NewWorkersKV.
+type WorkersKV struct {
+	// Now returns the current time and is used to evaluate key expiration.
+	// It defaults to time.Now.
+	Now func() time.Time
+
+	mu         sync.Mutex
+	namespaces map[string]*workersKVNamespace
+	nextID     int
+}
+
+var _ cloudflare.WorkersKVClient = (*WorkersKV)(nil)
+
+// NewWorkersKV returns an empty in-memory Workers KV store.
+func NewWorkersKV() *WorkersKV {
+	return &WorkersKV{
+		Now:        time.Now,
+		namespaces: make(map[string]*workersKVNamespace),
+	}
+}
+
+// CreateWorkersKVNamespace creates an empty namespace. As with the API,
+// titles must be unique within an account.
+func (kv *WorkersKV) CreateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateWorkersKVNamespaceParams) (cloudflare.WorkersKVNamespaceResponse, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return cloudflare.WorkersKVNamespaceResponse{}, err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	for _, ns := range kv.namespaces {
+		if ns.accountID == rc.Identifier && ns.title == params.Title {
+			return cloudflare.WorkersKVNamespaceResponse{}, requestError(http.StatusBadRequest, 10014, "a namespace with this account ID and title already exists")
+		}
+	}
+
+	kv.nextID++
+	id := fmt.Sprintf("%032x", kv.nextID)
+	kv.namespaces[id] = &workersKVNamespace{
+		accountID: rc.Identifier,
+		title:     params.Title,
+		entries:   make(map[string]workersKVEntry),
+	}
+
+	return cloudflare.WorkersKVNamespaceResponse{
+		Response: successResponse(),
+		Result:   cloudflare.WorkersKVNamespace{ID: id, Title: params.Title},
+	}, nil
+}
+
+// ListWorkersKVNamespaces returns all namespaces in the account ordered by
+// title.
+func (kv *WorkersKV) ListWorkersKVNamespaces(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVNamespacesParams) ([]cloudflare.WorkersKVNamespace, *cloudflare.ResultInfo, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return []cloudflare.WorkersKVNamespace{}, &cloudflare.ResultInfo{}, err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	namespaces := []cloudflare.WorkersKVNamespace{}
+	for id, ns := range kv.namespaces {
+		if ns.accountID == rc.Identifier {
+			namespaces = append(namespaces, cloudflare.WorkersKVNamespace{ID: id, Title: ns.title})
+		}
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Title < namespaces[j].Title })
+
+	return namespaces, &cloudflare.ResultInfo{
+		Page:       1,
+		PerPage:    len(namespaces),
+		Count:      len(namespaces),
+		Total:      len(namespaces),
+		TotalPages: 1,
+	}, nil
+}
+
+// GetWorkersKVNamespace returns a single namespace.
+func (kv *WorkersKV) GetWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.WorkersKVNamespace, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, namespaceID)
+	if err != nil {
+		return cloudflare.WorkersKVNamespace{}, err
+	}
+
+	return cloudflare.WorkersKVNamespace{ID: namespaceID, Title: ns.title}, nil
+}
+
+// DeleteWorkersKVNamespace removes a namespace and all of its entries.
+func (kv *WorkersKV) DeleteWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, namespaceID string) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if _, err := kv.namespace(rc, namespaceID); err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	delete(kv.namespaces, namespaceID)
+
+	return successResponse(), nil
+}
+
+// UpdateWorkersKVNamespace renames a namespace.
+func (kv *WorkersKV) UpdateWorkersKVNamespace(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateWorkersKVNamespaceParams) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	ns.title = params.Title
+
+	return successResponse(), nil
+}
+
+// WriteWorkersKVEntry stores a single value.
+func (kv *WorkersKV) WriteWorkersKVEntry(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.WriteWorkersKVEntryParams) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	value := make([]byte, len(params.Value))
+	copy(value, params.Value)
+	ns.entries[params.Key] = workersKVEntry{value: value}
+
+	return successResponse(), nil
+}
+
+// WriteWorkersKVEntries stores multiple values, honouring expiration, TTLs,
+// metadata and base64 encoded values.
+func (kv *WorkersKV) WriteWorkersKVEntries(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.WriteWorkersKVEntriesParams) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	now := kv.now()
+	entries := make(map[string]workersKVEntry, len(params.KVs))
+	for _, pair := range params.KVs {
+		value := []byte(pair.Value)
+		if pair.Base64 {
+			value, err = base64.StdEncoding.DecodeString(pair.Value)
+			if err != nil {
+				return cloudflare.Response{}, requestError(http.StatusBadRequest, 10020, fmt.Sprintf("invalid base64 value for key %q", pair.Key))
+			}
+		}
+
+		entry := workersKVEntry{value: value, metadata: pair.Metadata, expiration: int64(pair.Expiration)}
+		if pair.ExpirationTTL > 0 {
+			entry.expiration = now.Add(time.Duration(pair.ExpirationTTL) * time.Second).Unix()
+		}
+
+		entries[pair.Key] = entry
+	}
+
+	for k, v := range entries {
+		ns.entries[k] = v
+	}
+
+	return successResponse(), nil
+}
+
+// GetWorkersKV returns the value stored for a key or a *cloudflare.NotFoundError
+// if the key does not exist or has expired.
+func (kv *WorkersKV) GetWorkersKV(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.GetWorkersKVParams) ([]byte, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := ns.entries[params.Key]
+	if !ok || kv.expired(entry) {
+		return nil, notFoundError(10009, "get: 'key not found'")
+	}
+
+	value := make([]byte, len(entry.value))
+	copy(value, entry.value)
+
+	return value, nil
+}
+
+// DeleteWorkersKVEntry removes a key. Deleting a missing key is not an error.
+func (kv *WorkersKV) DeleteWorkersKVEntry(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkersKVEntryParams) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	delete(ns.entries, params.Key)
+
+	return successResponse(), nil
+}
+
+// DeleteWorkersKVEntries removes multiple keys.
+func (kv *WorkersKV) DeleteWorkersKVEntries(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.DeleteWorkersKVEntriesParams) (cloudflare.Response, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.Response{}, err
+	}
+
+	for _, k := range params.Keys {
+		delete(ns.entries, k)
+	}
+
+	return successResponse(), nil
+}
+
+// ListWorkersKVKeys returns the keys in a namespace in lexicographic order,
+// filtered by Prefix and paginated with Limit and Cursor like the API.
+func (kv *WorkersKV) ListWorkersKVKeys(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListWorkersKVsParams) (cloudflare.ListStorageKeysResponse, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	ns, err := kv.namespace(rc, params.NamespaceID)
+	if err != nil {
+		return cloudflare.ListStorageKeysResponse{}, err
+	}
+
+	names := make([]string, 0, len(ns.entries))
+	for name, entry := range ns.entries {
+		if strings.HasPrefix(name, params.Prefix) && !kv.expired(entry) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	start := 0
+	if params.Cursor != "" {
+		cursor, err := base64.RawURLEncoding.DecodeString(params.Cursor)
+		if err != nil {
+			return cloudflare.ListStorageKeysResponse{}, requestError(http.StatusBadRequest, 10021, "invalid cursor")
+		}
+		start = sort.SearchStrings(names, string(cursor))
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > defaultWorkersKVListLimit {
+		limit = defaultWorkersKVListLimit
+	}
+
+	end := start + limit
+	if end > len(names) {
+		end = len(names)
+	}
+
+	keys := make([]cloudflare.StorageKey, 0, end-start)
+	for _, name := range names[start:end] {
+		entry := ns.entries[name]
+		keys = append(keys, cloudflare.StorageKey{
+			Name:       name,
+			Expiration: int(entry.expiration),
+			Metadata:   entry.metadata,
+		})
+	}
+
+	info := cloudflare.ResultInfo{Count: len(keys)}
+	if end < len(names) {
+		info.Cursor = base64.RawURLEncoding.EncodeToString([]byte(names[end]))
+	}
+
+	return cloudflare.ListStorageKeysResponse{
+		Response:   successResponse(),
+		Result:     keys,
+		ResultInfo: info,
+	}, nil
+}
+
+// namespace returns the namespace for id, ensuring it belongs to the account
+// in rc. The caller must hold kv.mu.
+func (kv *WorkersKV) namespace(rc *cloudflare.ResourceContainer, id string) (*workersKVNamespace, error) {
+	if err := validateAccountContainer(rc); err != nil {
+		return nil, err
+	}
+
+	ns, ok := kv.namespaces[id]
+	if !ok || ns.accountID != rc.Identifier {
+		return nil, notFoundError(10013, "namespace not found")
+	}
+
+	return ns, nil
+}
+
+func (kv *WorkersKV) now() time.Time {
+	if kv.Now == nil {
+		return time.Now()
+	}
+
+	return kv.Now()
+}
+
+func (kv *WorkersKV) expired(entry workersKVEntry) bool {
+	return entry.expiration > 0 && kv.now().Unix() >= entry.expiration
+}
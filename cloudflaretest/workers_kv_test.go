@@ -0,0 +1,115 @@
+package cloudflaretest
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountID = "01a7362d577a6c3019a474fd6f485823"
+
+func TestWorkersKV(t *testing.T) {
+	ctx := context.Background()
+	rc := cloudflare.AccountIdentifier(testAccountID)
+
+	var client cloudflare.WorkersKVClient = NewWorkersKV()
+
+	ns, err := client.CreateWorkersKVNamespace(ctx, rc, cloudflare.CreateWorkersKVNamespaceParams{Title: "sessions"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = client.CreateWorkersKVNamespace(ctx, rc, cloudflare.CreateWorkersKVNamespaceParams{Title: "sessions"})
+	assert.Error(t, err)
+
+	got, err := client.GetWorkersKVNamespace(ctx, rc, ns.Result.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ns.Result, got)
+	}
+
+	_, err = client.WriteWorkersKVEntry(ctx, rc, cloudflare.WriteWorkersKVEntryParams{
+		NamespaceID: ns.Result.ID,
+		Key:         "user:1",
+		Value:       []byte("alice"),
+	})
+	assert.NoError(t, err)
+
+	value, err := client.GetWorkersKV(ctx, rc, cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "user:1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("alice"), value)
+	}
+
+	_, err = client.GetWorkersKV(ctx, rc, cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "user:2"})
+	var notFound *cloudflare.NotFoundError
+	assert.True(t, errors.As(err, &notFound))
+
+	_, err = client.DeleteWorkersKVEntry(ctx, rc, cloudflare.DeleteWorkersKVEntryParams{NamespaceID: ns.Result.ID, Key: "user:1"})
+	assert.NoError(t, err)
+
+	_, err = client.GetWorkersKV(ctx, rc, cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "user:1"})
+	assert.True(t, errors.As(err, &notFound))
+
+	_, err = client.GetWorkersKV(ctx, cloudflare.AccountIdentifier("9a7806061c88ada191ed06f989cc3dac"), cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "user:1"})
+	assert.True(t, errors.As(err, &notFound), "namespaces should be scoped to their account")
+
+	_, err = client.GetWorkersKV(ctx, cloudflare.ZoneIdentifier("d56084adb405e0b7e32c52321bf07be6"), cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "user:1"})
+	assert.ErrorIs(t, err, cloudflare.ErrRequiredAccountLevelResourceContainer)
+}
+
+func TestWorkersKV_BulkAndList(t *testing.T) {
+	ctx := context.Background()
+	rc := cloudflare.AccountIdentifier(testAccountID)
+
+	now := time.Unix(1700000000, 0)
+	kv := NewWorkersKV()
+	kv.Now = func() time.Time { return now }
+
+	ns, _ := kv.CreateWorkersKVNamespace(ctx, rc, cloudflare.CreateWorkersKVNamespaceParams{Title: "cache"})
+
+	_, err := kv.WriteWorkersKVEntries(ctx, rc, cloudflare.WriteWorkersKVEntriesParams{
+		NamespaceID: ns.Result.ID,
+		KVs: []*cloudflare.WorkersKVPair{
+			{Key: "a", Value: "1"},
+			{Key: "b", Value: base64.StdEncoding.EncodeToString([]byte("2")), Base64: true},
+			{Key: "c", Value: "3", Metadata: map[string]interface{}{"v": 1}},
+			{Key: "d", Value: "4", ExpirationTTL: 60},
+			{Key: "other", Value: "5"},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	value, _ := kv.GetWorkersKV(ctx, rc, cloudflare.GetWorkersKVParams{NamespaceID: ns.Result.ID, Key: "b"})
+	assert.Equal(t, []byte("2"), value)
+
+	page, err := kv.ListWorkersKVKeys(ctx, rc, cloudflare.ListWorkersKVsParams{NamespaceID: ns.Result.ID, Prefix: "", Limit: 2})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []cloudflare.StorageKey{{Name: "a"}, {Name: "b"}}, page.Result)
+		assert.NotEmpty(t, page.Cursor)
+	}
+
+	page, err = kv.ListWorkersKVKeys(ctx, rc, cloudflare.ListWorkersKVsParams{NamespaceID: ns.Result.ID, Limit: 2, Cursor: page.Cursor})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []cloudflare.StorageKey{
+			{Name: "c", Metadata: map[string]interface{}{"v": 1}},
+			{Name: "d", Expiration: 1700000060},
+		}, page.Result)
+	}
+
+	now = now.Add(2 * time.Minute)
+	page, _ = kv.ListWorkersKVKeys(ctx, rc, cloudflare.ListWorkersKVsParams{NamespaceID: ns.Result.ID})
+	assert.Len(t, page.Result, 4, "expired keys should not be listed")
+	assert.Empty(t, page.Cursor)
+
+	_, err = kv.DeleteWorkersKVEntries(ctx, rc, cloudflare.DeleteWorkersKVEntriesParams{NamespaceID: ns.Result.ID, Keys: []string{"a", "b"}})
+	assert.NoError(t, err)
+
+	page, _ = kv.ListWorkersKVKeys(ctx, rc, cloudflare.ListWorkersKVsParams{NamespaceID: ns.Result.ID, Prefix: "o"})
+	assert.Equal(t, []cloudflare.StorageKey{{Name: "other"}}, page.Result)
+}
@@ -81,6 +81,34 @@ type CustomHostnameSSL struct {
 	ValidationRecords []SSLValidationRecord `json:"validation_records,omitempty"`
 	ValidationErrors  []SSLValidationError  `json:"validation_errors,omitempty"`
 	BundleMethod      string                `json:"bundle_method,omitempty"`
+
+	// CustomCertBundle uploads several custom certificates, such as an RSA
+	// and an ECDSA certificate, in place of CustomCertificate and CustomKey.
+	CustomCertBundle []CustomHostnameCustomCertificate `json:"custom_cert_bundle,omitempty"`
+}
+
+// CustomHostnameCustomCertificate is a custom certificate and its private
+// key uploaded for a custom hostname.
+type CustomHostnameCustomCertificate struct {
+	CustomCertificate string `json:"custom_certificate"`
+	CustomKey         string `json:"custom_key"`
+}
+
+// String implements fmt.Stringer, redacting the private key.
+func (c CustomHostnameCustomCertificate) String() string {
+	return redactedString(c.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the private key.
+func (c CustomHostnameCustomCertificate) GoString() string {
+	return redactedGoString("CustomHostnameCustomCertificate", c.redacted())
+}
+
+func (c CustomHostnameCustomCertificate) redacted() interface{} {
+	type redacted CustomHostnameCustomCertificate
+	r := redacted(c)
+	r.CustomKey = redact(r.CustomKey)
+	return r
 }
 
 // CustomMetadata defines custom metadata for the hostname. This requires logic to be implemented by Cloudflare to act on the data provided.
@@ -194,6 +222,41 @@ func (api *API) DeleteCustomHostname(ctx context.Context, zoneID string, customH
 	return nil
 }
 
+// UpdateCustomHostnameCertificate replaces a custom certificate of a custom
+// hostname that uses a bundle of custom certificates, leaving the others in
+// the certificate pack in place.
+//
+// API reference: https://developers.cloudflare.com/api/operations/custom-hostname-for-a-zone-edit-custom-certificate-custom-hostname
+func (api *API) UpdateCustomHostnameCertificate(ctx context.Context, zoneID, customHostnameID, certificatePackID, certificateID string, cert CustomHostnameCustomCertificate) (*CustomHostnameResponse, error) {
+	uri := fmt.Sprintf("/zones/%s/custom_hostnames/%s/certificate_pack/%s/certificates/%s", zoneID, customHostnameID, certificatePackID, certificateID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CustomHostnameResponse
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return response, nil
+}
+
+// DeleteCustomHostnameCertificate removes a custom certificate from the
+// bundle of a custom hostname. The last certificate of a bundle cannot be
+// removed.
+//
+// API reference: https://developers.cloudflare.com/api/operations/custom-hostname-for-a-zone-delete-single-certificate-and-key-for-custom-hostname
+func (api *API) DeleteCustomHostnameCertificate(ctx context.Context, zoneID, customHostnameID, certificatePackID, certificateID string) error {
+	uri := fmt.Sprintf("/zones/%s/custom_hostnames/%s/certificate_pack/%s/certificates/%s", zoneID, customHostnameID, certificatePackID, certificateID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CreateCustomHostname creates a new custom hostname and requests that an SSL certificate be issued for it.
 //
 // API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-create-custom-hostname
@@ -0,0 +1,238 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrMissingCustomHostnameAnalyticsTimeRange = errors.New("required custom hostname analytics time range (since and until) is missing")
+
+// CustomHostnameAnalyticsInterval is the width of each point of a custom
+// hostname analytics series.
+type CustomHostnameAnalyticsInterval string
+
+// Intervals of custom hostname analytics series.
+const (
+	CustomHostnameAnalyticsHourly CustomHostnameAnalyticsInterval = "datetimeHour"
+	CustomHostnameAnalyticsDaily  CustomHostnameAnalyticsInterval = "date"
+)
+
+// customHostnameAnalyticsQuery groups requests by hostname twice, once for
+// all requests and once for those answered with a 5xx status. The time
+// dimension is substituted with the interval.
+const customHostnameAnalyticsQuery = `query CustomHostnameAnalytics($zoneTag: string, $filter: ZoneHttpRequestsAdaptiveGroupsFilter_InputObject, $errorFilter: ZoneHttpRequestsAdaptiveGroupsFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      requests: httpRequestsAdaptiveGroups(filter: $filter, limit: $limit) {
+        count
+        sum {
+          edgeResponseBytes
+        }
+        dimensions {
+          clientRequestHTTPHost
+          %[1]s
+        }
+      }
+      errors: httpRequestsAdaptiveGroups(filter: $errorFilter, limit: $limit) {
+        count
+        dimensions {
+          clientRequestHTTPHost
+          %[1]s
+        }
+      }
+    }
+  }
+}`
+
+// CustomHostnameAnalyticsParams filters the traffic returned by
+// GetCustomHostnameAnalytics. Since and Until are required.
+type CustomHostnameAnalyticsParams struct {
+	Since time.Time
+	Until time.Time
+
+	// Hostnames limits the results to these custom hostnames. All hostnames
+	// of the zone are returned if empty.
+	Hostnames []string
+
+	// Interval defaults to CustomHostnameAnalyticsHourly.
+	Interval CustomHostnameAnalyticsInterval
+
+	// Limit is the maximum number of hostname and interval groups queried.
+	// It defaults to 10,000.
+	Limit int
+}
+
+// CustomHostnameAnalyticsPoint is the traffic of a hostname in one
+// interval. Errors counts requests answered with a 5xx status.
+type CustomHostnameAnalyticsPoint struct {
+	Time     time.Time
+	Requests int64
+	Bytes    int64
+	Errors   int64
+}
+
+// CustomHostnameAnalytics is the traffic series of a single hostname,
+// ordered by time, and its totals.
+type CustomHostnameAnalytics struct {
+	Hostname string
+	Series   []CustomHostnameAnalyticsPoint
+	Requests int64
+	Bytes    int64
+	Errors   int64
+}
+
+type customHostnameAnalyticsDimensions struct {
+	Host         string `json:"clientRequestHTTPHost"`
+	DatetimeHour string `json:"datetimeHour"`
+	Date         string `json:"date"`
+}
+
+type customHostnameAnalyticsResponse struct {
+	Viewer struct {
+		Zones []struct {
+			Requests []struct {
+				Count int64 `json:"count"`
+				Sum   struct {
+					EdgeResponseBytes int64 `json:"edgeResponseBytes"`
+				} `json:"sum"`
+				Dimensions customHostnameAnalyticsDimensions `json:"dimensions"`
+			} `json:"requests"`
+			Errors []struct {
+				Count      int64                             `json:"count"`
+				Dimensions customHostnameAnalyticsDimensions `json:"dimensions"`
+			} `json:"errors"`
+		} `json:"zones"`
+	} `json:"viewer"`
+}
+
+// GetCustomHostnameAnalytics returns the requests, bandwidth and errors
+// served for each custom hostname of an SSL for SaaS zone as a series over
+// time, for billing or monitoring customers individually. Hostnames are
+// ordered by name.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GetCustomHostnameAnalytics(ctx context.Context, rc *ResourceContainer, params CustomHostnameAnalyticsParams) ([]CustomHostnameAnalytics, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []CustomHostnameAnalytics{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []CustomHostnameAnalytics{}, ErrMissingZoneID
+	}
+
+	if params.Since.IsZero() || params.Until.IsZero() {
+		return []CustomHostnameAnalytics{}, ErrMissingCustomHostnameAnalyticsTimeRange
+	}
+
+	interval := params.Interval
+	if interval == "" {
+		interval = CustomHostnameAnalyticsHourly
+	}
+	if interval != CustomHostnameAnalyticsHourly && interval != CustomHostnameAnalyticsDaily {
+		return []CustomHostnameAnalytics{}, fmt.Errorf("invalid custom hostname analytics interval %q", interval)
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq":  params.Since.UTC().Format(time.RFC3339),
+		"datetime_lt":   params.Until.UTC().Format(time.RFC3339),
+		"requestSource": "eyeball",
+	}
+	if len(params.Hostnames) > 0 {
+		filter["clientRequestHTTPHost_in"] = params.Hostnames
+	}
+
+	errorFilter := make(map[string]interface{}, len(filter)+1)
+	for k, v := range filter {
+		errorFilter[k] = v
+	}
+	errorFilter["edgeResponseStatus_geq"] = 500
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 10000
+	}
+
+	var r customHostnameAnalyticsResponse
+	err := api.GraphQL(ctx, GraphQLQuery{
+		Query: fmt.Sprintf(customHostnameAnalyticsQuery, interval),
+		Variables: map[string]interface{}{
+			"zoneTag":     rc.Identifier,
+			"filter":      filter,
+			"errorFilter": errorFilter,
+			"limit":       limit,
+		},
+	}, &r)
+	if err != nil {
+		return []CustomHostnameAnalytics{}, err
+	}
+
+	if len(r.Viewer.Zones) == 0 {
+		return []CustomHostnameAnalytics{}, nil
+	}
+
+	type key struct {
+		host string
+		t    time.Time
+	}
+	points := make(map[key]*CustomHostnameAnalyticsPoint)
+	point := func(d customHostnameAnalyticsDimensions) (*CustomHostnameAnalyticsPoint, error) {
+		var t time.Time
+		var err error
+		if interval == CustomHostnameAnalyticsDaily {
+			t, err = time.Parse("2006-01-02", d.Date)
+		} else {
+			t, err = time.Parse(time.RFC3339, d.DatetimeHour)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing custom hostname analytics time: %w", err)
+		}
+
+		k := key{host: d.Host, t: t}
+		if points[k] == nil {
+			points[k] = &CustomHostnameAnalyticsPoint{Time: t}
+		}
+		return points[k], nil
+	}
+
+	zone := r.Viewer.Zones[0]
+	for _, g := range zone.Requests {
+		p, err := point(g.Dimensions)
+		if err != nil {
+			return []CustomHostnameAnalytics{}, err
+		}
+		p.Requests += g.Count
+		p.Bytes += g.Sum.EdgeResponseBytes
+	}
+	for _, g := range zone.Errors {
+		p, err := point(g.Dimensions)
+		if err != nil {
+			return []CustomHostnameAnalytics{}, err
+		}
+		p.Errors += g.Count
+	}
+
+	byHost := make(map[string]*CustomHostnameAnalytics)
+	for k, p := range points {
+		h := byHost[k.host]
+		if h == nil {
+			h = &CustomHostnameAnalytics{Hostname: k.host}
+			byHost[k.host] = h
+		}
+		h.Series = append(h.Series, *p)
+		h.Requests += p.Requests
+		h.Bytes += p.Bytes
+		h.Errors += p.Errors
+	}
+
+	analytics := make([]CustomHostnameAnalytics, 0, len(byHost))
+	for _, h := range byHost {
+		sort.Slice(h.Series, func(i, j int) bool { return h.Series[i].Time.Before(h.Series[j].Time) })
+		analytics = append(analytics, *h)
+	}
+	sort.Slice(analytics, func(i, j int) bool { return analytics[i].Hostname < analytics[j].Hostname })
+
+	return analytics, nil
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCustomHostnameAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 10, 1, 2, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var q GraphQLQuery
+		_ = json.Unmarshal(body, &q)
+		assert.Contains(t, q.Query, "datetimeHour")
+		assert.Equal(t, testZoneID, q.Variables["zoneTag"])
+		assert.Equal(t, map[string]interface{}{
+			"datetime_geq":             "2024-10-01T00:00:00Z",
+			"datetime_lt":              "2024-10-01T02:00:00Z",
+			"requestSource":            "eyeball",
+			"clientRequestHTTPHost_in": []interface{}{"a.example.com", "b.example.com"},
+		}, q.Variables["filter"])
+		assert.Equal(t, float64(500), q.Variables["errorFilter"].(map[string]interface{})["edgeResponseStatus_geq"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{
+						"requests": [
+							{"count": 20, "sum": {"edgeResponseBytes": 2000}, "dimensions": {"clientRequestHTTPHost": "b.example.com", "datetimeHour": "2024-10-01T00:00:00Z"}},
+							{"count": 10, "sum": {"edgeResponseBytes": 1000}, "dimensions": {"clientRequestHTTPHost": "a.example.com", "datetimeHour": "2024-10-01T01:00:00Z"}},
+							{"count": 5, "sum": {"edgeResponseBytes": 500}, "dimensions": {"clientRequestHTTPHost": "a.example.com", "datetimeHour": "2024-10-01T00:00:00Z"}}
+						],
+						"errors": [
+							{"count": 2, "dimensions": {"clientRequestHTTPHost": "a.example.com", "datetimeHour": "2024-10-01T01:00:00Z"}}
+						]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	})
+
+	want := []CustomHostnameAnalytics{
+		{
+			Hostname: "a.example.com",
+			Series: []CustomHostnameAnalyticsPoint{
+				{Time: since, Requests: 5, Bytes: 500},
+				{Time: since.Add(time.Hour), Requests: 10, Bytes: 1000, Errors: 2},
+			},
+			Requests: 15,
+			Bytes:    1500,
+			Errors:   2,
+		},
+		{
+			Hostname: "b.example.com",
+			Series: []CustomHostnameAnalyticsPoint{
+				{Time: since, Requests: 20, Bytes: 2000},
+			},
+			Requests: 20,
+			Bytes:    2000,
+		},
+	}
+
+	actual, err := client.GetCustomHostnameAnalytics(context.Background(), ZoneIdentifier(testZoneID), CustomHostnameAnalyticsParams{
+		Since:     since,
+		Until:     until,
+		Hostnames: []string{"a.example.com", "b.example.com"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.GetCustomHostnameAnalytics(context.Background(), ZoneIdentifier(testZoneID), CustomHostnameAnalyticsParams{})
+	assert.Equal(t, ErrMissingCustomHostnameAnalyticsTimeRange, err)
+}
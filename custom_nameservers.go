@@ -9,6 +9,13 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Statuses of a custom nameserver's DNS records.
+const (
+	CustomNameserverStatusMoved    = "moved"
+	CustomNameserverStatusPending  = "pending"
+	CustomNameserverStatusVerified = "verified"
+)
+
 type CustomNameserverRecord struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
@@ -64,6 +71,8 @@ type DeleteCustomNameserversParams struct {
 
 type GetEligibleZonesAccountCustomNameserversParams struct{}
 
+type VerifyCustomNameserversParams struct{}
+
 type GetCustomNameserverZoneMetadataParams struct{}
 
 type UpdateCustomNameserverZoneMetadataParams struct {
@@ -140,6 +149,31 @@ func (api *API) DeleteCustomNameservers(ctx context.Context, rc *ResourceContain
 	return nil
 }
 
+// VerifyCustomNameservers checks the glue records of the account's custom
+// nameservers and returns the nameservers with their updated Status.
+//
+// API documentation: https://developers.cloudflare.com/api/operations/account-level-custom-nameservers-verify-account-custom-nameserver-glue-records
+func (api *API) VerifyCustomNameservers(ctx context.Context, rc *ResourceContainer, params VerifyCustomNameserversParams) ([]CustomNameserverResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return []CustomNameserverResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	uri := fmt.Sprintf("/%s/%s/custom_ns/verify", rc.Level, rc.Identifier)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response customNameserverListResponse
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return response.Result, nil
+}
+
 // GetEligibleZonesAccountCustomNameservers lists zones eligible for custom nameservers.
 //
 // API documentation: https://developers.cloudflare.com/api/operations/account-level-custom-nameservers-get-eligible-zones-for-account-custom-nameservers
@@ -150,6 +150,49 @@ func TestAccountCustomNameserver_Create(t *testing.T) {
 	}
 }
 
+func TestAccountCustomNameserver_Verify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"ns_name": "ns1.example.com",
+					"ns_set": 1,
+					"status": "verified",
+					"zone_tag": "023e105f4ecef8ad9ca31a8372d0c353",
+					"dns_records": [{"type": "A", "value": "192.0.2.1"}]
+				}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/custom_ns/verify", handler)
+	want := []CustomNameserverResult{
+		{
+			DNSRecords: []CustomNameserverRecord{{Type: "A", Value: "192.0.2.1"}},
+			NSName:     "ns1.example.com",
+			NSSet:      1,
+			Status:     CustomNameserverStatusVerified,
+			ZoneTag:    "023e105f4ecef8ad9ca31a8372d0c353",
+		},
+	}
+
+	_, err := client.VerifyCustomNameservers(context.Background(), ZoneIdentifier(testZoneID), VerifyCustomNameserversParams{})
+	assert.Equal(t, ErrRequiredAccountLevelResourceContainer, err)
+
+	actual, err := client.VerifyCustomNameservers(context.Background(), AccountIdentifier(testAccountID), VerifyCustomNameserversParams{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
 func TestAccountCustomNameserver_GetEligibleZones(t *testing.T) {
 	setup()
 	defer teardown()
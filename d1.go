@@ -69,6 +69,19 @@ type QueryD1Response struct {
 	Response
 }
 
+// D1Client is the set of D1 operations implemented by *API. Application code
+// can depend on this interface and substitute the in-memory implementation
+// from the cloudflaretest package in unit tests.
+type D1Client interface {
+	ListD1Databases(ctx context.Context, rc *ResourceContainer, params ListD1DatabasesParams) ([]D1Database, *ResultInfo, error)
+	CreateD1Database(ctx context.Context, rc *ResourceContainer, params CreateD1DatabaseParams) (D1Database, error)
+	DeleteD1Database(ctx context.Context, rc *ResourceContainer, databaseID string) error
+	GetD1Database(ctx context.Context, rc *ResourceContainer, databaseID string) (D1Database, error)
+	QueryD1Database(ctx context.Context, rc *ResourceContainer, params QueryD1DatabaseParams) ([]D1Result, error)
+}
+
+var _ D1Client = (*API)(nil)
+
 // ListD1Databases returns all databases for an account.
 //
 // API reference: https://developers.cloudflare.com/api/operations/cloudflare-d1-list-databases
@@ -0,0 +1,115 @@
+package cloudflare
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeprecationNotice holds the deprecation and sunset information returned by
+// the API for an endpoint that is scheduled for removal.
+type DeprecationNotice struct {
+	// Method and Path identify the request that received the notice.
+	Method string
+	Path   string
+
+	// Deprecation is when the endpoint was, or will be, deprecated. It is nil
+	// when the API only indicates that the endpoint is deprecated without a
+	// date.
+	Deprecation *time.Time
+
+	// Sunset is when the endpoint is expected to stop responding.
+	Sunset *time.Time
+
+	// Link is the documentation URL describing the deprecation, if provided.
+	Link string
+}
+
+// DeprecationHandler is called for every response that includes deprecation
+// or sunset headers.
+type DeprecationHandler func(notice DeprecationNotice)
+
+// DeprecationWarningLogger returns a DeprecationHandler that writes a warning
+// for each notice to logger.
+func DeprecationWarningLogger(logger Logger) DeprecationHandler {
+	return func(n DeprecationNotice) {
+		msg := "[warn] " + n.Method + " " + n.Path + " is deprecated"
+		if n.Sunset != nil {
+			msg += " and will be removed on " + n.Sunset.UTC().Format(time.RFC3339)
+		}
+		if n.Link != "" {
+			msg += " (see " + n.Link + ")"
+		}
+
+		logger.Printf("%s", msg)
+	}
+}
+
+// parseDeprecationNotice extracts the `Deprecation`, `Sunset` and
+// deprecation `Link` headers from a response. The boolean result is false
+// when the response does not signal a deprecation.
+func parseDeprecationNotice(method, path string, h http.Header) (DeprecationNotice, bool) {
+	deprecation := h.Get("Deprecation")
+	sunset := h.Get("Sunset")
+
+	if deprecation == "" && sunset == "" {
+		return DeprecationNotice{}, false
+	}
+
+	notice := DeprecationNotice{
+		Method: method,
+		Path:   path,
+		Link:   parseDeprecationLink(h.Values("Link")),
+	}
+
+	switch {
+	case strings.HasPrefix(deprecation, "@"):
+		// RFC 9745 structured field date, seconds since the epoch.
+		if secs, err := strconv.ParseInt(strings.TrimPrefix(deprecation, "@"), 10, 64); err == nil {
+			t := time.Unix(secs, 0).UTC()
+			notice.Deprecation = &t
+		}
+	case deprecation != "" && deprecation != "true":
+		// Earlier drafts used an HTTP-date.
+		if t, err := http.ParseTime(deprecation); err == nil {
+			notice.Deprecation = &t
+		}
+	}
+
+	if sunset != "" {
+		if t, err := http.ParseTime(sunset); err == nil {
+			notice.Sunset = &t
+		}
+	}
+
+	return notice, true
+}
+
+// parseDeprecationLink returns the target of the first `Link` header value
+// with a relation type of "deprecation" or "sunset".
+func parseDeprecationLink(links []string) string {
+	for _, header := range links {
+		for _, link := range strings.Split(header, ",") {
+			parts := strings.Split(link, ";")
+			if len(parts) < 2 {
+				continue
+			}
+
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			for _, param := range parts[1:] {
+				param = strings.TrimSpace(param)
+				if !strings.HasPrefix(strings.ToLower(param), "rel=") {
+					continue
+				}
+
+				rel := strings.Trim(param[len("rel="):], `"`)
+				if rel == "deprecation" || rel == "sunset" {
+					return target
+				}
+			}
+		}
+	}
+
+	return ""
+}
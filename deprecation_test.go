@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeprecationNotice(t *testing.T) {
+	h := make(http.Header)
+	_, ok := parseDeprecationNotice(http.MethodGet, "/zones", h)
+	assert.False(t, ok)
+
+	h.Set("Deprecation", "@1735689600")
+	h.Set("Sunset", "Wed, 01 Jul 2026 00:00:00 GMT")
+	h.Add("Link", `<https://developers.cloudflare.com/fundamentals/api/reference/deprecations/>; rel="deprecation"; type="text/html"`)
+
+	notice, ok := parseDeprecationNotice(http.MethodGet, "/zones", h)
+	if assert.True(t, ok) {
+		deprecation := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		sunset := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, DeprecationNotice{
+			Method:      http.MethodGet,
+			Path:        "/zones",
+			Deprecation: &deprecation,
+			Sunset:      &sunset,
+			Link:        "https://developers.cloudflare.com/fundamentals/api/reference/deprecations/",
+		}, notice)
+	}
+
+	h = make(http.Header)
+	h.Set("Deprecation", "true")
+	notice, ok = parseDeprecationNotice(http.MethodGet, "/zones", h)
+	if assert.True(t, ok) {
+		assert.Nil(t, notice.Deprecation)
+		assert.Nil(t, notice.Sunset)
+		assert.Empty(t, notice.Link)
+	}
+}
+
+func TestUsingDeprecationHandler(t *testing.T) {
+	var notices []DeprecationNotice
+	setup(UsingDeprecationHandler(func(n DeprecationNotice) {
+		notices = append(notices, n)
+	}))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/legacy", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Sunset", "Wed, 01 Jul 2026 00:00:00 GMT")
+		fmt.Fprint(w, `{ "success": true, "errors": [], "messages": [], "result": {} }`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/current", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{ "success": true, "errors": [], "messages": [], "result": {} }`)
+	})
+
+	_, err := client.Raw(context.Background(), http.MethodGet, "/zones/"+testZoneID+"/current", nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, notices)
+
+	_, err = client.Raw(context.Background(), http.MethodGet, "/zones/"+testZoneID+"/legacy", nil, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, notices, 1) {
+		assert.Equal(t, "/zones/"+testZoneID+"/legacy", notices[0].Path)
+		assert.Equal(t, http.MethodGet, notices[0].Method)
+	}
+}
+
+func TestDeprecationWarningLogger(t *testing.T) {
+	var buf bytes.Buffer
+	handler := DeprecationWarningLogger(log.New(&buf, "", 0))
+
+	sunset := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	handler(DeprecationNotice{
+		Method: http.MethodGet,
+		Path:   "/zones",
+		Sunset: &sunset,
+		Link:   "https://example.com/deprecations",
+	})
+
+	assert.Equal(t, "[warn] GET /zones is deprecated and will be removed on 2026-07-01T00:00:00Z (see https://example.com/deprecations)\n", buf.String())
+}
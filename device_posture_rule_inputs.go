@@ -0,0 +1,231 @@
+package cloudflare
+
+// Device posture rule types.
+const (
+	DevicePostureRuleTypeFile                = "file"
+	DevicePostureRuleTypeApplication         = "application"
+	DevicePostureRuleTypeSerialNumber        = "serial_number"
+	DevicePostureRuleTypeOSVersion           = "os_version"
+	DevicePostureRuleTypeDiskEncryption      = "disk_encryption"
+	DevicePostureRuleTypeFirewall            = "firewall"
+	DevicePostureRuleTypeDomainJoined        = "domain_joined"
+	DevicePostureRuleTypeClientCertificate   = "client_certificate"
+	DevicePostureRuleTypeClientCertificateV2 = "client_certificate_v2"
+	DevicePostureRuleTypeUniqueClientID      = "unique_client_id"
+	DevicePostureRuleTypeGateway             = "gateway"
+	DevicePostureRuleTypeWARP                = "warp"
+	DevicePostureRuleTypeWorkspaceOne        = "workspace_one"
+	DevicePostureRuleTypeCrowdStrikeS2S      = "crowdstrike_s2s"
+	DevicePostureRuleTypeIntune              = "intune"
+	DevicePostureRuleTypeKolide              = "kolide"
+	DevicePostureRuleTypeTaniumS2S           = "tanium_s2s"
+	DevicePostureRuleTypeSentinelOne         = "sentinelone"
+	DevicePostureRuleTypeSentinelOneS2S      = "sentinelone_s2s"
+	DevicePostureRuleTypeCustomS2S           = "custom_s2s"
+)
+
+// Device posture integration types.
+const (
+	DevicePostureIntegrationTypeWorkspaceOne   = "workspace_one"
+	DevicePostureIntegrationTypeCrowdStrikeS2S = "crowdstrike_s2s"
+	DevicePostureIntegrationTypeUptycs         = "uptycs"
+	DevicePostureIntegrationTypeIntune         = "intune"
+	DevicePostureIntegrationTypeKolide         = "kolide"
+	DevicePostureIntegrationTypeTaniumS2S      = "tanium_s2s"
+	DevicePostureIntegrationTypeSentinelOneS2S = "sentinelone_s2s"
+	DevicePostureIntegrationTypeCustomS2S      = "custom_s2s"
+)
+
+// DevicePostureInput is implemented by the typed, per rule type, inputs
+// accepted by NewDevicePostureRule.
+type DevicePostureInput interface {
+	devicePostureRuleType() string
+	devicePostureRuleInput() DevicePostureRuleInput
+}
+
+// NewDevicePostureRule builds a DevicePostureRule of the type implied by
+// input, matching the given platforms (e.g. "windows", "mac", "linux").
+func NewDevicePostureRule(name string, platforms []string, input DevicePostureInput) DevicePostureRule {
+	match := make([]DevicePostureRuleMatch, 0, len(platforms))
+	for _, p := range platforms {
+		match = append(match, DevicePostureRuleMatch{Platform: p})
+	}
+
+	return DevicePostureRule{
+		Type:  input.devicePostureRuleType(),
+		Name:  name,
+		Match: match,
+		Input: input.devicePostureRuleInput(),
+	}
+}
+
+// DevicePostureFileInput checks for the presence of a file on the device.
+type DevicePostureFileInput struct {
+	Path       string
+	Exists     bool
+	Sha256     string
+	Thumbprint string
+}
+
+func (i DevicePostureFileInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeFile
+}
+
+func (i DevicePostureFileInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		Path:       i.Path,
+		Exists:     BoolPtr(i.Exists),
+		Sha256:     i.Sha256,
+		Thumbprint: i.Thumbprint,
+	}
+}
+
+// DevicePostureOSVersionInput compares the operating system version of the
+// device using Operator (e.g. ">=").
+type DevicePostureOSVersionInput struct {
+	Version          string
+	Operator         string
+	OSDistroName     string
+	OSDistroRevision string
+	OSVersionExtra   string
+}
+
+func (i DevicePostureOSVersionInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeOSVersion
+}
+
+func (i DevicePostureOSVersionInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		Version:          i.Version,
+		Operator:         i.Operator,
+		OsDistroName:     i.OSDistroName,
+		OsDistroRevision: i.OSDistroRevision,
+		OSVersionExtra:   i.OSVersionExtra,
+	}
+}
+
+// DevicePostureDiskEncryptionInput requires disks on the device to be
+// encrypted.
+type DevicePostureDiskEncryptionInput struct {
+	RequireAll bool
+	CheckDisks []string
+}
+
+func (i DevicePostureDiskEncryptionInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeDiskEncryption
+}
+
+func (i DevicePostureDiskEncryptionInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		RequireAll: BoolPtr(i.RequireAll),
+		CheckDisks: i.CheckDisks,
+	}
+}
+
+// DevicePostureFirewallInput requires the device firewall to be enabled.
+type DevicePostureFirewallInput struct {
+	Enabled bool
+}
+
+func (i DevicePostureFirewallInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeFirewall
+}
+
+func (i DevicePostureFirewallInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		Enabled: BoolPtr(i.Enabled),
+	}
+}
+
+// DevicePostureDomainJoinedInput requires the device to be joined to Domain.
+type DevicePostureDomainJoinedInput struct {
+	Domain string
+}
+
+func (i DevicePostureDomainJoinedInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeDomainJoined
+}
+
+func (i DevicePostureDomainJoinedInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		Domain: i.Domain,
+	}
+}
+
+// DevicePostureCrowdStrikeInput checks the device against a CrowdStrike
+// service to service integration.
+type DevicePostureCrowdStrikeInput struct {
+	ConnectionID    string
+	Overall         string
+	SensorConfig    string
+	Operator        string
+	Version         string
+	VersionOperator string
+	OS              string
+	State           string
+	LastSeen        string
+}
+
+func (i DevicePostureCrowdStrikeInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeCrowdStrikeS2S
+}
+
+func (i DevicePostureCrowdStrikeInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		ConnectionID:    i.ConnectionID,
+		Overall:         i.Overall,
+		SensorConfig:    i.SensorConfig,
+		Operator:        i.Operator,
+		Version:         i.Version,
+		VersionOperator: i.VersionOperator,
+		Os:              i.OS,
+		State:           i.State,
+		LastSeen:        i.LastSeen,
+	}
+}
+
+// DevicePostureIntuneInput checks the device compliance status reported by a
+// Microsoft Intune integration.
+type DevicePostureIntuneInput struct {
+	ConnectionID     string
+	ComplianceStatus string
+}
+
+func (i DevicePostureIntuneInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeIntune
+}
+
+func (i DevicePostureIntuneInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		ConnectionID:     i.ConnectionID,
+		ComplianceStatus: i.ComplianceStatus,
+	}
+}
+
+// DevicePostureSentinelOneS2SInput checks the device against a SentinelOne
+// service to service integration.
+type DevicePostureSentinelOneS2SInput struct {
+	ConnectionID     string
+	ActiveThreats    int
+	Operator         string
+	Infected         *bool
+	IsActive         *bool
+	NetworkStatus    string
+	OperationalState *string
+}
+
+func (i DevicePostureSentinelOneS2SInput) devicePostureRuleType() string {
+	return DevicePostureRuleTypeSentinelOneS2S
+}
+
+func (i DevicePostureSentinelOneS2SInput) devicePostureRuleInput() DevicePostureRuleInput {
+	return DevicePostureRuleInput{
+		ConnectionID:     i.ConnectionID,
+		ActiveThreats:    i.ActiveThreats,
+		Operator:         i.Operator,
+		Infected:         i.Infected,
+		IsActive:         i.IsActive,
+		NetworkStatus:    i.NetworkStatus,
+		OperationalState: i.OperationalState,
+	}
+}
@@ -0,0 +1,92 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDevicePostureRule(t *testing.T) {
+	rule := NewDevicePostureRule("macOS 14+", []string{"mac"}, DevicePostureOSVersionInput{
+		Version:  "14.0.0",
+		Operator: ">=",
+	})
+
+	assert.Equal(t, DevicePostureRule{
+		Type:  DevicePostureRuleTypeOSVersion,
+		Name:  "macOS 14+",
+		Match: []DevicePostureRuleMatch{{Platform: "mac"}},
+		Input: DevicePostureRuleInput{Version: "14.0.0", Operator: ">="},
+	}, rule)
+
+	rule = NewDevicePostureRule("encrypted", []string{"windows", "mac"}, DevicePostureDiskEncryptionInput{RequireAll: true})
+	assert.Equal(t, DevicePostureRuleTypeDiskEncryption, rule.Type)
+	assert.Equal(t, []DevicePostureRuleMatch{{Platform: "windows"}, {Platform: "mac"}}, rule.Match)
+	assert.Equal(t, DevicePostureRuleInput{RequireAll: BoolPtr(true)}, rule.Input)
+
+	rule = NewDevicePostureRule("intune compliant", []string{"windows"}, DevicePostureIntuneInput{
+		ConnectionID:     "a8a1b1d4-2a2d-4c9b-9d6c-27e3c4e4f4d1",
+		ComplianceStatus: "compliant",
+	})
+	assert.Equal(t, DevicePostureRuleTypeIntune, rule.Type)
+	assert.Equal(t, "compliant", rule.Input.ComplianceStatus)
+}
+
+func TestCreateDevicePostureRule_CrowdStrikeInput(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"type": "crowdstrike_s2s",
+			"name": "CrowdStrike overall score",
+			"match": [{ "platform": "windows" }],
+			"input": {
+				"connection_id": "a8a1b1d4-2a2d-4c9b-9d6c-27e3c4e4f4d1",
+				"overall": "90",
+				"operator": ">=",
+				"locations": {}
+			}
+		}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+				"type": "crowdstrike_s2s",
+				"name": "CrowdStrike overall score",
+				"match": [{ "platform": "windows" }],
+				"input": {
+					"connection_id": "a8a1b1d4-2a2d-4c9b-9d6c-27e3c4e4f4d1",
+					"overall": "90",
+					"operator": ">="
+				}
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/posture", handler)
+
+	rule := NewDevicePostureRule("CrowdStrike overall score", []string{"windows"}, DevicePostureCrowdStrikeInput{
+		ConnectionID: "a8a1b1d4-2a2d-4c9b-9d6c-27e3c4e4f4d1",
+		Overall:      "90",
+		Operator:     ">=",
+	})
+
+	actual, err := client.CreateDevicePostureRule(context.Background(), testAccountID, rule)
+	if assert.NoError(t, err) {
+		rule.ID = "480f4f69-1a28-4fdd-9240-1ed29f0ac1db"
+		assert.Equal(t, rule, actual)
+	}
+}
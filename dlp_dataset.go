@@ -276,3 +276,36 @@ func (api *API) UploadDLPDatasetVersion(ctx context.Context, rc *ResourceContain
 
 	return dlpDatasetUploadVersionResponse.Result, nil
 }
+
+type UploadDLPDatasetParams struct {
+	DatasetID string
+	Body      interface{}
+}
+
+// UploadDLPDataset replaces the contents of a DLP dataset by creating a new
+// upload version and uploading Body to it, returning the updated dataset.
+// For Exact Data Match datasets Body must already contain the hashed cells
+// as described in the API documentation.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-datasets-create-version
+// API reference: https://developers.cloudflare.com/api/operations/dlp-datasets-upload-version
+func (api *API) UploadDLPDataset(ctx context.Context, rc *ResourceContainer, params UploadDLPDatasetParams) (DLPDataset, error) {
+	if rc.Identifier == "" {
+		return DLPDataset{}, ErrMissingResourceIdentifier
+	}
+
+	if params.DatasetID == "" {
+		return DLPDataset{}, ErrMissingDatasetID
+	}
+
+	upload, err := api.CreateDLPDatasetUpload(ctx, rc, CreateDLPDatasetUploadParams{DatasetID: params.DatasetID})
+	if err != nil {
+		return DLPDataset{}, fmt.Errorf("failed to create DLP dataset upload: %w", err)
+	}
+
+	return api.UploadDLPDatasetVersion(ctx, rc, UploadDLPDatasetVersionParams{
+		DatasetID: params.DatasetID,
+		Version:   upload.Version,
+		Body:      params.Body,
+	})
+}
@@ -420,3 +420,60 @@ func TestUploadDLPDatasetVersion(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, actual)
 }
+
+func TestUploadDLPDataset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	datasetID := "497f6eca-6276-4993-bfeb-53cbbbba6f08"
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dlp/datasets/"+datasetID+"/upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"max_cells": 1000000,
+				"secret": "",
+				"version": 3
+			}
+		}`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dlp/datasets/"+datasetID+"/upload/3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, []byte("a\nb\n"), body)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "497f6eca-6276-4993-bfeb-53cbbbba6f08",
+				"name": "word list",
+				"num_cells": 2,
+				"status": "complete",
+				"uploads": [{ "num_cells": 2, "status": "complete", "version": 3 }]
+			}
+		}`)
+	})
+
+	actual, err := client.UploadDLPDataset(context.Background(), AccountIdentifier(testAccountID), UploadDLPDatasetParams{DatasetID: datasetID, Body: []byte("a\nb\n")})
+	require.NoError(t, err)
+	assert.Equal(t, DLPDataset{
+		ID:       datasetID,
+		Name:     "word list",
+		NumCells: 2,
+		Status:   "complete",
+		Uploads:  []DLPDatasetUpload{{NumCells: 2, Status: "complete", Version: 3}},
+	}, actual)
+
+	_, err = client.UploadDLPDataset(context.Background(), AccountIdentifier(testAccountID), UploadDLPDatasetParams{})
+	assert.ErrorIs(t, err, ErrMissingDatasetID)
+}
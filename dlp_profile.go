@@ -20,6 +20,27 @@ type DLPPattern struct {
 	Validation string `json:"validation,omitempty"`
 }
 
+// DLPConfidenceThreshold is the minimum confidence a match must reach for a
+// profile to trigger.
+type DLPConfidenceThreshold string
+
+const (
+	DLPConfidenceThresholdLow      DLPConfidenceThreshold = "low"
+	DLPConfidenceThresholdMedium   DLPConfidenceThreshold = "medium"
+	DLPConfidenceThresholdHigh     DLPConfidenceThreshold = "high"
+	DLPConfidenceThresholdVeryHigh DLPConfidenceThreshold = "very_high"
+)
+
+// DLPEntryConfidence describes the confidence levels supported by a
+// predefined entry.
+type DLPEntryConfidence struct {
+	// Available reports whether the entry supports confidence scoring.
+	Available bool `json:"available"`
+	// AIContextAvailable reports whether AI context analysis can be used to
+	// raise the confidence of a match.
+	AIContextAvailable bool `json:"ai_context_available"`
+}
+
 // DLPEntry represents a DLP Entry, which can be matched in HTTP bodies or files.
 type DLPEntry struct {
 	ID        string `json:"id,omitempty"`
@@ -28,6 +49,9 @@ type DLPEntry struct {
 	Enabled   *bool  `json:"enabled,omitempty"`
 	Type      string `json:"type,omitempty"`
 
+	// Confidence is only present for predefined entries.
+	Confidence *DLPEntryConfidence `json:"confidence,omitempty"`
+
 	// The following fields are only present for custom entries.
 
 	Pattern   *DLPPattern `json:"pattern,omitempty"`
@@ -57,6 +81,8 @@ type DLPProfile struct {
 	AllowedMatchCount int    `json:"allowed_match_count"`
 	OCREnabled        *bool  `json:"ocr_enabled,omitempty"`
 
+	ConfidenceThreshold DLPConfidenceThreshold `json:"confidence_threshold,omitempty"`
+
 	ContextAwareness *DLPContextAwareness `json:"context_awareness,omitempty"`
 
 	// The following fields are omitted for predefined DLP
@@ -236,6 +236,64 @@ func TestGetDLPProfile(t *testing.T) {
 	require.Equal(t, want, actual)
 }
 
+func TestGetDLPPredefinedProfile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "c8932cc4-3312-4152-8041-f3f257122dc4",
+				"name": "Credentials and Secrets",
+				"type": "predefined",
+				"allowed_match_count": 0,
+				"confidence_threshold": "high",
+				"entries": [
+					{
+						"id": "56a8c060-01bb-4f89-ba1e-3ad42770a342",
+						"name": "Amazon AWS Access Key ID",
+						"profile_id": "c8932cc4-3312-4152-8041-f3f257122dc4",
+						"enabled": true,
+						"type": "predefined",
+						"confidence": {
+							"available": true,
+							"ai_context_available": false
+						}
+					}
+				]
+			}
+		}`)
+	}
+
+	want := DLPProfile{
+		ID:                  "c8932cc4-3312-4152-8041-f3f257122dc4",
+		Name:                "Credentials and Secrets",
+		Type:                "predefined",
+		ConfidenceThreshold: DLPConfidenceThresholdHigh,
+		Entries: []DLPEntry{
+			{
+				ID:         "56a8c060-01bb-4f89-ba1e-3ad42770a342",
+				Name:       "Amazon AWS Access Key ID",
+				ProfileID:  "c8932cc4-3312-4152-8041-f3f257122dc4",
+				Enabled:    BoolPtr(true),
+				Type:       "predefined",
+				Confidence: &DLPEntryConfidence{Available: true},
+			},
+		},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dlp/profiles/c8932cc4-3312-4152-8041-f3f257122dc4", handler)
+
+	actual, err := client.GetDLPProfile(context.Background(), AccountIdentifier(testAccountID), "c8932cc4-3312-4152-8041-f3f257122dc4")
+	require.NoError(t, err)
+	require.Equal(t, want, actual)
+}
+
 func TestCreateDLPCustomProfiles(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,246 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMissingDMARCDomain           = errors.New("required DMARC domain missing")
+	ErrMissingDMARCReportingAddress = errors.New("required DMARC reporting address missing")
+	ErrMissingDMARCReportsTimeRange = errors.New("required DMARC report time range (since and until) is missing")
+)
+
+// EnableDMARCReportsParams enables DMARC Management report ingestion for a
+// domain of the zone.
+type EnableDMARCReportsParams struct {
+	// Domain is the domain whose DMARC policy is changed, usually the
+	// zone name.
+	Domain string
+
+	// ReportingAddress is the DMARC Management aggregate report address of
+	// the zone, shown in the dashboard when DMARC Management is enabled.
+	ReportingAddress string
+}
+
+// EnableDMARCReports has aggregate DMARC reports for a domain delivered to
+// DMARC Management by adding its reporting address to the rua tag of the
+// domain's _dmarc TXT record. Existing report recipients are kept. If the
+// domain has no DMARC record, a monitoring-only "p=none" record is created.
+//
+// API reference: https://developers.cloudflare.com/dmarc-management/enable/
+func (api *API) EnableDMARCReports(ctx context.Context, rc *ResourceContainer, params EnableDMARCReportsParams) (DNSRecord, error) {
+	if rc.Level != ZoneRouteLevel {
+		return DNSRecord{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return DNSRecord{}, ErrMissingZoneID
+	}
+
+	if params.Domain == "" {
+		return DNSRecord{}, ErrMissingDMARCDomain
+	}
+
+	if params.ReportingAddress == "" {
+		return DNSRecord{}, ErrMissingDMARCReportingAddress
+	}
+
+	name := "_dmarc." + params.Domain
+	records, _, err := api.ListDNSRecords(ctx, rc, ListDNSRecordsParams{Type: "TXT", Name: name})
+	if err != nil {
+		return DNSRecord{}, err
+	}
+
+	for _, record := range records {
+		if !isDMARCRecord(record.Content) {
+			continue
+		}
+
+		content := addDMARCReportingAddress(record.Content, params.ReportingAddress)
+		if content == record.Content {
+			return record, nil
+		}
+
+		return api.UpdateDNSRecord(ctx, rc, UpdateDNSRecordParams{
+			ID:      record.ID,
+			Type:    record.Type,
+			Name:    record.Name,
+			Content: content,
+			TTL:     record.TTL,
+			Tags:    record.Tags,
+		})
+	}
+
+	return api.CreateDNSRecord(ctx, rc, CreateDNSRecordParams{
+		Type:    "TXT",
+		Name:    name,
+		Content: "v=DMARC1; p=none; rua=mailto:" + params.ReportingAddress,
+	})
+}
+
+func isDMARCRecord(content string) bool {
+	content = strings.Trim(content, `"`)
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(content)), "V=DMARC1")
+}
+
+// addDMARCReportingAddress returns a DMARC record with address added to its
+// rua tag, or the record unchanged if address is already a recipient.
+func addDMARCReportingAddress(content, address string) string {
+	quoted := strings.HasPrefix(content, `"`) && strings.HasSuffix(content, `"`)
+	content = strings.Trim(content, `"`)
+	uri := "mailto:" + address
+
+	tags := strings.Split(content, ";")
+	found := false
+	for i, tag := range tags {
+		key, value, ok := strings.Cut(strings.TrimSpace(tag), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), "rua") {
+			continue
+		}
+
+		found = true
+		for _, existing := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(existing), uri) {
+				if quoted {
+					return `"` + content + `"`
+				}
+				return content
+			}
+		}
+		tags[i] = " rua=" + strings.TrimSpace(value) + "," + uri
+	}
+
+	if !found {
+		tags = append(tags, " rua="+uri)
+	}
+
+	// Drop the empty tag left by a trailing separator.
+	kept := tags[:0]
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) != "" {
+			kept = append(kept, tag)
+		}
+	}
+	content = strings.TrimSpace(strings.Join(kept, ";"))
+
+	if quoted {
+		return `"` + content + `"`
+	}
+	return content
+}
+
+// DMARCReportsParams selects the aggregate DMARC reports summarized by
+// GetDMARCReportSources. Since and Until are required.
+type DMARCReportsParams struct {
+	Since time.Time
+	Until time.Time
+
+	// Limit is the maximum number of groups returned. It defaults to 1,000.
+	Limit int
+}
+
+// DMARCReportSource is the number of messages an email source sent for the
+// zone's domains with one disposition and one DKIM and SPF outcome.
+type DMARCReportSource struct {
+	SourceOrgName string
+	Disposition   string
+	DKIM          string
+	SPF           string
+	Messages      int64
+}
+
+const dmarcReportSourcesQuery = `query DMARCReportSources($zoneTag: string, $filter: ZoneDmarcReportsSourcesAdaptiveGroupsFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      dmarcReportsSourcesAdaptiveGroups(filter: $filter, limit: $limit, orderBy: [sum_totalMatchingMessages_DESC]) {
+        sum {
+          totalMatchingMessages
+        }
+        dimensions {
+          sourceOrgName
+          disposition
+          dkim
+          spf
+        }
+      }
+    }
+  }
+}`
+
+type dmarcReportSourcesResponse struct {
+	Viewer struct {
+		Zones []struct {
+			Groups []struct {
+				Sum struct {
+					TotalMatchingMessages int64 `json:"totalMatchingMessages"`
+				} `json:"sum"`
+				Dimensions struct {
+					SourceOrgName string `json:"sourceOrgName"`
+					Disposition   string `json:"disposition"`
+					DKIM          string `json:"dkim"`
+					SPF           string `json:"spf"`
+				} `json:"dimensions"`
+			} `json:"dmarcReportsSourcesAdaptiveGroups"`
+		} `json:"zones"`
+	} `json:"viewer"`
+}
+
+// GetDMARCReportSources summarizes the aggregate DMARC reports received for
+// a zone over a time range by email source and disposition, busiest first.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GetDMARCReportSources(ctx context.Context, rc *ResourceContainer, params DMARCReportsParams) ([]DMARCReportSource, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []DMARCReportSource{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []DMARCReportSource{}, ErrMissingZoneID
+	}
+
+	if params.Since.IsZero() || params.Until.IsZero() {
+		return []DMARCReportSource{}, ErrMissingDMARCReportsTimeRange
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	var r dmarcReportSourcesResponse
+	err := api.GraphQL(ctx, GraphQLQuery{
+		Query: dmarcReportSourcesQuery,
+		Variables: map[string]interface{}{
+			"zoneTag": rc.Identifier,
+			"filter": map[string]interface{}{
+				"date_geq": params.Since.UTC().Format("2006-01-02"),
+				"date_leq": params.Until.UTC().Format("2006-01-02"),
+			},
+			"limit": limit,
+		},
+	}, &r)
+	if err != nil {
+		return []DMARCReportSource{}, err
+	}
+
+	if len(r.Viewer.Zones) == 0 {
+		return []DMARCReportSource{}, nil
+	}
+
+	groups := r.Viewer.Zones[0].Groups
+	sources := make([]DMARCReportSource, 0, len(groups))
+	for _, g := range groups {
+		sources = append(sources, DMARCReportSource{
+			SourceOrgName: g.Dimensions.SourceOrgName,
+			Disposition:   g.Dimensions.Disposition,
+			DKIM:          g.Dimensions.DKIM,
+			SPF:           g.Dimensions.SPF,
+			Messages:      g.Sum.TotalMatchingMessages,
+		})
+	}
+
+	return sources, nil
+}
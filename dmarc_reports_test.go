@@ -0,0 +1,135 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDMARCReportingAddress(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		want    string
+	}{
+		"no rua":          {"v=DMARC1; p=reject", "v=DMARC1; p=reject; rua=mailto:a@dmarc.example"},
+		"trailing ;":      {"v=DMARC1; p=none;", "v=DMARC1; p=none; rua=mailto:a@dmarc.example"},
+		"other recipient": {"v=DMARC1; p=none; rua=mailto:me@example.com", "v=DMARC1; p=none; rua=mailto:me@example.com,mailto:a@dmarc.example"},
+		"already present": {"v=DMARC1; p=none; rua=mailto:a@dmarc.example", "v=DMARC1; p=none; rua=mailto:a@dmarc.example"},
+		"quoted":          {`"v=DMARC1; p=none"`, `"v=DMARC1; p=none; rua=mailto:a@dmarc.example"`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, addDMARCReportingAddress(tc.content, "a@dmarc.example"))
+		})
+	}
+}
+
+func TestEnableDMARCReports(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "TXT", r.URL.Query().Get("type"))
+		assert.Equal(t, "_dmarc.example.com", r.URL.Query().Get("name"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "TXT", "name": "_dmarc.example.com", "content": "v=DMARC1; p=quarantine", "ttl": 1, "tags": ["owner:mail"]}
+			],
+			"result_info": {"page": 1, "per_page": 100, "count": 1, "total_count": 1}
+		}`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var v map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &v))
+		assert.Equal(t, "v=DMARC1; p=quarantine; rua=mailto:a@dmarc.example", v["content"])
+		assert.Equal(t, []interface{}{"owner:mail"}, v["tags"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "TXT", "name": "_dmarc.example.com", "content": "v=DMARC1; p=quarantine; rua=mailto:a@dmarc.example", "ttl": 1, "tags": ["owner:mail"]}
+		}`)
+	})
+
+	record, err := client.EnableDMARCReports(context.Background(), ZoneIdentifier(testZoneID), EnableDMARCReportsParams{
+		Domain:           "example.com",
+		ReportingAddress: "a@dmarc.example",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "v=DMARC1; p=quarantine; rua=mailto:a@dmarc.example", record.Content)
+	}
+
+	_, err = client.EnableDMARCReports(context.Background(), ZoneIdentifier(testZoneID), EnableDMARCReportsParams{Domain: "example.com"})
+	assert.Equal(t, ErrMissingDMARCReportingAddress, err)
+}
+
+func TestGetDMARCReportSources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var q GraphQLQuery
+		_ = json.Unmarshal(body, &q)
+		assert.Contains(t, q.Query, "dmarcReportsSourcesAdaptiveGroups")
+		assert.Equal(t, testZoneID, q.Variables["zoneTag"])
+		assert.Equal(t, map[string]interface{}{
+			"date_geq": "2024-10-01",
+			"date_leq": "2024-10-07",
+		}, q.Variables["filter"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{
+						"dmarcReportsSourcesAdaptiveGroups": [
+							{"sum": {"totalMatchingMessages": 120}, "dimensions": {"sourceOrgName": "Google", "disposition": "none", "dkim": "pass", "spf": "pass"}},
+							{"sum": {"totalMatchingMessages": 3}, "dimensions": {"sourceOrgName": "Unknown", "disposition": "reject", "dkim": "fail", "spf": "fail"}}
+						]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	})
+
+	want := []DMARCReportSource{
+		{SourceOrgName: "Google", Disposition: "none", DKIM: "pass", SPF: "pass", Messages: 120},
+		{SourceOrgName: "Unknown", Disposition: "reject", DKIM: "fail", SPF: "fail", Messages: 3},
+	}
+
+	actual, err := client.GetDMARCReportSources(context.Background(), ZoneIdentifier(testZoneID), DMARCReportsParams{Since: since, Until: until})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.GetDMARCReportSources(context.Background(), ZoneIdentifier(testZoneID), DMARCReportsParams{})
+	assert.Equal(t, ErrMissingDMARCReportsTimeRange, err)
+}
@@ -154,3 +154,27 @@ func (api *API) DeleteEmailRoutingDestinationAddress(ctx context.Context, rc *Re
 
 	return r.Result, nil
 }
+
+// WaitForEmailRoutingDestinationAddressVerification polls a destination
+// address every interval until its owner has followed the link in the
+// verification email, and returns the verified address. As that depends on
+// a person, ctx should carry a deadline.
+func (api *API) WaitForEmailRoutingDestinationAddressVerification(ctx context.Context, rc *ResourceContainer, addressID string, interval time.Duration) (EmailRoutingDestinationAddress, error) {
+	var address EmailRoutingDestinationAddress
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		var err error
+		address, err = api.GetEmailRoutingDestinationAddress(ctx, rc, id)
+		if err != nil {
+			return Operation{}, err
+		}
+
+		op := Operation{ID: id, State: OperationStatePending}
+		if address.Verified != nil {
+			op.State = OperationStateSucceeded
+		}
+		return op, nil
+	}
+
+	_, err := api.WaitForOperation(ctx, addressID, fetch, WaitForOperationParams{Interval: interval})
+	return address, err
+}
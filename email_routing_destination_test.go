@@ -136,6 +136,40 @@ func TestEmailRouting_GetDestinationAddress(t *testing.T) {
 	}
 }
 
+func TestEmailRouting_WaitForDestinationAddressVerification(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/accounts/"+testAccountID+"/email/routing/addresses/"+testEmailID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+
+		verified := "null"
+		if polls++; polls > 1 {
+			verified = `"2014-01-02T02:20:00Z"`
+		}
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "tag": "ea95132c15732412d22c1476fa83f27a",
+    "email": "user@example.com",
+    "verified": %s,
+    "created": "2014-01-02T02:20:00Z",
+    "modified": "2014-01-02T02:20:00Z"
+  }
+}`, verified)
+	})
+
+	res, err := client.WaitForEmailRoutingDestinationAddressVerification(context.Background(), AccountIdentifier(testAccountID), testEmailID, time.Millisecond)
+	if assert.NoError(t, err) {
+		assert.Equal(t, createTestDestinationAddress(), res)
+		assert.Equal(t, 2, polls)
+	}
+}
+
 func TestEmailRouting_DeleteDestinationAddress(t *testing.T) {
 	setup()
 	defer teardown()
@@ -11,6 +11,24 @@ import (
 
 var ErrMissingRuleID = errors.New("required rule id missing")
 
+// Email Routing rule matcher types and fields. A literal matcher compares
+// Field to Value; an all matcher, used by the catch-all rule, matches every
+// message.
+const (
+	EmailRoutingRuleMatcherTypeLiteral = "literal"
+	EmailRoutingRuleMatcherTypeAll     = "all"
+	EmailRoutingRuleMatcherFieldTo     = "to"
+)
+
+// Email Routing rule action types. Forward sends the message to the
+// destination addresses in Value, worker hands it to the Worker named in
+// Value and drop discards it.
+const (
+	EmailRoutingRuleActionTypeForward = "forward"
+	EmailRoutingRuleActionTypeWorker  = "worker"
+	EmailRoutingRuleActionTypeDrop    = "drop"
+)
+
 type EmailRoutingRuleMatcher struct {
 	Type  string `json:"type,omitempty"`
 	Field string `json:"field,omitempty"`
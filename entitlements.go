@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// Allocation types of entitlements.
+const (
+	EntitlementAllocationMaxCount = "max_count"
+	EntitlementAllocationBoolean  = "boolean"
+)
+
+// IDs of commonly checked entitlements.
+const (
+	EntitlementPageRules          = "page_rules"
+	EntitlementCustomCertificates = "custom_ssl"
+	EntitlementFirewallRules      = "firewall_rules"
+)
+
+// Entitlement is a product limit or feature flag of a zone or account.
+type Entitlement struct {
+	ID         string                `json:"id"`
+	Allocation EntitlementAllocation `json:"allocation"`
+}
+
+// EntitlementAllocation is the amount of an entitlement granted. Value is a
+// number for max_count allocations and a bool for boolean allocations.
+type EntitlementAllocation struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Entitlements is the set of entitlements of a zone or account.
+type Entitlements []Entitlement
+
+// EntitlementsResponse is the API response containing entitlements.
+type EntitlementsResponse struct {
+	Response
+	Result Entitlements `json:"result"`
+}
+
+// Limit returns the maximum count granted by the max_count entitlement id,
+// and false if there is no such entitlement.
+func (e Entitlements) Limit(id string) (int, bool) {
+	for _, ent := range e {
+		if ent.ID != id || ent.Allocation.Type != EntitlementAllocationMaxCount {
+			continue
+		}
+		if n, ok := ent.Allocation.Value.(float64); ok {
+			return int(n), true
+		}
+	}
+
+	return 0, false
+}
+
+// Enabled reports whether the boolean entitlement id is granted.
+func (e Entitlements) Enabled(id string) bool {
+	for _, ent := range e {
+		if ent.ID == id && ent.Allocation.Type == EntitlementAllocationBoolean {
+			enabled, _ := ent.Allocation.Value.(bool)
+			return enabled
+		}
+	}
+
+	return false
+}
+
+// HasCapacity reports whether n more items can be created given the used
+// count of the max_count entitlement id. It returns false if the entitlement
+// is not granted at all.
+func (e Entitlements) HasCapacity(id string, used, n int) bool {
+	limit, ok := e.Limit(id)
+	return ok && used+n <= limit
+}
+
+// GetEntitlements returns the entitlements, such as the number of page rules
+// or custom certificates allowed, of a zone or account.
+func (api *API) GetEntitlements(ctx context.Context, rc *ResourceContainer) (Entitlements, error) {
+	if rc.Level != ZoneRouteLevel && rc.Level != AccountRouteLevel {
+		return Entitlements{}, fmt.Errorf(errInvalidResourceContainerAccess, rc.Level)
+	}
+
+	if rc.Identifier == "" {
+		return Entitlements{}, ErrMissingIdentifier
+	}
+
+	uri := fmt.Sprintf("/%s/%s/entitlements", rc.Level, rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Entitlements{}, err
+	}
+
+	var r EntitlementsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Entitlements{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
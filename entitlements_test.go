@@ -0,0 +1,55 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEntitlements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/entitlements", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "page_rules", "allocation": {"type": "max_count", "value": 20}},
+				{"id": "custom_ssl", "allocation": {"type": "max_count", "value": 0}},
+				{"id": "waf", "allocation": {"type": "boolean", "value": true}}
+			]
+		}`)
+	})
+
+	_, err := client.GetEntitlements(context.Background(), UserIdentifier("foo"))
+	assert.EqualError(t, err, fmt.Sprintf(errInvalidResourceContainerAccess, UserRouteLevel))
+
+	_, err = client.GetEntitlements(context.Background(), ZoneIdentifier(""))
+	assert.Equal(t, ErrMissingIdentifier, err)
+
+	entitlements, err := client.GetEntitlements(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Len(t, entitlements, 3)
+
+		limit, ok := entitlements.Limit(EntitlementPageRules)
+		assert.True(t, ok)
+		assert.Equal(t, 20, limit)
+
+		_, ok = entitlements.Limit(EntitlementFirewallRules)
+		assert.False(t, ok)
+
+		assert.True(t, entitlements.HasCapacity(EntitlementPageRules, 18, 2))
+		assert.False(t, entitlements.HasCapacity(EntitlementPageRules, 18, 3))
+		assert.False(t, entitlements.HasCapacity(EntitlementCustomCertificates, 0, 1))
+
+		assert.True(t, entitlements.Enabled("waf"))
+		assert.False(t, entitlements.Enabled(EntitlementPageRules))
+	}
+}
@@ -0,0 +1,273 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrMissingFirewallEventsTimeRange = errors.New("required firewall events time range (since and until) is missing")
+
+// FirewallEventsDimension is a field firewall events can be grouped by.
+type FirewallEventsDimension string
+
+// Dimensions of firewall event groups.
+const (
+	FirewallEventsDimensionAction    FirewallEventsDimension = "action"
+	FirewallEventsDimensionSource    FirewallEventsDimension = "source"
+	FirewallEventsDimensionRuleID    FirewallEventsDimension = "ruleId"
+	FirewallEventsDimensionRulesetID FirewallEventsDimension = "rulesetId"
+	FirewallEventsDimensionClientIP  FirewallEventsDimension = "clientIP"
+	FirewallEventsDimensionCountry   FirewallEventsDimension = "clientCountryName"
+	FirewallEventsDimensionASN       FirewallEventsDimension = "clientAsn"
+	FirewallEventsDimensionHost      FirewallEventsDimension = "clientRequestHTTPHost"
+	FirewallEventsDimensionPath      FirewallEventsDimension = "clientRequestPath"
+)
+
+const firewallEventsQuery = `query FirewallEvents($zoneTag: string, $filter: FirewallEventsAdaptiveFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      firewallEventsAdaptive(filter: $filter, limit: $limit, orderBy: [datetime_DESC]) {
+        datetime
+        rayName
+        action
+        source
+        ruleId
+        rulesetId
+        description
+        clientIP
+        clientAsn
+        clientCountryName
+        clientRequestHTTPHost
+        clientRequestHTTPMethodName
+        clientRequestPath
+        clientRequestQuery
+        userAgent
+        edgeResponseStatus
+      }
+    }
+  }
+}`
+
+// firewallEventGroupsQuery counts events grouped by the dimensions
+// substituted into it.
+const firewallEventGroupsQuery = `query FirewallEventGroups($zoneTag: string, $filter: FirewallEventsAdaptiveGroupsFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      firewallEventsAdaptiveGroups(filter: $filter, limit: $limit, orderBy: [count_DESC]) {
+        count
+        dimensions {
+          %s
+        }
+      }
+    }
+  }
+}`
+
+// FirewallEventsParams filters the firewall events of a zone. Since and
+// Until are required; the other filters match any of their values.
+type FirewallEventsParams struct {
+	Since time.Time
+	Until time.Time
+
+	// Actions are the actions taken, such as "block" or "managed_challenge".
+	Actions []string
+
+	// Sources are the products that acted, such as "firewallManaged",
+	// "firewallCustom" or "ratelimit".
+	Sources []string
+
+	RuleIDs   []string
+	ClientIPs []string
+	Hosts     []string
+
+	// Limit is the maximum number of events or groups returned. It defaults
+	// to 1,000.
+	Limit int
+}
+
+// FirewallEventGroupsParams groups the firewall events of a zone.
+type FirewallEventGroupsParams struct {
+	FirewallEventsParams
+
+	// GroupBy are the dimensions events are grouped by. It defaults to
+	// FirewallEventsDimensionAction.
+	GroupBy []FirewallEventsDimension
+}
+
+// FirewallEvent is a request acted on by a security product.
+type FirewallEvent struct {
+	Datetime           time.Time `json:"datetime"`
+	RayID              string    `json:"rayName"`
+	Action             string    `json:"action"`
+	Source             string    `json:"source"`
+	RuleID             string    `json:"ruleId"`
+	RulesetID          string    `json:"rulesetId"`
+	Description        string    `json:"description"`
+	ClientIP           string    `json:"clientIP"`
+	ClientASN          string    `json:"clientAsn"`
+	ClientCountryName  string    `json:"clientCountryName"`
+	Host               string    `json:"clientRequestHTTPHost"`
+	Method             string    `json:"clientRequestHTTPMethodName"`
+	Path               string    `json:"clientRequestPath"`
+	Query              string    `json:"clientRequestQuery"`
+	UserAgent          string    `json:"userAgent"`
+	EdgeResponseStatus int       `json:"edgeResponseStatus"`
+}
+
+// FirewallEventGroup is the number of firewall events sharing the same
+// dimensions. Only the dimensions grouped by are set.
+type FirewallEventGroup struct {
+	Count             int64  `json:"count"`
+	Action            string `json:"action"`
+	Source            string `json:"source"`
+	RuleID            string `json:"ruleId"`
+	RulesetID         string `json:"rulesetId"`
+	ClientIP          string `json:"clientIP"`
+	ClientCountryName string `json:"clientCountryName"`
+	ClientASN         string `json:"clientAsn"`
+	Host              string `json:"clientRequestHTTPHost"`
+	Path              string `json:"clientRequestPath"`
+}
+
+type firewallEventsResponse struct {
+	Viewer struct {
+		Zones []struct {
+			Events []FirewallEvent `json:"firewallEventsAdaptive"`
+		} `json:"zones"`
+	} `json:"viewer"`
+}
+
+type firewallEventGroupsResponse struct {
+	Viewer struct {
+		Zones []struct {
+			Groups []struct {
+				Count      int64              `json:"count"`
+				Dimensions FirewallEventGroup `json:"dimensions"`
+			} `json:"firewallEventsAdaptiveGroups"`
+		} `json:"zones"`
+	} `json:"viewer"`
+}
+
+func (p FirewallEventsParams) variables(zoneID string) (map[string]interface{}, error) {
+	if p.Since.IsZero() || p.Until.IsZero() {
+		return nil, ErrMissingFirewallEventsTimeRange
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq": p.Since.UTC().Format(time.RFC3339),
+		"datetime_lt":  p.Until.UTC().Format(time.RFC3339),
+	}
+	for key, values := range map[string][]string{
+		"action_in":                p.Actions,
+		"source_in":                p.Sources,
+		"ruleId_in":                p.RuleIDs,
+		"clientIP_in":              p.ClientIPs,
+		"clientRequestHTTPHost_in": p.Hosts,
+	} {
+		if len(values) > 0 {
+			filter[key] = values
+		}
+	}
+
+	limit := p.Limit
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	return map[string]interface{}{
+		"zoneTag": zoneID,
+		"filter":  filter,
+		"limit":   limit,
+	}, nil
+}
+
+// ListFirewallEvents returns the requests of a zone acted on by WAF, rate
+// limiting and other security products, most recent first.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-firewall-events/
+func (api *API) ListFirewallEvents(ctx context.Context, rc *ResourceContainer, params FirewallEventsParams) ([]FirewallEvent, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []FirewallEvent{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []FirewallEvent{}, ErrMissingZoneID
+	}
+
+	variables, err := params.variables(rc.Identifier)
+	if err != nil {
+		return []FirewallEvent{}, err
+	}
+
+	var r firewallEventsResponse
+	err = api.GraphQL(ctx, GraphQLQuery{Query: firewallEventsQuery, Variables: variables}, &r)
+	if err != nil {
+		return []FirewallEvent{}, err
+	}
+
+	if len(r.Viewer.Zones) == 0 {
+		return []FirewallEvent{}, nil
+	}
+
+	return r.Viewer.Zones[0].Events, nil
+}
+
+// GetFirewallEventGroups counts the firewall events of a zone grouped by
+// dimensions such as the rule and client IP, largest groups first.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-firewall-events/
+func (api *API) GetFirewallEventGroups(ctx context.Context, rc *ResourceContainer, params FirewallEventGroupsParams) ([]FirewallEventGroup, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []FirewallEventGroup{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []FirewallEventGroup{}, ErrMissingZoneID
+	}
+
+	variables, err := params.variables(rc.Identifier)
+	if err != nil {
+		return []FirewallEventGroup{}, err
+	}
+
+	groupBy := params.GroupBy
+	if len(groupBy) == 0 {
+		groupBy = []FirewallEventsDimension{FirewallEventsDimensionAction}
+	}
+	dimensions := make([]string, 0, len(groupBy))
+	for _, d := range groupBy {
+		switch d {
+		case FirewallEventsDimensionAction, FirewallEventsDimensionSource, FirewallEventsDimensionRuleID,
+			FirewallEventsDimensionRulesetID, FirewallEventsDimensionClientIP, FirewallEventsDimensionCountry,
+			FirewallEventsDimensionASN, FirewallEventsDimensionHost, FirewallEventsDimensionPath:
+			dimensions = append(dimensions, string(d))
+		default:
+			return []FirewallEventGroup{}, fmt.Errorf("invalid firewall events dimension %q", d)
+		}
+	}
+
+	var r firewallEventGroupsResponse
+	err = api.GraphQL(ctx, GraphQLQuery{
+		Query:     fmt.Sprintf(firewallEventGroupsQuery, strings.Join(dimensions, "\n          ")),
+		Variables: variables,
+	}, &r)
+	if err != nil {
+		return []FirewallEventGroup{}, err
+	}
+
+	if len(r.Viewer.Zones) == 0 {
+		return []FirewallEventGroup{}, nil
+	}
+
+	groups := make([]FirewallEventGroup, 0, len(r.Viewer.Zones[0].Groups))
+	for _, g := range r.Viewer.Zones[0].Groups {
+		group := g.Dimensions
+		group.Count = g.Count
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
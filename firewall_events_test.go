@@ -0,0 +1,119 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFirewallEventGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var q GraphQLQuery
+		_ = json.Unmarshal(body, &q)
+		assert.Contains(t, q.Query, "firewallEventsAdaptiveGroups")
+		assert.Contains(t, q.Query, "ruleId\n          clientIP")
+		assert.Equal(t, testZoneID, q.Variables["zoneTag"])
+		assert.Equal(t, map[string]interface{}{
+			"datetime_geq": "2024-10-01T00:00:00Z",
+			"datetime_lt":  "2024-10-02T00:00:00Z",
+			"action_in":    []interface{}{"block"},
+		}, q.Variables["filter"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{
+						"firewallEventsAdaptiveGroups": [
+							{"count": 42, "dimensions": {"ruleId": "6179ae15870a4bb7b2d480d4843b323c", "clientIP": "192.0.2.1"}},
+							{"count": 7, "dimensions": {"ruleId": "6179ae15870a4bb7b2d480d4843b323c", "clientIP": "192.0.2.2"}}
+						]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	})
+
+	actual, err := client.GetFirewallEventGroups(context.Background(), ZoneIdentifier(testZoneID), FirewallEventGroupsParams{
+		FirewallEventsParams: FirewallEventsParams{
+			Since:   since,
+			Until:   until,
+			Actions: []string{"block"},
+		},
+		GroupBy: []FirewallEventsDimension{FirewallEventsDimensionRuleID, FirewallEventsDimensionClientIP},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []FirewallEventGroup{
+			{Count: 42, RuleID: "6179ae15870a4bb7b2d480d4843b323c", ClientIP: "192.0.2.1"},
+			{Count: 7, RuleID: "6179ae15870a4bb7b2d480d4843b323c", ClientIP: "192.0.2.2"},
+		}, actual)
+	}
+
+	_, err = client.GetFirewallEventGroups(context.Background(), ZoneIdentifier(testZoneID), FirewallEventGroupsParams{})
+	assert.Equal(t, ErrMissingFirewallEventsTimeRange, err)
+
+	_, err = client.GetFirewallEventGroups(context.Background(), ZoneIdentifier(testZoneID), FirewallEventGroupsParams{
+		FirewallEventsParams: FirewallEventsParams{Since: since, Until: until},
+		GroupBy:              []FirewallEventsDimension{"datetime { }"},
+	})
+	assert.Error(t, err)
+}
+
+func TestListFirewallEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{
+						"firewallEventsAdaptive": [{
+							"datetime": "2024-10-01T01:02:03Z",
+							"rayName": "8cbd2f1a4f1e5a2b",
+							"action": "block",
+							"source": "firewallManaged",
+							"ruleId": "6179ae15870a4bb7b2d480d4843b323c",
+							"clientIP": "192.0.2.1",
+							"clientRequestPath": "/wp-login.php",
+							"edgeResponseStatus": 403
+						}]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	})
+
+	actual, err := client.ListFirewallEvents(context.Background(), ZoneIdentifier(testZoneID), FirewallEventsParams{
+		Since: since,
+		Until: since.Add(time.Hour),
+	})
+	if assert.NoError(t, err) && assert.Len(t, actual, 1) {
+		assert.Equal(t, time.Date(2024, 10, 1, 1, 2, 3, 0, time.UTC), actual[0].Datetime)
+		assert.Equal(t, "firewallManaged", actual[0].Source)
+		assert.Equal(t, "/wp-login.php", actual[0].Path)
+		assert.Equal(t, 403, actual[0].EdgeResponseStatus)
+	}
+}
@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingGraphQLQuery = errors.New("required GraphQL query is missing")
+
+// GraphQLQuery is a query, and its variables, sent to the GraphQL Analytics
+// API.
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError is a single error returned by the GraphQL Analytics API.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors is returned when the GraphQL Analytics API responds
+// successfully at the HTTP level but reports errors for the query itself.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Message)
+	}
+
+	return "graphql: " + strings.Join(msgs, ", ")
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQL executes a query against the GraphQL Analytics API and unmarshals
+// the `data` member of the response into result.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GraphQL(ctx context.Context, query GraphQLQuery, result interface{}) error {
+	if query.Query == "" {
+		return ErrMissingGraphQLQuery
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, "/graphql", query)
+	if err != nil {
+		return err
+	}
+
+	var r graphQLResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if len(r.Errors) > 0 {
+		return r.Errors
+	}
+
+	if result == nil || len(r.Data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Data, result); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
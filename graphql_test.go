@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var q GraphQLQuery
+		_ = json.Unmarshal(body, &q)
+		assert.Equal(t, "query { viewer { zones { zoneTag } } }", q.Query)
+		assert.Equal(t, "bar", q.Variables["foo"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{ "zoneTag": "d56084adb405e0b7e32c52321bf07be6" }]
+				}
+			},
+			"errors": null
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	var result struct {
+		Viewer struct {
+			Zones []struct {
+				ZoneTag string `json:"zoneTag"`
+			} `json:"zones"`
+		} `json:"viewer"`
+	}
+	err := client.GraphQL(context.Background(), GraphQLQuery{
+		Query:     "query { viewer { zones { zoneTag } } }",
+		Variables: map[string]interface{}{"foo": "bar"},
+	}, &result)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testZoneID, result.Viewer.Zones[0].ZoneTag)
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": null,
+			"errors": [
+				{ "message": "unknown field \"foo\"", "path": ["viewer", "zones", 0, "foo"] }
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	err := client.GraphQL(context.Background(), GraphQLQuery{Query: "query { viewer { zones { foo } } }"}, nil)
+	var gqlErr GraphQLErrors
+	if assert.ErrorAs(t, err, &gqlErr) {
+		assert.Len(t, gqlErr, 1)
+		assert.Equal(t, `graphql: unknown field "foo"`, err.Error())
+	}
+
+	err = client.GraphQL(context.Background(), GraphQLQuery{}, nil)
+	assert.ErrorIs(t, err, ErrMissingGraphQLQuery)
+}
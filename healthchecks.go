@@ -9,6 +9,21 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Healthcheck types.
+const (
+	HealthcheckTypeHTTP  = "HTTP"
+	HealthcheckTypeHTTPS = "HTTPS"
+	HealthcheckTypeTCP   = "TCP"
+)
+
+// Healthcheck statuses reported in Healthcheck.Status.
+const (
+	HealthcheckStatusUnknown   = "unknown"
+	HealthcheckStatusHealthy   = "healthy"
+	HealthcheckStatusUnhealthy = "unhealthy"
+	HealthcheckStatusSuspended = "suspended"
+)
+
 // Healthcheck describes a Healthcheck object.
 type Healthcheck struct {
 	ID                   string                 `json:"id,omitempty"`
@@ -62,21 +77,38 @@ type HealthcheckResponse struct {
 	Result Healthcheck `json:"result"`
 }
 
-// Healthchecks returns all healthchecks for a zone.
+// Healthchecks returns all healthchecks for a zone, fetching every page.
 //
 // API reference: https://api.cloudflare.com/#health-checks-list-health-checks
 func (api *API) Healthchecks(ctx context.Context, zoneID string) ([]Healthcheck, error) {
-	uri := fmt.Sprintf("/zones/%s/healthchecks", zoneID)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return []Healthcheck{}, err
+	params := PaginationOptions{Page: 1, PerPage: 50}
+
+	var healthchecks []Healthcheck
+	for {
+		uri := buildURI(fmt.Sprintf("/zones/%s/healthchecks", zoneID), params)
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []Healthcheck{}, err
+		}
+		var r HealthcheckListResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []Healthcheck{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		healthchecks = append(healthchecks, r.Result...)
+
+		next := r.ResultInfo.Next()
+		if len(r.Result) == 0 || next.Done() {
+			break
+		}
+		params.Page = next.Page
 	}
-	var r HealthcheckListResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return []Healthcheck{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+
+	if healthchecks == nil {
+		return []Healthcheck{}, nil
 	}
-	return r.Result, nil
+
+	return healthchecks, nil
 }
 
 // Healthcheck returns a single healthcheck by ID.
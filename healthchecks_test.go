@@ -122,6 +122,42 @@ func TestHealthchecks(t *testing.T) {
 	}
 }
 
+func TestHealthchecks_Paginated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [
+			%s
+			],
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result_info": {
+				"page": %s,
+				"per_page": 1,
+				"count": 1,
+				"total_count": 2,
+				"total_pages": 2
+			}
+		}
+		`, fmt.Sprintf(healthcheckResponse, "healthcheck-"+page), page)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/healthchecks", handler)
+
+	actual, err := client.Healthchecks(context.Background(), testZoneID)
+	if assert.NoError(t, err) && assert.Len(t, actual, 2) {
+		assert.Equal(t, "healthcheck-1", actual[0].ID)
+		assert.Equal(t, "healthcheck-2", actual[1].ID)
+		assert.Equal(t, HealthcheckStatusUnknown, actual[0].Status)
+	}
+}
+
 func TestHealthcheck(t *testing.T) {
 	setup()
 	defer teardown()
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/goccy/go-json"
 )
@@ -24,10 +25,38 @@ type HyperdriveConfig struct {
 	Name    string                  `json:"name,omitempty"`
 	Origin  HyperdriveConfigOrigin  `json:"origin,omitempty"`
 	Caching HyperdriveConfigCaching `json:"caching,omitempty"`
+
+	// OriginConnectionLimit is the soft maximum number of connections
+	// opened to the origin database.
+	OriginConnectionLimit int                   `json:"origin_connection_limit,omitempty"`
+	MTLS                  *HyperdriveConfigMTLS `json:"mtls,omitempty"`
+	CreatedOn             *time.Time            `json:"created_on,omitempty"`
+	ModifiedOn            *time.Time            `json:"modified_on,omitempty"`
 }
 
 type HyperdriveOriginType string
 
+// Schemes of Hyperdrive origin databases.
+const (
+	HyperdriveOriginSchemePostgres   = "postgres"
+	HyperdriveOriginSchemePostgreSQL = "postgresql"
+	HyperdriveOriginSchemeMySQL      = "mysql"
+)
+
+// HyperdriveConfigMTLS configures TLS between Hyperdrive and the origin
+// database.
+type HyperdriveConfigMTLS struct {
+	// CACertificateID verifies the origin certificate with a CA uploaded
+	// as an mTLS certificate.
+	CACertificateID string `json:"ca_certificate_id,omitempty"`
+
+	// MTLSCertificateID is the client certificate presented to the origin.
+	MTLSCertificateID string `json:"mtls_certificate_id,omitempty"`
+
+	// SSLMode is "require", "verify-ca" or "verify-full".
+	SSLMode string `json:"sslmode,omitempty"`
+}
+
 type HyperdriveConfigOrigin struct {
 	Database       string `json:"database,omitempty"`
 	Host           string `json:"host,omitempty"`
@@ -43,6 +72,48 @@ type HyperdriveConfigOriginWithSecrets struct {
 	AccessClientSecret string `json:"access_client_secret,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the database password and Access client secret.
+func (h HyperdriveConfigOriginWithSecrets) String() string {
+	return redactedString(h.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the database password and Access client secret.
+func (h HyperdriveConfigOriginWithSecrets) GoString() string {
+	return redactedGoString("HyperdriveConfigOriginWithSecrets", h.redacted())
+}
+
+func (h HyperdriveConfigOriginWithSecrets) redacted() interface{} {
+	type redacted HyperdriveConfigOriginWithSecrets
+	r := redacted(h)
+	r.Password = redact(r.Password)
+	r.AccessClientSecret = redact(r.AccessClientSecret)
+	return r
+}
+
+func (h HyperdriveConfigOriginWithSecrets) validate() error {
+	if h.Database == "" {
+		return ErrMissingHyperdriveConfigOriginDatabase
+	}
+
+	if h.Password == "" {
+		return ErrMissingHyperdriveConfigOriginPassword
+	}
+
+	if h.Host == "" {
+		return ErrMissingHyperdriveConfigOriginHost
+	}
+
+	if h.Scheme == "" {
+		return ErrMissingHyperdriveConfigOriginScheme
+	}
+
+	if h.User == "" {
+		return ErrMissingHyperdriveConfigOriginUser
+	}
+
+	return nil
+}
+
 type HyperdriveConfigCaching struct {
 	Disabled             *bool `json:"disabled,omitempty"`
 	MaxAge               int   `json:"max_age,omitempty"`
@@ -55,9 +126,11 @@ type HyperdriveConfigListResponse struct {
 }
 
 type CreateHyperdriveConfigParams struct {
-	Name    string                            `json:"name"`
-	Origin  HyperdriveConfigOriginWithSecrets `json:"origin"`
-	Caching HyperdriveConfigCaching           `json:"caching,omitempty"`
+	Name                  string                            `json:"name"`
+	Origin                HyperdriveConfigOriginWithSecrets `json:"origin"`
+	Caching               HyperdriveConfigCaching           `json:"caching,omitempty"`
+	OriginConnectionLimit int                               `json:"origin_connection_limit,omitempty"`
+	MTLS                  *HyperdriveConfigMTLS             `json:"mtls,omitempty"`
 }
 
 type HyperdriveConfigResponse struct {
@@ -66,10 +139,24 @@ type HyperdriveConfigResponse struct {
 }
 
 type UpdateHyperdriveConfigParams struct {
-	HyperdriveID string                            `json:"-"`
-	Name         string                            `json:"name"`
-	Origin       HyperdriveConfigOriginWithSecrets `json:"origin"`
-	Caching      HyperdriveConfigCaching           `json:"caching,omitempty"`
+	HyperdriveID          string                            `json:"-"`
+	Name                  string                            `json:"name"`
+	Origin                HyperdriveConfigOriginWithSecrets `json:"origin"`
+	Caching               HyperdriveConfigCaching           `json:"caching,omitempty"`
+	OriginConnectionLimit int                               `json:"origin_connection_limit,omitempty"`
+	MTLS                  *HyperdriveConfigMTLS             `json:"mtls,omitempty"`
+}
+
+// EditHyperdriveConfigParams changes some settings of a Hyperdrive config,
+// leaving nil fields unchanged. Unlike UpdateHyperdriveConfigParams the
+// origin, and so its password, is only needed when it changes.
+type EditHyperdriveConfigParams struct {
+	HyperdriveID          string                             `json:"-"`
+	Name                  string                             `json:"name,omitempty"`
+	Origin                *HyperdriveConfigOriginWithSecrets `json:"origin,omitempty"`
+	Caching               *HyperdriveConfigCaching           `json:"caching,omitempty"`
+	OriginConnectionLimit *int                               `json:"origin_connection_limit,omitempty"`
+	MTLS                  *HyperdriveConfigMTLS              `json:"mtls,omitempty"`
 }
 
 type ListHyperdriveConfigParams struct{}
@@ -110,6 +197,10 @@ func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 		return HyperdriveConfig{}, ErrMissingHyperdriveConfigName
 	}
 
+	if err := params.Origin.validate(); err != nil {
+		return HyperdriveConfig{}, err
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", rc.Identifier)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
@@ -185,29 +276,48 @@ func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
 	}
 
-	if params.Origin.Database == "" {
-		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOriginDatabase
+	if err := params.Origin.validate(); err != nil {
+		return HyperdriveConfig{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, params.HyperdriveID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return HyperdriveConfig{}, err
 	}
 
-	if params.Origin.Password == "" {
-		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOriginPassword
+	var r HyperdriveConfigResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 
-	if params.Origin.Host == "" {
-		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOriginHost
+	return r.Result, nil
+}
+
+// EditHyperdriveConfig changes some settings of a Hyperdrive config, such as
+// its caching, without resending the origin credentials.
+//
+// API reference: https://developers.cloudflare.com/api/operations/patch-hyperdrive
+func (api *API) EditHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params EditHyperdriveConfigParams) (HyperdriveConfig, error) {
+	if rc.Identifier == "" {
+		return HyperdriveConfig{}, ErrMissingAccountID
 	}
 
-	if params.Origin.Scheme == "" {
-		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOriginScheme
+	if params.HyperdriveID == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
 	}
 
-	if params.Origin.User == "" {
-		return HyperdriveConfig{}, ErrMissingHyperdriveConfigOriginUser
+	if params.Origin != nil {
+		if err := params.Origin.validate(); err != nil {
+			return HyperdriveConfig{}, err
+		}
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, params.HyperdriveID)
 
-	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
 	if err != nil {
 		return HyperdriveConfig{}, err
 	}
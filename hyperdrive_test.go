@@ -3,10 +3,12 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -370,3 +372,52 @@ func TestHyperdriveConfig_Update(t *testing.T) {
 		assert.Equal(t, testHyperdriveHostAndPortConfig(), result)
 	}
 }
+
+func TestHyperdriveConfig_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", testAccountID, testHyperdriveConfigId), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"caching": {"disabled": true}, "origin_connection_limit": 20}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "6b7efc370ea34ded8327fa20698dfe3a",
+				"name": "example-hyperdrive",
+				"origin": {
+					"database": "postgres",
+					"host": "database.example.com",
+					"port": 5432,
+					"scheme": "postgres",
+					"user": "postgres"
+				},
+				"caching": {
+					"disabled": true
+				},
+				"origin_connection_limit": 20
+			}
+		}`)
+	})
+
+	_, err := client.EditHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), EditHyperdriveConfigParams{})
+	assert.Equal(t, ErrMissingHyperdriveConfigID, err)
+
+	result, err := client.EditHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), EditHyperdriveConfigParams{
+		HyperdriveID:          testHyperdriveConfigId,
+		Caching:               &HyperdriveConfigCaching{Disabled: BoolPtr(true)},
+		OriginConnectionLimit: IntPtr(20),
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, BoolPtr(true), result.Caching.Disabled)
+		assert.Equal(t, 20, result.OriginConnectionLimit)
+	}
+}
@@ -37,6 +37,9 @@ type Image struct {
 
 // UploadImageParams is the data required for an Image Upload request.
 type UploadImageParams struct {
+	// ID is a custom ID for the image, such as its path. A random ID is
+	// assigned if empty.
+	ID                string
 	File              io.ReadCloser
 	URL               string
 	Name              string
@@ -65,6 +68,13 @@ func (b UploadImageParams) write(mpw *multipart.Writer) error {
 		_ = b.File.Close()
 	}
 
+	if b.ID != "" {
+		err := mpw.WriteField("id", b.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if b.URL != "" {
 		err := mpw.WriteField("url", b.URL)
 		if err != nil {
@@ -105,7 +115,10 @@ type UpdateImageParams struct {
 
 // CreateImageDirectUploadURLParams is the data required for a CreateImageDirectUploadURL request.
 type CreateImageDirectUploadURLParams struct {
-	Version           ImagesAPIVersion       `json:"-"`
+	Version ImagesAPIVersion `json:"-"`
+	// ID is a custom ID for the image, only supported by
+	// ImagesAPIVersionV2.
+	ID                string                 `json:"-"`
 	Expiry            *time.Time             `json:"expiry,omitempty"`
 	Metadata          map[string]interface{} `json:"metadata,omitempty"`
 	RequireSignedURLs *bool                  `json:"requireSignedURLs,omitempty"`
@@ -248,12 +261,17 @@ func (api *API) CreateImageDirectUploadURL(ctx context.Context, rc *ResourceCont
 			return ImageDirectUploadURL{}, fmt.Errorf("error setting multipart boundary")
 		}
 
-		if *params.RequireSignedURLs {
+		if params.ID != "" {
+			if err = writer.WriteField("id", params.ID); err != nil {
+				return ImageDirectUploadURL{}, fmt.Errorf("error writing id field: %w", err)
+			}
+		}
+		if params.RequireSignedURLs != nil && *params.RequireSignedURLs {
 			if err = writer.WriteField("requireSignedURLs", "true"); err != nil {
 				return ImageDirectUploadURL{}, fmt.Errorf("error writing requireSignedURLs field: %w", err)
 			}
 		}
-		if !params.Expiry.IsZero() {
+		if params.Expiry != nil && !params.Expiry.IsZero() {
 			if err = writer.WriteField("expiry", params.Expiry.Format(time.RFC3339)); err != nil {
 				return ImageDirectUploadURL{}, fmt.Errorf("error writing expiry field: %w", err)
 			}
@@ -281,8 +299,10 @@ func (api *API) CreateImageDirectUploadURL(ctx context.Context, rc *ResourceCont
 				"Content-Type": []string{writer.FormDataContentType()},
 			},
 		)
-	case ImagesAPIVersionV1:
-	case "":
+	case ImagesAPIVersionV1, "":
+		if params.ID != "" {
+			return ImageDirectUploadURL{}, errors.New("custom image IDs require the v2 direct upload API")
+		}
 		uri = fmt.Sprintf("/%s/%s/images/%s/direct_upload", rc.Level, rc.Identifier, ImagesAPIVersionV1)
 		res, err = api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	default:
@@ -321,6 +321,45 @@ func TestCreateImageDirectUploadURLV2(t *testing.T) {
 	}
 }
 
+func TestCreateImageDirectUploadURLV2CustomID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		require.NoError(t, r.ParseMultipartForm(32<<20))
+		assert.Equal(t, "products/shoe.png", r.Form.Get("id"))
+		assert.Empty(t, r.Form.Get("expiry"))
+		assert.Empty(t, r.Form.Get("requireSignedURLs"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "products/shoe.png",
+				"uploadURL": "https://upload.imagedelivery.net/fgr33htrthytjtyereifjewoi338272s7w1383"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v2/direct_upload", handler)
+
+	actual, err := client.CreateImageDirectUploadURL(context.Background(), AccountIdentifier(testAccountID), CreateImageDirectUploadURLParams{
+		Version: ImagesAPIVersionV2,
+		ID:      "products/shoe.png",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "products/shoe.png", actual.ID)
+	}
+
+	_, err = client.CreateImageDirectUploadURL(context.Background(), AccountIdentifier(testAccountID), CreateImageDirectUploadURLParams{
+		Version: ImagesAPIVersionV1,
+		ID:      "products/shoe.png",
+	})
+	assert.Error(t, err)
+}
+
 func TestListImages(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,278 @@
+package cloudflare
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"golang.org/x/net/websocket"
+)
+
+// instantLogsKind is the Logpush job kind of Instant Logs jobs.
+const instantLogsKind = "instant-logs"
+
+// InstantLogsJob is an Instant Logs session. Logs are streamed over the
+// WebSocket at DestinationConf until the session expires.
+type InstantLogsJob struct {
+	DestinationConf string `json:"destination_conf"`
+	SessionID       string `json:"session_id"`
+	Fields          string `json:"fields"`
+	Sample          int    `json:"sample"`
+	Filter          string `json:"filter"`
+}
+
+// CreateInstantLogsJobParams configures an Instant Logs session.
+type CreateInstantLogsJobParams struct {
+	// Fields is a comma separated list of the HTTP request log fields to
+	// include, for example "ClientIP,ClientRequestHost,EdgeResponseStatus".
+	Fields string `json:"fields"`
+
+	// Sample streams one in every Sample requests. Zero streams every
+	// request.
+	Sample int `json:"sample,omitempty"`
+
+	// Filter uses the same format as Logpush job filters.
+	Filter string `json:"filter,omitempty"`
+}
+
+// InstantLogsJobResponse is the API response, containing an Instant Logs job.
+type InstantLogsJobResponse struct {
+	Response
+	Result InstantLogsJob `json:"result"`
+}
+
+// InstantLogsJobsResponse is the API response, containing Instant Logs jobs.
+type InstantLogsJobsResponse struct {
+	Response
+	Result []InstantLogsJob `json:"result"`
+}
+
+// CreateInstantLogsJob starts an Instant Logs session for a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/instant-logs-jobs-for-a-zone-create-instant-logs-job
+func (api *API) CreateInstantLogsJob(ctx context.Context, rc *ResourceContainer, params CreateInstantLogsJobParams) (InstantLogsJob, error) {
+	if rc.Level != ZoneRouteLevel {
+		return InstantLogsJob{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return InstantLogsJob{}, ErrMissingZoneID
+	}
+
+	body := struct {
+		CreateInstantLogsJobParams
+		Kind string `json:"kind"`
+	}{params, instantLogsKind}
+
+	uri := fmt.Sprintf("/zones/%s/logpush/edge/jobs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, body)
+	if err != nil {
+		return InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return InstantLogsJob{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListInstantLogsJobs returns the active Instant Logs sessions of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/instant-logs-jobs-for-a-zone-list-instant-logs-jobs
+func (api *API) ListInstantLogsJobs(ctx context.Context, rc *ResourceContainer) ([]InstantLogsJob, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []InstantLogsJob{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []InstantLogsJob{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/logpush/edge/jobs", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []InstantLogsJob{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// InstantLogsEntry is a single request log, keyed by field name.
+type InstantLogsEntry map[string]interface{}
+
+// StreamInstantLogsParams configures an Instant Logs stream.
+type StreamInstantLogsParams struct {
+	// KeepaliveInterval is how often a ping is sent to keep an idle
+	// connection open. It defaults to 30 seconds.
+	KeepaliveInterval time.Duration
+
+	// Buffer is the number of entries buffered before reading from the
+	// connection blocks. It defaults to 100.
+	Buffer int
+}
+
+// InstantLogsStream is a connection to an Instant Logs session.
+type InstantLogsStream struct {
+	// Entries receives each log line as it arrives. It is closed when the
+	// stream ends.
+	Entries <-chan InstantLogsEntry
+
+	conn      *websocket.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu  sync.Mutex
+	err error
+}
+
+// Err returns the error that ended the stream, or nil if it was ended by
+// Close, its context or the session closing the connection.
+func (s *InstantLogsStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close ends the stream.
+func (s *InstantLogsStream) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.conn.Close()
+	})
+	return err
+}
+
+func (s *InstantLogsStream) fail(err error) {
+	select {
+	case <-s.done:
+		// Errors caused by closing the connection are expected.
+		return
+	default:
+	}
+
+	s.mu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+}
+
+// StreamInstantLogs connects to the WebSocket of an Instant Logs job and
+// streams its log lines, pinging the connection to keep it open while no
+// requests are logged. The stream ends when ctx is done, Close is called,
+// the session closes the connection or the connection fails.
+func (api *API) StreamInstantLogs(ctx context.Context, job InstantLogsJob, params StreamInstantLogsParams) (*InstantLogsStream, error) {
+	if job.DestinationConf == "" {
+		return nil, errors.New("required Instant Logs destination missing")
+	}
+
+	config, err := websocket.NewConfig(job.DestinationConf, defaultScheme+"://"+defaultHostname)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Instant Logs destination: %w", err)
+	}
+	if api.UserAgent != "" {
+		config.Header.Set("User-Agent", api.UserAgent)
+	}
+
+	conn, err := config.DialContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to Instant Logs: %w", err)
+	}
+
+	interval := params.KeepaliveInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	buffer := params.Buffer
+	if buffer <= 0 {
+		buffer = 100
+	}
+
+	entries := make(chan InstantLogsEntry, buffer)
+	s := &InstantLogsStream{
+		Entries: entries,
+		conn:    conn,
+		done:    make(chan struct{}),
+	}
+
+	// Nothing but pings is ever written to the connection.
+	conn.PayloadType = websocket.PingFrame
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.Close()
+				return
+			case <-s.done:
+				return
+			case <-ticker.C:
+				if _, err := conn.Write(nil); err != nil {
+					s.fail(fmt.Errorf("sending Instant Logs keepalive: %w", err))
+					s.Close()
+					return
+				}
+			}
+		}
+	}()
+
+	go func() {
+		defer close(entries)
+
+		for {
+			var msg string
+			if err := websocket.Message.Receive(conn, &msg); err != nil {
+				if !errors.Is(err, io.EOF) {
+					s.fail(fmt.Errorf("reading Instant Logs: %w", err))
+				}
+				s.Close()
+				return
+			}
+
+			// A message holds one or more newline delimited entries.
+			scanner := bufio.NewScanner(strings.NewReader(msg))
+			scanner.Buffer(make([]byte, 0, 64*1024), len(msg)+1)
+			for scanner.Scan() {
+				line := scanner.Bytes()
+				if len(line) == 0 {
+					continue
+				}
+
+				var entry InstantLogsEntry
+				if err := json.Unmarshal(line, &entry); err != nil {
+					s.fail(fmt.Errorf("%s: %w", errUnmarshalError, err))
+					s.Close()
+					return
+				}
+
+				select {
+				case entries <- entry:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+
+	return s, nil
+}
@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestCreateInstantLogsJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logpush/edge/jobs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"fields": "ClientIP,EdgeResponseStatus", "sample": 10, "kind": "instant-logs"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"destination_conf": "wss://logs.cloudflare.com/instant-logs/ws/sessions/99d471b1ca3c23cc8e30b6acec5db987",
+				"session_id": "99d471b1ca3c23cc8e30b6acec5db987",
+				"fields": "ClientIP,EdgeResponseStatus",
+				"sample": 10,
+				"filter": ""
+			}
+		}`)
+	})
+
+	actual, err := client.CreateInstantLogsJob(context.Background(), ZoneIdentifier(testZoneID), CreateInstantLogsJobParams{
+		Fields: "ClientIP,EdgeResponseStatus",
+		Sample: 10,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, InstantLogsJob{
+			DestinationConf: "wss://logs.cloudflare.com/instant-logs/ws/sessions/99d471b1ca3c23cc8e30b6acec5db987",
+			SessionID:       "99d471b1ca3c23cc8e30b6acec5db987",
+			Fields:          "ClientIP,EdgeResponseStatus",
+			Sample:          10,
+		}, actual)
+	}
+}
+
+func TestStreamInstantLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ws := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		_ = websocket.Message.Send(conn, `{"ClientIP":"192.0.2.1","EdgeResponseStatus":200}`)
+		_ = websocket.Message.Send(conn, "{\"ClientIP\":\"192.0.2.2\",\"EdgeResponseStatus\":404}\n{\"ClientIP\":\"192.0.2.3\",\"EdgeResponseStatus\":500}\n")
+	}))
+	defer ws.Close()
+
+	stream, err := client.StreamInstantLogs(context.Background(), InstantLogsJob{
+		DestinationConf: "ws" + strings.TrimPrefix(ws.URL, "http"),
+	}, StreamInstantLogsParams{KeepaliveInterval: time.Millisecond})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var ips []interface{}
+	for entry := range stream.Entries {
+		ips = append(ips, entry["ClientIP"])
+	}
+
+	assert.Equal(t, []interface{}{"192.0.2.1", "192.0.2.2", "192.0.2.3"}, ips)
+	assert.NoError(t, stream.Err())
+}
@@ -20,6 +20,16 @@ type KeylessSSL struct {
 	Permissions []string  `json:"permissions"`
 	CreatedOn   time.Time `json:"created_on"`
 	ModifiedOn  time.Time `json:"modified_on"`
+
+	Tunnel *KeylessSSLTunnel `json:"tunnel,omitempty"`
+}
+
+// KeylessSSLTunnel routes requests to a key server on a private network
+// through a Cloudflare Tunnel instead of the public internet. Host is then
+// resolved within the virtual network.
+type KeylessSSLTunnel struct {
+	PrivateIP string `json:"private_ip"`
+	VnetID    string `json:"vnet_id"`
 }
 
 // KeylessSSLCreateRequest represents the request format made for creating KeylessSSL.
@@ -29,6 +39,8 @@ type KeylessSSLCreateRequest struct {
 	Certificate  string `json:"certificate"`
 	Name         string `json:"name,omitempty"`
 	BundleMethod string `json:"bundle_method,omitempty"`
+
+	Tunnel *KeylessSSLTunnel `json:"tunnel,omitempty"`
 }
 
 // KeylessSSLDetailResponse is the API response, containing a single Keyless SSL.
@@ -49,6 +61,8 @@ type KeylessSSLUpdateRequest struct {
 	Name    string `json:"name,omitempty"`
 	Port    int    `json:"port,omitempty"`
 	Enabled *bool  `json:"enabled,omitempty"`
+
+	Tunnel *KeylessSSLTunnel `json:"tunnel,omitempty"`
 }
 
 // CreateKeylessSSL creates a new Keyless SSL configuration for the zone.
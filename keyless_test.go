@@ -238,6 +238,52 @@ func TestUpdateKeylessSSL(t *testing.T) {
 	assert.Equal(t, want, actual)
 }
 
+func TestUpdateKeylessSSLTunnel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := KeylessSSLUpdateRequest{
+		Host: "keyserver.internal",
+		Tunnel: &KeylessSSLTunnel{
+			PrivateIP: "10.0.0.1",
+			VnetID:    "7365377a-85a4-4390-9480-531ef7dc7a3c",
+		},
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		var v KeylessSSLUpdateRequest
+		err := json.NewDecoder(r.Body).Decode(&v)
+		require.NoError(t, err)
+		assert.Equal(t, input, v)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success":true,
+			"errors":[],
+			"messages":[],
+			"result":{
+			   "id":"4d2844d2ce78891c34d0b6c0535a291e",
+			   "host":"keyserver.internal",
+			   "port":24008,
+			   "status":"active",
+			   "enabled":true,
+			   "tunnel":{
+				  "private_ip":"10.0.0.1",
+				  "vnet_id":"7365377a-85a4-4390-9480-531ef7dc7a3c"
+			   }
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/keyless_certificates/"+"4d2844d2ce78891c34d0b6c0535a291e", handler)
+
+	actual, err := client.UpdateKeylessSSL(context.Background(), testZoneID, "4d2844d2ce78891c34d0b6c0535a291e", input)
+	require.NoError(t, err)
+	assert.Equal(t, input.Tunnel, actual.Tunnel)
+}
+
 func TestDeleteKeylessSSL(t *testing.T) {
 	setup()
 	defer teardown()
@@ -2,7 +2,6 @@ package cloudflare
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -21,6 +20,14 @@ const (
 	ListTypeASN = "asn"
 )
 
+// Statuses of a list bulk operation.
+const (
+	ListBulkOperationStatusPending   = "pending"
+	ListBulkOperationStatusRunning   = "running"
+	ListBulkOperationStatusCompleted = "completed"
+	ListBulkOperationStatusFailed    = "failed"
+)
+
 // ListBulkOperation contains information about a Bulk Operation.
 type ListBulkOperation struct {
 	ID        string     `json:"id"`
@@ -596,34 +603,63 @@ func (api *API) GetListBulkOperation(ctx context.Context, rc *ResourceContainer,
 	return result.Result, nil
 }
 
-// pollListBulkOperation implements synchronous behaviour for some asynchronous
-// endpoints. bulk-operation status can be either pending, running, failed or
-// completed.
-func (api *API) pollListBulkOperation(ctx context.Context, rc *ResourceContainer, ID string) error {
-	for i := uint8(0); i < 16; i++ {
-		sleepDuration := 1 << (i / 2) * time.Second
-		select {
-		case <-time.After(sleepDuration):
-		case <-ctx.Done():
-			return fmt.Errorf("operation aborted during backoff: %w", ctx.Err())
-		}
-
-		bulkResult, err := api.GetListBulkOperation(ctx, rc, ID)
+// ListBulkOperationFetcher returns an OperationFetcher of list bulk
+// operations for use with WaitForOperation.
+func (api *API) ListBulkOperationFetcher(rc *ResourceContainer) OperationFetcher {
+	return func(ctx context.Context, id string) (Operation, error) {
+		op, err := api.GetListBulkOperation(ctx, rc, id)
 		if err != nil {
-			return err
+			return Operation{}, err
 		}
+		return listBulkOperationStatus(op), nil
+	}
+}
 
-		switch bulkResult.Status {
-		case "failed":
-			return errors.New(bulkResult.Error)
-		case "pending", "running":
-			continue
-		case "completed":
-			return nil
-		default:
-			return fmt.Errorf("%s: %s", errOperationUnexpectedStatus, bulkResult.Status)
+func listBulkOperationStatus(op ListBulkOperation) Operation {
+	status := Operation{ID: op.ID, State: OperationState(op.Status), Error: op.Error}
+	switch op.Status {
+	case ListBulkOperationStatusCompleted:
+		status.State = OperationStateSucceeded
+	case ListBulkOperationStatusFailed:
+		status.State = OperationStateFailed
+	}
+	return status
+}
+
+// WaitForListOperation polls a bulk operation, such as the one started by
+// CreateListItemsAsync, until it completes or fails and returns its final
+// state. It polls 16 times, waiting from 1 up to 128 seconds before each poll
+// and about 510 seconds in total. An error is returned if the operation
+// failed, is still running after the last poll, or ctx is done.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-get-bulk-operation
+func (api *API) WaitForListOperation(ctx context.Context, rc *ResourceContainer, operationID string) (ListBulkOperation, error) {
+	var bulkResult ListBulkOperation
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		var err error
+		bulkResult, err = api.GetListBulkOperation(ctx, rc, id)
+		if err != nil {
+			return Operation{}, err
 		}
+		return listBulkOperationStatus(bulkResult), nil
 	}
 
-	return errors.New(errOperationStillRunning)
+	_, err := api.WaitForOperation(ctx, operationID, fetch, WaitForOperationParams{
+		MaxAttempts: 16,
+		Delay:       listBulkOperationDelay,
+	})
+	return bulkResult, err
+}
+
+// listBulkOperationDelay waits 1, 1, 2, 2, 4, 4, ... seconds before each
+// poll of a bulk operation.
+func listBulkOperationDelay(attempt int) time.Duration {
+	return time.Duration(1<<((attempt-1)/2)) * time.Second
+}
+
+// pollListBulkOperation implements synchronous behaviour for some asynchronous
+// endpoints.
+func (api *API) pollListBulkOperation(ctx context.Context, rc *ResourceContainer, ID string) error {
+	_, err := api.WaitForListOperation(ctx, rc, ID)
+	return err
 }
@@ -0,0 +1,198 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrMissingListName = errors.New("missing required list name")
+
+// redirectCSVColumns is the column order of the bulk redirect CSV format
+// used by the dashboard.
+var redirectCSVColumns = []string{
+	"source_url",
+	"target_url",
+	"status_code",
+	"preserve_query_string",
+	"include_subdomains",
+	"subpath_matching",
+	"preserve_path_suffix",
+}
+
+// Validate checks that the redirect has a source and target URL and, if set,
+// a status code supported by bulk redirects.
+func (r Redirect) Validate() error {
+	if r.SourceUrl == "" {
+		return errors.New("redirect requires a source URL")
+	}
+
+	if strings.Contains(r.SourceUrl, "://") {
+		return fmt.Errorf("redirect source URL %q must not include a scheme", r.SourceUrl)
+	}
+
+	if r.TargetUrl == "" {
+		return errors.New("redirect requires a target URL")
+	}
+
+	if r.StatusCode != nil {
+		switch *r.StatusCode {
+		case 301, 302, 307, 308:
+		default:
+			return fmt.Errorf("invalid redirect status code %d", *r.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+// ParseRedirectListCSV reads redirect list items from CSV in the format used
+// by the dashboard's bulk redirect import: source URL, target URL, status
+// code, preserve query string, include subdomains, subpath matching and
+// preserve path suffix. Only the first two columns are required. A header
+// row naming the columns, such as "source_url,target_url", may be used to
+// give the columns in a different order.
+func ParseRedirectListCSV(r io.Reader) ([]ListItemCreateRequest, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading redirect CSV: %w", err)
+	}
+
+	columns := redirectCSVColumns
+	if len(records) > 0 && contains(redirectCSVColumns, strings.ToLower(strings.TrimSpace(records[0][0]))) {
+		columns = make([]string, 0, len(records[0]))
+		for _, c := range records[0] {
+			c = strings.ToLower(strings.TrimSpace(c))
+			if !contains(redirectCSVColumns, c) {
+				return nil, fmt.Errorf("unknown redirect CSV column %q", c)
+			}
+			columns = append(columns, c)
+		}
+		records = records[1:]
+	}
+
+	items := make([]ListItemCreateRequest, 0, len(records))
+	for i, record := range records {
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		if len(record) > len(columns) {
+			return nil, fmt.Errorf("line %d: too many columns", i+1)
+		}
+
+		redirect := &Redirect{}
+		for j, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+
+			if err := setRedirectCSVField(redirect, columns[j], value); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+
+		if err := redirect.Validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		items = append(items, ListItemCreateRequest{Redirect: redirect})
+	}
+
+	return items, nil
+}
+
+func setRedirectCSVField(r *Redirect, column, value string) error {
+	switch column {
+	case "source_url":
+		r.SourceUrl = value
+		return nil
+	case "target_url":
+		r.TargetUrl = value
+		return nil
+	case "status_code":
+		code, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid status code %q", value)
+		}
+		r.StatusCode = &code
+		return nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", column, value)
+	}
+
+	switch column {
+	case "preserve_query_string":
+		r.PreserveQueryString = &b
+	case "include_subdomains":
+		r.IncludeSubdomains = &b
+	case "subpath_matching":
+		r.SubpathMatching = &b
+	case "preserve_path_suffix":
+		r.PreservePathSuffix = &b
+	}
+
+	return nil
+}
+
+// CreateBulkRedirectRuleParams configures a rule enabling a bulk redirect
+// list.
+type CreateBulkRedirectRuleParams struct {
+	// ListName is the name of the redirect list the rule uses.
+	ListName    string
+	Description string
+	Enabled     *bool
+
+	// Expression limits the requests the list is applied to. It defaults to
+	// all requests whose URL is in the list.
+	Expression string
+}
+
+// CreateBulkRedirectRule adds a rule to the account's http_request_redirect
+// entry point ruleset that redirects requests using a redirect list, creating
+// the entry point ruleset if needed. The created rule is returned.
+//
+// API reference: https://developers.cloudflare.com/rules/url-forwarding/bulk-redirects/create-api/
+func (api *API) CreateBulkRedirectRule(ctx context.Context, rc *ResourceContainer, params CreateBulkRedirectRuleParams) (RulesetRule, error) {
+	if rc.Level != AccountRouteLevel {
+		return RulesetRule{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return RulesetRule{}, ErrMissingAccountID
+	}
+
+	if params.ListName == "" {
+		return RulesetRule{}, ErrMissingListName
+	}
+
+	expression := params.Expression
+	if expression == "" {
+		expression = fmt.Sprintf("http.request.full_uri in $%s", params.ListName)
+	}
+
+	return api.AddEntrypointRule(ctx, rc, RulesetPhaseHTTPRequestRedirect, RulesetRule{
+		Action:      string(RulesetRuleActionRedirect),
+		Expression:  expression,
+		Description: params.Description,
+		Enabled:     params.Enabled,
+		ActionParameters: &RulesetRuleActionParameters{
+			FromList: &RulesetRuleActionParametersFromList{
+				Name: params.ListName,
+				Key:  "http.request.full_uri",
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRedirectListCSV(t *testing.T) {
+	input := `example.com/old,https://example.com/new,301,true
+www.example.com/blog,https://blog.example.com/,,,true,false
+`
+	items, err := ParseRedirectListCSV(strings.NewReader(input))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ListItemCreateRequest{
+			{Redirect: &Redirect{
+				SourceUrl:           "example.com/old",
+				TargetUrl:           "https://example.com/new",
+				StatusCode:          IntPtr(301),
+				PreserveQueryString: BoolPtr(true),
+			}},
+			{Redirect: &Redirect{
+				SourceUrl:         "www.example.com/blog",
+				TargetUrl:         "https://blog.example.com/",
+				IncludeSubdomains: BoolPtr(true),
+				SubpathMatching:   BoolPtr(false),
+			}},
+		}, items)
+	}
+
+	withHeader := "target_url,source_url,status_code\nhttps://example.com/,example.net/,308\n"
+	items, err = ParseRedirectListCSV(strings.NewReader(withHeader))
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, "example.net/", items[0].Redirect.SourceUrl)
+		assert.Equal(t, "https://example.com/", items[0].Redirect.TargetUrl)
+		assert.Equal(t, IntPtr(308), items[0].Redirect.StatusCode)
+	}
+
+	_, err = ParseRedirectListCSV(strings.NewReader("example.com/a,https://example.com/b,303\n"))
+	assert.EqualError(t, err, "line 1: invalid redirect status code 303")
+
+	_, err = ParseRedirectListCSV(strings.NewReader("https://example.com/a,https://example.com/b\n"))
+	assert.EqualError(t, err, `line 1: redirect source URL "https://example.com/a" must not include a scheme`)
+}
+
+func TestCreateBulkRedirectRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "2f2feab2026849078ba485f918791bdc",
+					"phase": "http_request_redirect",
+					"rules": [{
+						"id": "existing",
+						"action": "redirect",
+						"expression": "http.request.full_uri in $other",
+						"action_parameters": {"from_list": {"name": "other", "key": "http.request.full_uri"}}
+					}]
+				}
+			}`)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			var body UpdateEntrypointRulesetParams
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 2) {
+				assert.Equal(t, "http.request.full_uri in $my_redirects", body.Rules[1].Expression)
+				assert.Equal(t, &RulesetRuleActionParametersFromList{Name: "my_redirects", Key: "http.request.full_uri"}, body.Rules[1].ActionParameters.FromList)
+				body.Rules[1].ID = "created"
+			}
+			result, _ := json.Marshal(body.Rules)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2f2feab2026849078ba485f918791bdc", "rules": %s}}`, result)
+		}
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/http_request_redirect/entrypoint", handler)
+
+	_, err := client.CreateBulkRedirectRule(context.Background(), ZoneIdentifier(testZoneID), CreateBulkRedirectRuleParams{ListName: "my_redirects"})
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	rule, err := client.CreateBulkRedirectRule(context.Background(), AccountIdentifier(testAccountID), CreateBulkRedirectRuleParams{
+		ListName:    "my_redirects",
+		Description: "bulk redirects",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "created", rule.ID)
+		assert.Equal(t, "bulk redirects", rule.Description)
+	}
+}
@@ -1043,3 +1043,45 @@ func TestPollListTimeout(t *testing.T) {
 	assert.WithinDuration(t, start, time.Now(), time.Second,
 		"pollListBulkOperation took too much time with an expiring context")
 }
+
+func TestWaitForListOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "4da8780eeb215e6cb7f48dd981c4ea02",
+				"status": "completed",
+				"completed": "2020-01-01T08:00:00Z"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", handler)
+
+	completed, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00Z")
+	actual, err := client.WaitForListOperation(context.Background(), AccountIdentifier(testAccountID), "4da8780eeb215e6cb7f48dd981c4ea02")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ListBulkOperation{
+			ID:        "4da8780eeb215e6cb7f48dd981c4ea02",
+			Status:    ListBulkOperationStatusCompleted,
+			Completed: &completed,
+		}, actual)
+	}
+}
+
+func TestListBulkOperationDelay(t *testing.T) {
+	var total time.Duration
+	for attempt := 1; attempt <= 16; attempt++ {
+		total += listBulkOperationDelay(attempt)
+	}
+	assert.Equal(t, time.Second, listBulkOperationDelay(1))
+	assert.Equal(t, 128*time.Second, listBulkOperationDelay(16))
+	assert.Equal(t, 510*time.Second, total)
+}
@@ -10,6 +10,29 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Steering policies for LoadBalancer.SteeringPolicy and
+// LoadBalancerRuleOverrides.SteeringPolicy.
+const (
+	LoadBalancerSteeringPolicyOff                      = "off"
+	LoadBalancerSteeringPolicyGeo                      = "geo"
+	LoadBalancerSteeringPolicyRandom                   = "random"
+	LoadBalancerSteeringPolicyDynamicLatency           = "dynamic_latency"
+	LoadBalancerSteeringPolicyProximity                = "proximity"
+	LoadBalancerSteeringPolicyLeastOutstandingRequests = "least_outstanding_requests"
+	LoadBalancerSteeringPolicyLeastConnections         = "least_connections"
+)
+
+// Values for LocationStrategy.PreferECS and LocationStrategy.Mode.
+const (
+	LocationStrategyPreferECSAlways    = "always"
+	LocationStrategyPreferECSNever     = "never"
+	LocationStrategyPreferECSProximity = "proximity"
+	LocationStrategyPreferECSGeo       = "geo"
+
+	LocationStrategyModePop        = "pop"
+	LocationStrategyModeResolverIP = "resolver_ip"
+)
+
 // LoadBalancerPool represents a load balancer pool's properties.
 type LoadBalancerPool struct {
 	ID                string                      `json:"id,omitempty"`
@@ -145,6 +168,11 @@ type LoadBalancer struct {
 	// "": Maps to "geo" if RegionPools or PopPools or CountryPools have entries otherwise "off".
 	SteeringPolicy string `json:"steering_policy,omitempty"`
 
+	// Networks lists the networks the load balancer is served on, for
+	// example "cloudflare" (public) or "jdcloud". Only used for zone load
+	// balancers.
+	Networks []string `json:"networks,omitempty"`
+
 	// TunnelID is the ID of the tunnel associated with this load balancer.
 	// It is only used for account load balancers and has no relation to any
 	// tunnels used as origins for this load balancer.
@@ -401,6 +429,9 @@ var (
 	ErrMissingPoolID         = errors.New("missing required pool ID")
 	ErrMissingMonitorID      = errors.New("missing required monitor ID")
 	ErrMissingLoadBalancerID = errors.New("missing required load balancer ID")
+
+	ErrMissingLoadBalancerMonitorPreviewID    = errors.New("missing required monitor preview ID")
+	ErrMissingLoadBalancerMonitorPreviewPools = errors.New("monitor preview has no pools to probe")
 )
 
 // CreateLoadBalancerPool creates a new load balancer pool.
@@ -842,3 +873,146 @@ func (api *API) GetLoadBalancerPoolHealth(ctx context.Context, rc *ResourceConta
 	}
 	return r.Result, nil
 }
+
+// LoadBalancerMonitorPreview identifies a running preview of a monitor
+// against the pools it is attached to.
+type LoadBalancerMonitorPreview struct {
+	ID string `json:"preview_id"`
+	// Pools maps the ID of each pool being probed to its name.
+	Pools map[string]string `json:"pools"`
+}
+
+type loadBalancerMonitorPreviewResponse struct {
+	Response
+	Result LoadBalancerMonitorPreview `json:"result"`
+}
+
+type loadBalancerMonitorPreviewResultResponse struct {
+	Response
+	Result map[string]LoadBalancerPoolPopHealth `json:"result"`
+}
+
+type PreviewLoadBalancerMonitorParams struct {
+	MonitorID           string
+	LoadBalancerMonitor LoadBalancerMonitor
+
+	// PollInterval is how often RunLoadBalancerMonitorPreview checks for
+	// results. Defaults to 2 seconds.
+	PollInterval time.Duration
+
+	// MaxPolls limits how many times RunLoadBalancerMonitorPreview checks
+	// for results. Defaults to 30.
+	MaxPolls int
+}
+
+// PreviewLoadBalancerMonitor starts an on-demand health check of the pools
+// attached to a monitor using the supplied, unsaved, monitor configuration.
+// Use GetLoadBalancerMonitorPreviewResult to fetch the results.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-load-balancer-monitors-preview-monitor
+func (api *API) PreviewLoadBalancerMonitor(ctx context.Context, rc *ResourceContainer, params PreviewLoadBalancerMonitorParams) (LoadBalancerMonitorPreview, error) {
+	if rc.Level == ZoneRouteLevel {
+		return LoadBalancerMonitorPreview{}, fmt.Errorf(errInvalidResourceContainerAccess, ZoneRouteLevel)
+	}
+
+	if params.MonitorID == "" {
+		return LoadBalancerMonitorPreview{}, ErrMissingMonitorID
+	}
+
+	var uri string
+	if rc.Level == UserRouteLevel {
+		uri = fmt.Sprintf("/user/load_balancers/monitors/%s/preview", params.MonitorID)
+	} else {
+		uri = fmt.Sprintf("/accounts/%s/load_balancers/monitors/%s/preview", rc.Identifier, params.MonitorID)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params.LoadBalancerMonitor)
+	if err != nil {
+		return LoadBalancerMonitorPreview{}, err
+	}
+	var r loadBalancerMonitorPreviewResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return LoadBalancerMonitorPreview{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// GetLoadBalancerMonitorPreviewResult fetches the results of a monitor
+// preview keyed by pool ID. Pools that have not been probed yet are absent.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-load-balancer-monitors-preview-result
+func (api *API) GetLoadBalancerMonitorPreviewResult(ctx context.Context, rc *ResourceContainer, previewID string) (map[string]LoadBalancerPoolPopHealth, error) {
+	if rc.Level == ZoneRouteLevel {
+		return nil, fmt.Errorf(errInvalidResourceContainerAccess, ZoneRouteLevel)
+	}
+
+	if previewID == "" {
+		return nil, ErrMissingLoadBalancerMonitorPreviewID
+	}
+
+	var uri string
+	if rc.Level == UserRouteLevel {
+		uri = fmt.Sprintf("/user/load_balancers/preview/%s", previewID)
+	} else {
+		uri = fmt.Sprintf("/accounts/%s/load_balancers/preview/%s", rc.Identifier, previewID)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var r loadBalancerMonitorPreviewResultResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// RunLoadBalancerMonitorPreview starts a monitor preview and polls until
+// every pool has been probed. An error is returned if the monitor has no
+// pools, or the results are incomplete after MaxPolls polls or when ctx is
+// done.
+func (api *API) RunLoadBalancerMonitorPreview(ctx context.Context, rc *ResourceContainer, params PreviewLoadBalancerMonitorParams) (map[string]LoadBalancerPoolPopHealth, error) {
+	preview, err := api.PreviewLoadBalancerMonitor(ctx, rc, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(preview.Pools) == 0 {
+		return nil, ErrMissingLoadBalancerMonitorPreviewPools
+	}
+
+	interval := params.PollInterval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	maxPolls := params.MaxPolls
+	if maxPolls <= 0 {
+		maxPolls = 30
+	}
+
+	var result map[string]LoadBalancerPoolPopHealth
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		var err error
+		result, err = api.GetLoadBalancerMonitorPreviewResult(ctx, rc, id)
+		if err != nil {
+			return Operation{}, err
+		}
+
+		if len(result) >= len(preview.Pools) {
+			return Operation{ID: id, State: OperationStateSucceeded}, nil
+		}
+		return Operation{ID: id, State: OperationStateRunning}, nil
+	}
+
+	_, err = api.WaitForOperation(ctx, preview.ID, fetch, WaitForOperationParams{
+		Interval:    interval,
+		MaxAttempts: maxPolls,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("monitor preview %s did not complete: %w", preview.ID, err)
+	}
+
+	return result, nil
+}
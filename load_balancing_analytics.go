@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingLoadBalancerAnalyticsTimeRange = errors.New("required load balancer analytics time range (since and until) is missing")
+
+const loadBalancingRequestsAdaptiveQuery = `query LoadBalancingRequestsAdaptive($zoneTag: string, $filter: ZoneLoadBalancingRequestsAdaptiveFilter_InputObject, $limit: uint64!) {
+  viewer {
+    zones(filter: {zoneTag: $zoneTag}) {
+      loadBalancingRequestsAdaptive(filter: $filter, limit: $limit, orderBy: [datetime_DESC]) {
+        datetime
+        coloCode
+        lbName
+        proxied
+        region
+        sessionAffinity
+        steeringPolicy
+        selectedPoolId
+        selectedPoolName
+        selectedPoolHealthy
+        selectedPoolAvgRttMs
+        selectedOriginName
+        numberOriginsSelected
+        pools {
+          id
+          poolName
+          healthy
+          healthCheckEnabled
+          avgRttMs
+        }
+        origins {
+          originName
+          ipv4
+          ipv6
+          health
+          selected
+          weight
+        }
+      }
+    }
+  }
+}`
+
+// LoadBalancerRequestAnalytics is a single sampled request from the
+// `loadBalancingRequestsAdaptive` dataset describing which pool and origin
+// were chosen and the health of the candidates at the time.
+type LoadBalancerRequestAnalytics struct {
+	Datetime              time.Time                            `json:"datetime"`
+	ColoCode              string                               `json:"coloCode"`
+	LoadBalancerName      string                               `json:"lbName"`
+	Proxied               int                                  `json:"proxied"`
+	Region                string                               `json:"region"`
+	SessionAffinity       string                               `json:"sessionAffinity"`
+	SteeringPolicy        string                               `json:"steeringPolicy"`
+	SelectedPoolID        string                               `json:"selectedPoolId"`
+	SelectedPoolName      string                               `json:"selectedPoolName"`
+	SelectedPoolHealthy   int                                  `json:"selectedPoolHealthy"`
+	SelectedPoolAvgRttMs  int64                                `json:"selectedPoolAvgRttMs"`
+	SelectedOriginName    string                               `json:"selectedOriginName"`
+	NumberOriginsSelected int                                  `json:"numberOriginsSelected"`
+	Pools                 []LoadBalancerRequestAnalyticsPool   `json:"pools"`
+	Origins               []LoadBalancerRequestAnalyticsOrigin `json:"origins"`
+}
+
+// LoadBalancerRequestAnalyticsPool is the state of a candidate pool when the
+// request was steered.
+type LoadBalancerRequestAnalyticsPool struct {
+	ID                 string `json:"id"`
+	Name               string `json:"poolName"`
+	Healthy            int    `json:"healthy"`
+	HealthCheckEnabled int    `json:"healthCheckEnabled"`
+	AvgRttMs           int64  `json:"avgRttMs"`
+}
+
+// LoadBalancerRequestAnalyticsOrigin is the state of an origin within the
+// selected pool when the request was steered.
+type LoadBalancerRequestAnalyticsOrigin struct {
+	Name     string  `json:"originName"`
+	IPv4     string  `json:"ipv4"`
+	IPv6     string  `json:"ipv6"`
+	Health   int     `json:"health"`
+	Selected int     `json:"selected"`
+	Weight   float64 `json:"weight"`
+}
+
+// LoadBalancerRequestAnalyticsParams filters the sampled load balancer
+// requests. Since and Until are required.
+type LoadBalancerRequestAnalyticsParams struct {
+	Since            time.Time
+	Until            time.Time
+	LoadBalancerName string
+	SteeringPolicy   string
+	Limit            int
+}
+
+type loadBalancerRequestAnalyticsResponse struct {
+	Viewer struct {
+		Zones []struct {
+			LoadBalancingRequestsAdaptive []LoadBalancerRequestAnalytics `json:"loadBalancingRequestsAdaptive"`
+		} `json:"zones"`
+	} `json:"viewer"`
+}
+
+// ListLoadBalancerRequestAnalytics returns sampled load balancer requests for
+// a zone including the pool selected, the steering policy applied and the
+// health of pools and origins at request time, ordered newest first.
+//
+// API reference: https://developers.cloudflare.com/load-balancing/reference/load-balancing-analytics/
+func (api *API) ListLoadBalancerRequestAnalytics(ctx context.Context, rc *ResourceContainer, params LoadBalancerRequestAnalyticsParams) ([]LoadBalancerRequestAnalytics, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []LoadBalancerRequestAnalytics{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []LoadBalancerRequestAnalytics{}, ErrMissingZoneID
+	}
+
+	if params.Since.IsZero() || params.Until.IsZero() {
+		return []LoadBalancerRequestAnalytics{}, ErrMissingLoadBalancerAnalyticsTimeRange
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq": params.Since.UTC().Format(time.RFC3339),
+		"datetime_leq": params.Until.UTC().Format(time.RFC3339),
+	}
+	if params.LoadBalancerName != "" {
+		filter["lbName"] = params.LoadBalancerName
+	}
+	if params.SteeringPolicy != "" {
+		filter["steeringPolicy"] = params.SteeringPolicy
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	var r loadBalancerRequestAnalyticsResponse
+	err := api.GraphQL(ctx, GraphQLQuery{
+		Query: loadBalancingRequestsAdaptiveQuery,
+		Variables: map[string]interface{}{
+			"zoneTag": rc.Identifier,
+			"filter":  filter,
+			"limit":   limit,
+		},
+	}, &r)
+	if err != nil {
+		return []LoadBalancerRequestAnalytics{}, err
+	}
+
+	if len(r.Viewer.Zones) == 0 {
+		return []LoadBalancerRequestAnalytics{}, nil
+	}
+
+	return r.Viewer.Zones[0].LoadBalancingRequestsAdaptive, nil
+}
+
+// LoadBalancerHealthEvent is a change in health of a pool or one of its
+// origins as recorded by the health checks.
+type LoadBalancerHealthEvent struct {
+	ID        int                             `json:"id"`
+	Timestamp *time.Time                      `json:"timestamp"`
+	Pool      LoadBalancerHealthEventPool     `json:"pool"`
+	Origins   []LoadBalancerHealthEventOrigin `json:"origins"`
+}
+
+// LoadBalancerHealthEventPool is the state of a pool at the time of a
+// health event.
+type LoadBalancerHealthEventPool struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Healthy        bool   `json:"healthy"`
+	Changed        bool   `json:"changed"`
+	MinimumOrigins int    `json:"minimum_origins"`
+}
+
+// LoadBalancerHealthEventOrigin is the state of an origin at the time of a
+// health event.
+type LoadBalancerHealthEventOrigin struct {
+	Name          string   `json:"name"`
+	Address       string   `json:"address"`
+	IP            string   `json:"ip"`
+	Enabled       bool     `json:"enabled"`
+	Healthy       bool     `json:"healthy"`
+	Changed       bool     `json:"changed"`
+	FailureReason string   `json:"failure_reason"`
+	ResponseCode  int      `json:"response_code"`
+	RTT           Duration `json:"rtt"`
+}
+
+// ListLoadBalancerHealthEventsParams filters the health events returned.
+type ListLoadBalancerHealthEventsParams struct {
+	Since         *time.Time `url:"since,omitempty"`
+	Until         *time.Time `url:"until,omitempty"`
+	PoolID        string     `url:"pool_id,omitempty"`
+	PoolName      string     `url:"pool_name,omitempty"`
+	PoolHealthy   *bool      `url:"pool_healthy,omitempty"`
+	OriginName    string     `url:"origin_name,omitempty"`
+	OriginHealthy *bool      `url:"origin_healthy,omitempty"`
+}
+
+type loadBalancerHealthEventsResponse struct {
+	Response
+	Result []LoadBalancerHealthEvent `json:"result"`
+}
+
+// ListLoadBalancerHealthEvents returns the pool and origin health changes
+// for all load balancers owned by the user.
+//
+// API reference: https://developers.cloudflare.com/api/operations/load-balancer-healthcheck-events-list-healthcheck-events
+func (api *API) ListLoadBalancerHealthEvents(ctx context.Context, rc *ResourceContainer, params ListLoadBalancerHealthEventsParams) ([]LoadBalancerHealthEvent, error) {
+	if rc.Level != UserRouteLevel {
+		return []LoadBalancerHealthEvent{}, fmt.Errorf(errInvalidResourceContainerAccess, rc.Level)
+	}
+
+	uri := buildURI("/user/load_balancing_analytics/events", params)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []LoadBalancerHealthEvent{}, err
+	}
+
+	var r loadBalancerHealthEventsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []LoadBalancerHealthEvent{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,185 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLoadBalancerRequestAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var q GraphQLQuery
+		_ = json.Unmarshal(body, &q)
+		assert.Contains(t, q.Query, "loadBalancingRequestsAdaptive")
+		assert.Equal(t, testZoneID, q.Variables["zoneTag"])
+		assert.Equal(t, float64(100), q.Variables["limit"])
+		assert.Equal(t, map[string]interface{}{
+			"datetime_geq": "2024-10-01T00:00:00Z",
+			"datetime_leq": "2024-10-02T00:00:00Z",
+			"lbName":       "lb.example.com",
+		}, q.Variables["filter"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"zones": [{
+						"loadBalancingRequestsAdaptive": [{
+							"datetime": "2024-10-01T12:00:00Z",
+							"coloCode": "LHR",
+							"lbName": "lb.example.com",
+							"proxied": 1,
+							"region": "WEU",
+							"sessionAffinity": "none",
+							"steeringPolicy": "dynamic_latency",
+							"selectedPoolId": "17b5962d775c646f3f9725cbc7a53df4",
+							"selectedPoolName": "primary",
+							"selectedPoolHealthy": 1,
+							"selectedPoolAvgRttMs": 42,
+							"selectedOriginName": "app-1",
+							"numberOriginsSelected": 1,
+							"pools": [
+								{ "id": "17b5962d775c646f3f9725cbc7a53df4", "poolName": "primary", "healthy": 1, "healthCheckEnabled": 1, "avgRttMs": 42 },
+								{ "id": "9290f38c5d07c2e2f4df57b1f61d4196", "poolName": "secondary", "healthy": 0, "healthCheckEnabled": 1, "avgRttMs": 0 }
+							],
+							"origins": [
+								{ "originName": "app-1", "ipv4": "192.0.2.1", "ipv6": "", "health": 1, "selected": 1, "weight": 1 }
+							]
+						}]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	want := []LoadBalancerRequestAnalytics{{
+		Datetime:              time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC),
+		ColoCode:              "LHR",
+		LoadBalancerName:      "lb.example.com",
+		Proxied:               1,
+		Region:                "WEU",
+		SessionAffinity:       "none",
+		SteeringPolicy:        "dynamic_latency",
+		SelectedPoolID:        "17b5962d775c646f3f9725cbc7a53df4",
+		SelectedPoolName:      "primary",
+		SelectedPoolHealthy:   1,
+		SelectedPoolAvgRttMs:  42,
+		SelectedOriginName:    "app-1",
+		NumberOriginsSelected: 1,
+		Pools: []LoadBalancerRequestAnalyticsPool{
+			{ID: "17b5962d775c646f3f9725cbc7a53df4", Name: "primary", Healthy: 1, HealthCheckEnabled: 1, AvgRttMs: 42},
+			{ID: "9290f38c5d07c2e2f4df57b1f61d4196", Name: "secondary", Healthy: 0, HealthCheckEnabled: 1, AvgRttMs: 0},
+		},
+		Origins: []LoadBalancerRequestAnalyticsOrigin{
+			{Name: "app-1", IPv4: "192.0.2.1", Health: 1, Selected: 1, Weight: 1},
+		},
+	}}
+
+	actual, err := client.ListLoadBalancerRequestAnalytics(context.Background(), ZoneIdentifier(testZoneID), LoadBalancerRequestAnalyticsParams{
+		Since:            since,
+		Until:            until,
+		LoadBalancerName: "lb.example.com",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListLoadBalancerRequestAnalytics(context.Background(), AccountIdentifier(testAccountID), LoadBalancerRequestAnalyticsParams{Since: since, Until: until})
+	assert.ErrorIs(t, err, ErrRequiredZoneLevelResourceContainer)
+
+	_, err = client.ListLoadBalancerRequestAnalytics(context.Background(), ZoneIdentifier(testZoneID), LoadBalancerRequestAnalyticsParams{})
+	assert.ErrorIs(t, err, ErrMissingLoadBalancerAnalyticsTimeRange)
+}
+
+func TestListLoadBalancerHealthEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/load_balancing_analytics/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "17b5962d775c646f3f9725cbc7a53df4", r.URL.Query().Get("pool_id"))
+		assert.Equal(t, "false", r.URL.Query().Get("origin_healthy"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": 42,
+					"timestamp": "2024-01-10T12:00:00Z",
+					"pool": {
+						"id": "17b5962d775c646f3f9725cbc7a53df4",
+						"name": "primary-dc-1",
+						"healthy": false,
+						"changed": true,
+						"minimum_origins": 1
+					},
+					"origins": [
+						{
+							"name": "app-server-1",
+							"address": "0.0.0.0",
+							"ip": "0.0.0.0",
+							"enabled": true,
+							"healthy": false,
+							"changed": true,
+							"failure_reason": "HTTP timeout occurred",
+							"response_code": 0,
+							"rtt": "0s"
+						}
+					]
+				}
+			]
+		}`)
+	})
+
+	timestamp := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	want := []LoadBalancerHealthEvent{{
+		ID:        42,
+		Timestamp: &timestamp,
+		Pool: LoadBalancerHealthEventPool{
+			ID:             "17b5962d775c646f3f9725cbc7a53df4",
+			Name:           "primary-dc-1",
+			Changed:        true,
+			MinimumOrigins: 1,
+		},
+		Origins: []LoadBalancerHealthEventOrigin{{
+			Name:          "app-server-1",
+			Address:       "0.0.0.0",
+			IP:            "0.0.0.0",
+			Enabled:       true,
+			Changed:       true,
+			FailureReason: "HTTP timeout occurred",
+		}},
+	}}
+
+	actual, err := client.ListLoadBalancerHealthEvents(context.Background(), UserIdentifier(testUserID), ListLoadBalancerHealthEventsParams{
+		PoolID:        "17b5962d775c646f3f9725cbc7a53df4",
+		OriginHealthy: BoolPtr(false),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListLoadBalancerHealthEvents(context.Background(), AccountIdentifier(testAccountID), ListLoadBalancerHealthEventsParams{})
+	assert.EqualError(t, err, fmt.Sprintf(errInvalidResourceContainerAccess, AccountRouteLevel))
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -3027,3 +3028,218 @@ func TestLoadBalancerPoolHealthDetails_ZoneIsNotSupported(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf(errInvalidResourceContainerAccess, ZoneRouteLevel), err.Error())
 	}
 }
+
+func TestRunLoadBalancerMonitorPreview(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/monitors/f1aba936b94213e5b8dca0c0dbf1f9cc/preview", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		var monitor LoadBalancerMonitor
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&monitor)) {
+			assert.Equal(t, "/health", monitor.Path)
+			assert.Equal(t, "2xx", monitor.ExpectedCodes)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"preview_id": "f1aba936b94213e5b8dca0c0dbf1f9cc",
+				"pools": { "abwlnp5jbqn45ecgxd03erbgtxtqai0d": "WNAM Datacenter" }
+			}
+		}`)
+	})
+
+	polls := 0
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/preview/f1aba936b94213e5b8dca0c0dbf1f9cc", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{ "success": true, "errors": [], "messages": [], "result": {} }`)
+			return
+		}
+
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"abwlnp5jbqn45ecgxd03erbgtxtqai0d": {
+					"healthy": true,
+					"origins": [
+						{
+							"originone.example.com.": {
+								"healthy": true,
+								"rtt": "66ms",
+								"failure_reason": "No failures",
+								"response_code": 200
+							}
+						}
+					]
+				}
+			}
+		}`)
+	})
+
+	want := map[string]LoadBalancerPoolPopHealth{
+		"abwlnp5jbqn45ecgxd03erbgtxtqai0d": {
+			Healthy: true,
+			Origins: []map[string]LoadBalancerOriginHealth{{
+				"originone.example.com.": {
+					Healthy:       true,
+					RTT:           Duration{66 * time.Millisecond},
+					FailureReason: "No failures",
+					ResponseCode:  200,
+				},
+			}},
+		},
+	}
+
+	actual, err := client.RunLoadBalancerMonitorPreview(context.Background(), AccountIdentifier(testAccountID), PreviewLoadBalancerMonitorParams{
+		MonitorID: "f1aba936b94213e5b8dca0c0dbf1f9cc",
+		LoadBalancerMonitor: LoadBalancerMonitor{
+			Type:          "https",
+			Path:          "/health",
+			ExpectedCodes: "2xx",
+		},
+		PollInterval: time.Millisecond,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, 2, polls)
+	}
+
+	_, err = client.PreviewLoadBalancerMonitor(context.Background(), AccountIdentifier(testAccountID), PreviewLoadBalancerMonitorParams{})
+	assert.ErrorIs(t, err, ErrMissingMonitorID)
+}
+
+func TestRunLoadBalancerMonitorPreviewIncomplete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pools := `{ "abwlnp5jbqn45ecgxd03erbgtxtqai0d": "WNAM Datacenter" }`
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/monitors/f1aba936b94213e5b8dca0c0dbf1f9cc/preview", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": { "preview_id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "pools": %s }
+		}`, pools)
+	})
+
+	polls := 0
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/preview/f1aba936b94213e5b8dca0c0dbf1f9cc", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{ "success": true, "errors": [], "messages": [], "result": {} }`)
+	})
+
+	params := PreviewLoadBalancerMonitorParams{
+		MonitorID:    "f1aba936b94213e5b8dca0c0dbf1f9cc",
+		PollInterval: time.Millisecond,
+		MaxPolls:     3,
+	}
+
+	_, err := client.RunLoadBalancerMonitorPreview(context.Background(), AccountIdentifier(testAccountID), params)
+	assert.EqualError(t, err, "monitor preview f1aba936b94213e5b8dca0c0dbf1f9cc did not complete: "+errOperationStillRunning)
+	assert.Equal(t, 3, polls)
+
+	pools = `{}`
+	_, err = client.RunLoadBalancerMonitorPreview(context.Background(), AccountIdentifier(testAccountID), params)
+	assert.ErrorIs(t, err, ErrMissingLoadBalancerMonitorPreviewPools)
+	assert.Equal(t, 3, polls)
+}
+
+func TestCreateLoadBalancer_SteeringParity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/199d98642c564d2e855e9661899b7252/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		b, err := io.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "",
+				"name": "www.example.com",
+				"fallback_pool": "17b5962d775c646f3f9725cbc7a53df4",
+				"default_pools": ["17b5962d775c646f3f9725cbc7a53df4", "9290f38c5d07c2e2f4df57b1f61d4196"],
+				"region_pools": null,
+				"pop_pools": null,
+				"country_pools": null,
+				"proxied": false,
+				"steering_policy": "least_outstanding_requests",
+				"networks": ["cloudflare"],
+				"random_steering": {
+					"default_weight": 0.2,
+					"pool_weights": { "9290f38c5d07c2e2f4df57b1f61d4196": 0.8 }
+				},
+				"adaptive_routing": { "failover_across_pools": true },
+				"location_strategy": { "prefer_ecs": "always", "mode": "resolver_ip" },
+				"rules": [
+					{
+						"name": "api traffic",
+						"condition": "http.request.uri.path contains \"/api\"",
+						"priority": 0,
+						"disabled": false,
+						"overrides": {
+							"steering_policy": "random",
+							"random_steering": { "pool_weights": { "17b5962d775c646f3f9725cbc7a53df4": 1 } }
+						}
+					}
+				]
+			}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "699d98642c564d2e855e9661899b7252",
+				"name": "www.example.com",
+				"fallback_pool": "17b5962d775c646f3f9725cbc7a53df4",
+				"default_pools": ["17b5962d775c646f3f9725cbc7a53df4", "9290f38c5d07c2e2f4df57b1f61d4196"],
+				"steering_policy": "least_outstanding_requests",
+				"networks": ["cloudflare"]
+			}
+		}`)
+	})
+
+	lb := LoadBalancer{
+		Name:           "www.example.com",
+		FallbackPool:   "17b5962d775c646f3f9725cbc7a53df4",
+		DefaultPools:   []string{"17b5962d775c646f3f9725cbc7a53df4", "9290f38c5d07c2e2f4df57b1f61d4196"},
+		SteeringPolicy: LoadBalancerSteeringPolicyLeastOutstandingRequests,
+		Networks:       []string{"cloudflare"},
+		RandomSteering: &RandomSteering{
+			DefaultWeight: 0.2,
+			PoolWeights:   map[string]float64{"9290f38c5d07c2e2f4df57b1f61d4196": 0.8},
+		},
+		AdaptiveRouting: &AdaptiveRouting{FailoverAcrossPools: BoolPtr(true)},
+		LocationStrategy: &LocationStrategy{
+			PreferECS: LocationStrategyPreferECSAlways,
+			Mode:      LocationStrategyModeResolverIP,
+		},
+		Rules: []*LoadBalancerRule{{
+			Name:      "api traffic",
+			Condition: `http.request.uri.path contains "/api"`,
+			Overrides: LoadBalancerRuleOverrides{
+				SteeringPolicy: LoadBalancerSteeringPolicyRandom,
+				RandomSteering: &RandomSteering{PoolWeights: map[string]float64{"17b5962d775c646f3f9725cbc7a53df4": 1}},
+			},
+		}},
+	}
+
+	actual, err := client.CreateLoadBalancer(context.Background(), ZoneIdentifier("199d98642c564d2e855e9661899b7252"), CreateLoadBalancerParams{LoadBalancer: lb})
+	if assert.NoError(t, err) {
+		assert.Equal(t, LoadBalancerSteeringPolicyLeastOutstandingRequests, actual.SteeringPolicy)
+		assert.Equal(t, []string{"cloudflare"}, actual.Networks)
+	}
+}
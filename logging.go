@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+)
+
+// StructuredLogger logs messages with key-value attributes. It is
+// implemented by *slog.Logger, and is easily adapted for other structured
+// logging libraries.
+type StructuredLogger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// dumpSecretHeaderRegex matches the headers of an HTTP dump carrying
+// credentials.
+var dumpSecretHeaderRegex = regexp.MustCompile(`(?mi)^(Authorization|Proxy-Authorization|X-Auth-Key|X-Auth-Email|X-Auth-User-Service-Key|Cookie|Set-Cookie|Cf-Access-Client-Secret|Cf-Access-Token):[^\r\n]*`)
+
+// dumpSecretFieldRegex matches JSON string fields likely to hold secrets,
+// such as "password", "client_secret" or "private_key".
+var dumpSecretFieldRegex = regexp.MustCompile(`(?i)("[a-z_]*(?:password|secret|token|private_?key|api_?key|service_?key|psk)[a-z_]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redactDump redacts credentials from an HTTP request or response dump:
+// the values of authentication headers, of JSON fields named like secrets
+// and any occurrence of secrets.
+func redactDump(dump []byte, secrets ...string) []byte {
+	for _, secret := range secrets {
+		if secret != "" {
+			dump = bytes.ReplaceAll(dump, []byte(secret), []byte(redactedValue))
+		}
+	}
+
+	dump = dumpSecretHeaderRegex.ReplaceAll(dump, []byte("${1}: "+redactedValue))
+	dump = dumpSecretFieldRegex.ReplaceAll(dump, []byte(`${1}"`+redactedValue+`"`))
+
+	return dump
+}
+
+// logDump logs a redacted HTTP dump when debugging, to the standard logger
+// if no logger is configured.
+func (api *API) logDump(msg string, dump []byte) {
+	dump = redactDump(dump, api.APIKey, api.APIEmail, api.APIToken, api.APIUserServiceKey)
+
+	logger := api.logger
+	if logger == nil {
+		logger = printfLogger{logger: log.Default()}
+	}
+
+	logger.Debug(msg, "dump", string(dump))
+}
+
+// printfLogger adapts a Logger to StructuredLogger, printing the level and
+// message followed by the attributes as key=value pairs. Multi-line values,
+// such as HTTP dumps, are printed on their own lines.
+type printfLogger struct {
+	logger Logger
+}
+
+func (l printfLogger) Debug(msg string, args ...any) { l.log("DEBUG", msg, args) }
+func (l printfLogger) Info(msg string, args ...any)  { l.log("INFO", msg, args) }
+func (l printfLogger) Warn(msg string, args ...any)  { l.log("WARN", msg, args) }
+func (l printfLogger) Error(msg string, args ...any) { l.log("ERROR", msg, args) }
+
+func (l printfLogger) log(level, msg string, args []any) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteString(" ")
+	b.WriteString(msg)
+
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			fmt.Fprintf(&b, " %v", args[i])
+			break
+		}
+
+		if v, ok := args[i+1].(string); ok && strings.Contains(v, "\n") {
+			b.WriteString("\n")
+			b.WriteString(v)
+			continue
+		}
+		fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+	}
+
+	l.logger.Printf("%s", b.String())
+}
@@ -1,13 +1,20 @@
 package cloudflare
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/goccy/go-json"
 )
 
+var ErrMissingLogpullTimeRange = errors.New("required logpull time range (start and end) is missing")
+
 // LogpullRetentionConfiguration describes a the structure of a Logpull Retention
 // payload.
 type LogpullRetentionConfiguration struct {
@@ -56,3 +63,129 @@ func (api *API) SetLogpullRetentionFlag(ctx context.Context, zoneID string, enab
 	}
 	return &r.Result, nil
 }
+
+// LogpullReceivedParams selects the logs returned by GetLogpullReceived.
+// Start is inclusive, End is exclusive and both are required.
+type LogpullReceivedParams struct {
+	Start time.Time `url:"start"`
+	End   time.Time `url:"end"`
+
+	// Fields lists the log fields to return. The default fields are
+	// returned if empty.
+	Fields []string `url:"fields,omitempty" del:","`
+
+	// Sample is the fraction of logs to return, between 0.001 and 1.
+	Sample float64 `url:"sample,omitempty"`
+
+	// Count is the maximum number of logs to return.
+	Count int `url:"count,omitempty"`
+
+	// Timestamps is the format of timestamp fields: "unixnano" (default),
+	// "unix" or "rfc3339".
+	Timestamps string `url:"timestamps,omitempty"`
+}
+
+// LogpullEntry is a single HTTP request log, keyed by field name.
+type LogpullEntry map[string]interface{}
+
+// LogpullIterator reads the newline delimited JSON logs of a logpull
+// response one at a time, so that pulls of any size can be processed
+// without holding the response in memory. It must be closed when done.
+//
+//	it, err := api.GetLogpullReceived(ctx, rc, params)
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//
+//	for it.Next() {
+//		var entry LogpullEntry
+//		if err := it.Decode(&entry); err != nil {
+//			return err
+//		}
+//	}
+//	return it.Err()
+type LogpullIterator struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	line   []byte
+	err    error
+}
+
+// Next advances to the next log, returning false at the end of the
+// response or on error.
+func (it *LogpullIterator) Next() bool {
+	for it.err == nil {
+		line, err := it.reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			it.err = fmt.Errorf("reading logpull response: %w", err)
+			return false
+		}
+
+		it.line = bytes.TrimSpace(line)
+		if len(it.line) > 0 {
+			return true
+		}
+
+		if err != nil {
+			return false
+		}
+	}
+
+	return false
+}
+
+// Bytes returns the raw JSON of the current log. It is only valid until the
+// next call to Next.
+func (it *LogpullIterator) Bytes() []byte {
+	return it.line
+}
+
+// Decode unmarshals the current log into v, which is usually a
+// *LogpullEntry or a pointer to a struct of the requested fields.
+func (it *LogpullIterator) Decode(v interface{}) error {
+	if err := json.Unmarshal(it.line, v); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return nil
+}
+
+// Err returns the error that stopped Next, if any.
+func (it *LogpullIterator) Err() error {
+	return it.err
+}
+
+// Close closes the response.
+func (it *LogpullIterator) Close() error {
+	return it.body.Close()
+}
+
+// GetLogpullReceived requests the HTTP request logs of a zone received in a
+// time range and returns an iterator over them as they are streamed.
+//
+// API reference: https://developers.cloudflare.com/logs/logpull/requesting-logs/
+func (api *API) GetLogpullReceived(ctx context.Context, rc *ResourceContainer, params LogpullReceivedParams) (*LogpullIterator, error) {
+	if rc.Level != ZoneRouteLevel {
+		return nil, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	if params.Start.IsZero() || params.End.IsZero() {
+		return nil, ErrMissingLogpullTimeRange
+	}
+
+	params.Start = params.Start.UTC()
+	params.End = params.End.UTC()
+	uri := buildURI(fmt.Sprintf("/zones/%s/logs/received", rc.Identifier), params)
+
+	// The response is read incrementally by the iterator.
+	resp, err := api.makeStreamingRequest(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogpullIterator{body: resp.Body, reader: bufio.NewReader(resp.Body)}, nil
+}
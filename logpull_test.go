@@ -2,11 +2,14 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetLogpullRetentionFlag(t *testing.T) {
@@ -60,3 +63,116 @@ func TestSetLogpullRetentionFlag(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestGetLogpullReceived(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2024-10-01T00:00:00Z", r.URL.Query().Get("start"))
+		assert.Equal(t, "2024-10-01T00:05:00Z", r.URL.Query().Get("end"))
+		assert.Equal(t, "ClientIP,EdgeResponseStatus", r.URL.Query().Get("fields"))
+		assert.Equal(t, "0.1", r.URL.Query().Get("sample"))
+
+		w.Header().Set("content-type", "application/x-ndjson")
+		fmt.Fprint(w, `{"ClientIP":"192.0.2.1","EdgeResponseStatus":200}
+{"ClientIP":"192.0.2.2","EdgeResponseStatus":404}
+
+{"ClientIP":"192.0.2.3","EdgeResponseStatus":500}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	it, err := client.GetLogpullReceived(context.Background(), ZoneIdentifier(testZoneID), LogpullReceivedParams{
+		Start:  start,
+		End:    start.Add(5 * time.Minute),
+		Fields: []string{"ClientIP", "EdgeResponseStatus"},
+		Sample: 0.1,
+	})
+	require.NoError(t, err)
+	defer it.Close()
+
+	type entry struct {
+		ClientIP           string
+		EdgeResponseStatus int
+	}
+	var actual []entry
+	for it.Next() {
+		var e entry
+		require.NoError(t, it.Decode(&e))
+		actual = append(actual, e)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []entry{
+		{"192.0.2.1", 200},
+		{"192.0.2.2", 404},
+		{"192.0.2.3", 500},
+	}, actual)
+
+	_, err = client.GetLogpullReceived(context.Background(), ZoneIdentifier(testZoneID), LogpullReceivedParams{})
+	assert.Equal(t, ErrMissingLogpullTimeRange, err)
+}
+
+func TestGetLogpullReceivedError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1010, "message": "bad query: error parsing time"}], "messages": [], "result": null}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.GetLogpullReceived(context.Background(), ZoneIdentifier(testZoneID), LogpullReceivedParams{
+		Start: start,
+		End:   start.Add(time.Minute),
+	})
+
+	var requestError *RequestError
+	if assert.True(t, errors.As(err, &requestError)) {
+		assert.Equal(t, []int{1010}, requestError.ErrorCodes())
+	}
+}
+
+func TestGetLogpullReceivedRetry(t *testing.T) {
+	recorder := &testMetricsRecorder{}
+	setup(UsingRetryPolicy(1, 0, 0), UsingMetrics(recorder))
+	defer teardown()
+
+	attempts := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("content-type", "application/x-ndjson")
+		fmt.Fprint(w, `{"ClientIP":"192.0.2.1","EdgeResponseStatus":200}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	it, err := client.GetLogpullReceived(context.Background(), ZoneIdentifier(testZoneID), LogpullReceivedParams{
+		Start: start,
+		End:   start.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	defer it.Close()
+
+	assert.True(t, it.Next())
+	assert.Equal(t, 2, attempts)
+
+	if assert.Len(t, recorder.observed, 1) {
+		assert.Equal(t, "/zones/{id}/logs/received", recorder.observed[0].PathTemplate)
+		assert.Equal(t, http.StatusOK, recorder.observed[0].StatusCode)
+		assert.Equal(t, 1, recorder.observed[0].Retries)
+	}
+}
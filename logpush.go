@@ -64,6 +64,19 @@ type LogpushJobFilter struct {
 	Value    interface{} `json:"value,omitempty"`
 }
 
+// Output types of Logpush jobs.
+const (
+	LogpushOutputTypeNDJSON = "ndjson"
+	LogpushOutputTypeCSV    = "csv"
+)
+
+// Timestamp formats of Logpush output.
+const (
+	LogpushTimestampFormatUnixNano = "unixnano"
+	LogpushTimestampFormatUnix     = "unix"
+	LogpushTimestampFormatRFC3339  = "rfc3339"
+)
+
 type LogpushOutputOptions struct {
 	FieldNames      []string `json:"field_names"`
 	OutputType      string   `json:"output_type,omitempty"`
@@ -79,6 +92,36 @@ type LogpushOutputOptions struct {
 	CVE202144228    *bool    `json:"CVE-2021-44228,omitempty"`
 }
 
+// Validate checks the sample rate is a fraction. A sample rate of zero is
+// unset and logs every request. The output type and timestamp format are
+// left for the API to check.
+func (o LogpushOutputOptions) Validate() error {
+	if o.SampleRate < 0 || o.SampleRate > 1 {
+		return fmt.Errorf("invalid Logpush sample rate %v, must be between 0 and 1", o.SampleRate)
+	}
+
+	return nil
+}
+
+// LogpushValidation is the result of validating a Logpush destination or
+// origin. Message explains why it is invalid.
+type LogpushValidation struct {
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+}
+
+// LogpushValidationResponse is the API response, containing a destination or
+// origin validation result.
+type LogpushValidationResponse struct {
+	Response
+	Result LogpushValidation `json:"result"`
+}
+
+// LogpushValidateOriginRequest is the API request for validate origin.
+type LogpushValidateOriginRequest struct {
+	LogpullOptions string `json:"logpull_options"`
+}
+
 // LogpushJobsResponse is the API response, containing an array of Logpush Jobs.
 type LogpushJobsResponse struct {
 	Response
@@ -398,6 +441,12 @@ type GetLogpushOwnershipChallengeParams struct {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-create-logpush-job
 func (api *API) CreateLogpushJob(ctx context.Context, rc *ResourceContainer, params CreateLogpushJobParams) (*LogpushJob, error) {
+	if params.OutputOptions != nil {
+		if err := params.OutputOptions.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	uri := fmt.Sprintf("/%s/%s/logpush/jobs", rc.Level, rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
 	if err != nil {
@@ -483,6 +532,12 @@ func (api *API) GetLogpushJob(ctx context.Context, rc *ResourceContainer, jobID
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-update-logpush-job
 func (api *API) UpdateLogpushJob(ctx context.Context, rc *ResourceContainer, params UpdateLogpushJobParams) error {
+	if params.OutputOptions != nil {
+		if err := params.OutputOptions.Validate(); err != nil {
+			return err
+		}
+	}
+
 	uri := fmt.Sprintf("/%s/%s/logpush/jobs/%d", rc.Level, rc.Identifier, params.ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
 	if err != nil {
@@ -570,3 +625,43 @@ func (api *API) CheckLogpushDestinationExists(ctx context.Context, rc *ResourceC
 	}
 	return r.Result.Exists, nil
 }
+
+// ValidateLogpushDestination checks that Cloudflare can write to a Logpush
+// destination, such as a bucket, before a job is created for it.
+//
+// API reference: https://developers.cloudflare.com/api/operations/post-zones-zone_id-logpush-validate-destination
+func (api *API) ValidateLogpushDestination(ctx context.Context, rc *ResourceContainer, destinationConf string) (LogpushValidation, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/validate/destination", rc.Level, rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogpushDestinationExistsRequest{
+		DestinationConf: destinationConf,
+	})
+	if err != nil {
+		return LogpushValidation{}, err
+	}
+	var r LogpushValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushValidation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// ValidateLogpushOrigin checks the logpull options, such as the fields and
+// timestamp format, of a job.
+//
+// API reference: https://developers.cloudflare.com/api/operations/post-zones-zone_id-logpush-validate-origin
+func (api *API) ValidateLogpushOrigin(ctx context.Context, rc *ResourceContainer, logpullOptions string) (LogpushValidation, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/validate/origin", rc.Level, rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogpushValidateOriginRequest{
+		LogpullOptions: logpullOptions,
+	})
+	if err != nil {
+		return LogpushValidation{}, err
+	}
+	var r LogpushValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushValidation{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
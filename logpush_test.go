@@ -542,6 +542,66 @@ func TestCheckLogpushDestinationExists(t *testing.T) {
 	}
 }
 
+func TestValidateLogpushDestination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"valid": false,
+			"message": "access denied"
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logpush/validate/destination", handler)
+
+	actual, err := client.ValidateLogpushDestination(context.Background(), ZoneIdentifier(testZoneID), "s3://mybucket/logs?region=us-west-2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, LogpushValidation{Valid: false, Message: "access denied"}, actual)
+	}
+}
+
+func TestValidateLogpushOrigin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"logpull_options": "fields=RayID,ClientIP&timestamps=rfc3339"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"valid": true,
+			"message": ""
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logpush/validate/origin", handler)
+
+	actual, err := client.ValidateLogpushOrigin(context.Background(), ZoneIdentifier(testZoneID), "fields=RayID,ClientIP&timestamps=rfc3339")
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Valid)
+	}
+}
+
+func TestLogpushOutputOptions_Validate(t *testing.T) {
+	assert.NoError(t, LogpushOutputOptions{OutputType: LogpushOutputTypeCSV, TimestampFormat: LogpushTimestampFormatUnix, SampleRate: 0.5}.Validate())
+	assert.NoError(t, LogpushOutputOptions{OutputType: "json", TimestampFormat: "iso8601"}.Validate())
+	assert.Error(t, LogpushOutputOptions{SampleRate: 1.5}.Validate())
+}
+
 var (
 	validFilter LogpushJobFilter = LogpushJobFilter{Key: "ClientRequestPath", Operator: Contains, Value: "static"}
 )
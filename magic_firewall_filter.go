@@ -0,0 +1,113 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Magic Firewall filter protocols. Ports can only be matched for TCP and
+// UDP.
+const (
+	MagicFirewallProtocolTCP  = "tcp"
+	MagicFirewallProtocolUDP  = "udp"
+	MagicFirewallProtocolICMP = "icmp"
+	MagicFirewallProtocolGRE  = "gre"
+	MagicFirewallProtocolESP  = "esp"
+)
+
+var magicFirewallProtocols = map[string]bool{
+	MagicFirewallProtocolTCP:  true,
+	MagicFirewallProtocolUDP:  true,
+	MagicFirewallProtocolICMP: true,
+	MagicFirewallProtocolGRE:  true,
+	MagicFirewallProtocolESP:  true,
+}
+
+// MagicFirewallFilter is a typed packet filter that builds the expression
+// of a Magic Firewall rule. A packet matches when every set field matches;
+// a field with several values matches any of them.
+type MagicFirewallFilter struct {
+	// Protocol is one of the MagicFirewallProtocol constants.
+	Protocol string
+
+	// SourceIPs and DestinationIPs hold IP addresses or CIDR ranges.
+	SourceIPs      []string
+	DestinationIPs []string
+
+	// SourcePorts and DestinationPorts require Protocol to be TCP or UDP.
+	SourcePorts      []int
+	DestinationPorts []int
+
+	// SourceCountries holds alpha-2 country codes of the source IPs.
+	SourceCountries []string
+}
+
+// Expression validates the filter and returns it as a rule expression,
+// such as `ip.proto eq "tcp" and ip.src in {192.0.2.0/24} and
+// tcp.dstport in {22}`. An empty filter matches every packet.
+func (f MagicFirewallFilter) Expression() (string, error) {
+	var clauses []string
+
+	if f.Protocol != "" {
+		if !magicFirewallProtocols[f.Protocol] {
+			return "", fmt.Errorf("invalid Magic Firewall protocol %q", f.Protocol)
+		}
+		clauses = append(clauses, fmt.Sprintf("ip.proto eq %q", f.Protocol))
+	}
+
+	for _, ips := range []struct {
+		field  string
+		values []string
+	}{{"ip.src", f.SourceIPs}, {"ip.dst", f.DestinationIPs}} {
+		if len(ips.values) == 0 {
+			continue
+		}
+		for _, ip := range ips.values {
+			if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+				return "", fmt.Errorf("invalid Magic Firewall IP address or range %q", ip)
+			}
+		}
+		clauses = append(clauses, fmt.Sprintf("%s in {%s}", ips.field, strings.Join(ips.values, " ")))
+	}
+
+	for _, ports := range []struct {
+		field  string
+		values []int
+	}{{"srcport", f.SourcePorts}, {"dstport", f.DestinationPorts}} {
+		if len(ports.values) == 0 {
+			continue
+		}
+		if f.Protocol != MagicFirewallProtocolTCP && f.Protocol != MagicFirewallProtocolUDP {
+			return "", errors.New("matching ports in a Magic Firewall filter requires the tcp or udp protocol")
+		}
+
+		values := make([]string, len(ports.values))
+		for i, port := range ports.values {
+			if port < 1 || port > 65535 {
+				return "", fmt.Errorf("invalid Magic Firewall port %d", port)
+			}
+			values[i] = strconv.Itoa(port)
+		}
+		clauses = append(clauses, fmt.Sprintf("%s.%s in {%s}", f.Protocol, ports.field, strings.Join(values, " ")))
+	}
+
+	if len(f.SourceCountries) > 0 {
+		countries := make([]string, len(f.SourceCountries))
+		for i, country := range f.SourceCountries {
+			if len(country) != 2 {
+				return "", fmt.Errorf("invalid Magic Firewall country code %q", country)
+			}
+			countries[i] = strconv.Quote(strings.ToUpper(country))
+		}
+		clauses = append(clauses, fmt.Sprintf("ip.src.country in {%s}", strings.Join(countries, " ")))
+	}
+
+	if len(clauses) == 0 {
+		return "true", nil
+	}
+
+	return strings.Join(clauses, " and "), nil
+}
@@ -0,0 +1,64 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMagicFirewallFilterExpression(t *testing.T) {
+	testCases := map[string]struct {
+		filter  MagicFirewallFilter
+		want    string
+		wantErr bool
+	}{
+		"empty": {
+			filter: MagicFirewallFilter{},
+			want:   "true",
+		},
+		"ssh from a range": {
+			filter: MagicFirewallFilter{
+				Protocol:         MagicFirewallProtocolTCP,
+				SourceIPs:        []string{"192.0.2.0/24", "198.51.100.1"},
+				DestinationPorts: []int{22},
+			},
+			want: `ip.proto eq "tcp" and ip.src in {192.0.2.0/24 198.51.100.1} and tcp.dstport in {22}`,
+		},
+		"countries": {
+			filter: MagicFirewallFilter{
+				DestinationIPs:  []string{"203.0.113.10"},
+				SourceCountries: []string{"pt", "US"},
+			},
+			want: `ip.dst in {203.0.113.10} and ip.src.country in {"PT" "US"}`,
+		},
+		"ports without protocol": {
+			filter:  MagicFirewallFilter{DestinationPorts: []int{53}},
+			wantErr: true,
+		},
+		"invalid port": {
+			filter:  MagicFirewallFilter{Protocol: MagicFirewallProtocolUDP, SourcePorts: []int{70000}},
+			wantErr: true,
+		},
+		"invalid ip": {
+			filter:  MagicFirewallFilter{SourceIPs: []string{"example.com"}},
+			wantErr: true,
+		},
+		"invalid protocol": {
+			filter:  MagicFirewallFilter{Protocol: "sctp"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.filter.Expression()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+)
+
+var errMagicIDSManagedRulesetNotFound = errors.New("managed IDS ruleset not found for the account")
+
+// MagicIDSSettings is the Intrusion Detection System configuration of a
+// Magic Transit account. Detections are reported as Logpush and
+// notifications, they are never blocked.
+type MagicIDSSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+// GetMagicIDSSettings reports whether the Intrusion Detection System is
+// enabled for an account, which it is when its managed ruleset is executed
+// by the magic_transit_ids_managed phase entry point.
+//
+// API reference: https://developers.cloudflare.com/magic-firewall/about/ids/
+func (api *API) GetMagicIDSSettings(ctx context.Context, rc *ResourceContainer) (MagicIDSSettings, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicIDSSettings{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicIDSSettings{}, ErrMissingAccountID
+	}
+
+	entrypoint, err := api.GetEntrypointRuleset(ctx, rc, string(RulesetPhaseMagicTransitIDSManaged))
+	if err != nil {
+		var notFoundError *NotFoundError
+		if errors.As(err, &notFoundError) {
+			return MagicIDSSettings{}, nil
+		}
+		return MagicIDSSettings{}, err
+	}
+
+	for _, rule := range entrypoint.Rules {
+		if rule.Action == string(RulesetRuleActionExecute) && (rule.Enabled == nil || *rule.Enabled) {
+			return MagicIDSSettings{Enabled: true}, nil
+		}
+	}
+
+	return MagicIDSSettings{}, nil
+}
+
+// UpdateMagicIDSSettings enables or disables the Intrusion Detection System
+// for an account by toggling the rule of the magic_transit_ids_managed phase
+// entry point executing the managed IDS ruleset, adding the rule if needed.
+// Other rules of the entry point are left unchanged.
+//
+// API reference: https://developers.cloudflare.com/magic-firewall/about/ids/
+func (api *API) UpdateMagicIDSSettings(ctx context.Context, rc *ResourceContainer, settings MagicIDSSettings) (MagicIDSSettings, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicIDSSettings{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicIDSSettings{}, ErrMissingAccountID
+	}
+
+	rulesets, err := api.ListRulesets(ctx, rc, ListRulesetsParams{})
+	if err != nil {
+		return MagicIDSSettings{}, err
+	}
+
+	var managedID string
+	for _, r := range rulesets {
+		if r.Phase == string(RulesetPhaseMagicTransitIDSManaged) && r.Kind == string(RulesetKindManaged) {
+			managedID = r.ID
+			break
+		}
+	}
+	if managedID == "" {
+		return MagicIDSSettings{}, errMagicIDSManagedRulesetNotFound
+	}
+
+	entrypoint, err := api.getPhaseEntrypoint(ctx, rc, RulesetPhaseMagicTransitIDSManaged)
+	if err != nil {
+		return MagicIDSSettings{}, err
+	}
+
+	enabled := settings.Enabled
+	i := managedRulesetExecuteRule(entrypoint.Rules, managedID)
+	if i < 0 {
+		if !enabled {
+			return MagicIDSSettings{}, nil
+		}
+
+		_, err = api.AddEntrypointRule(ctx, rc, RulesetPhaseMagicTransitIDSManaged, RulesetRule{
+			Action:           string(RulesetRuleActionExecute),
+			ActionParameters: &RulesetRuleActionParameters{ID: managedID},
+			Expression:       "true",
+			Description:      "Intrusion Detection System",
+			Enabled:          &enabled,
+		})
+		if err != nil {
+			return MagicIDSSettings{}, err
+		}
+
+		return MagicIDSSettings{Enabled: enabled}, nil
+	}
+
+	entrypoint.Rules[i].Enabled = &enabled
+	_, err = api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase:       string(RulesetPhaseMagicTransitIDSManaged),
+		Description: entrypoint.Description,
+		Rules:       entrypoint.Rules,
+	})
+	if err != nil {
+		return MagicIDSSettings{}, err
+	}
+
+	return MagicIDSSettings{Enabled: enabled}, nil
+}
@@ -0,0 +1,140 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMagicIDSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"kind": "root",
+				"phase": "magic_transit_ids_managed",
+				"rules": [{"id": "1", "action": "execute", "action_parameters": {"id": "managed"}, "expression": "true", "enabled": true}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit_ids_managed/entrypoint", handler)
+
+	actual, err := client.GetMagicIDSSettings(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Enabled)
+	}
+}
+
+func TestGetMagicIDSSettingsNoEntrypoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the magic_transit_ids_managed phase"}], "messages": [], "result": null}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit_ids_managed/entrypoint", handler)
+
+	actual, err := client.GetMagicIDSSettings(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.False(t, actual.Enabled)
+	}
+}
+
+func TestUpdateMagicIDSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "magic-root", "kind": "root", "phase": "magic_transit"},
+				{"id": "ids-managed", "kind": "managed", "phase": "magic_transit_ids_managed"}
+			]
+		}`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit_ids_managed/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "entrypoint",
+					"phase": "magic_transit_ids_managed",
+					"rules": [
+						{"id": "custom", "action": "log", "expression": "ip.src eq 192.0.2.1"},
+						{"id": "ids", "action": "execute", "action_parameters": {"id": "ids-managed"}, "expression": "true", "enabled": true}
+					]
+				}
+			}`)
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rules": [
+			{"id": "custom", "action": "log", "expression": "ip.src eq 192.0.2.1"},
+			{"id": "ids", "action": "execute", "action_parameters": {"id": "ids-managed"}, "expression": "true", "enabled": false}
+		]}`, string(body))
+
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "entrypoint", "phase": "magic_transit_ids_managed"}}`)
+	})
+
+	actual, err := client.UpdateMagicIDSSettings(context.Background(), AccountIdentifier(testAccountID), MagicIDSSettings{Enabled: false})
+	if assert.NoError(t, err) {
+		assert.False(t, actual.Enabled)
+	}
+}
+
+func TestUpdateMagicIDSSettingsNoEntrypoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "ids-managed", "kind": "managed", "phase": "magic_transit_ids_managed"}]}`)
+	})
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rulesets/phases/magic_transit_ids_managed/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the magic_transit_ids_managed phase"}], "messages": [], "result": null}`)
+			return
+		}
+
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rules": [{"action": "execute", "action_parameters": {"id": "ids-managed"}, "expression": "true", "description": "Intrusion Detection System", "enabled": true}]}`, string(body))
+
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "entrypoint", "phase": "magic_transit_ids_managed", "rules": [{"id": "ids", "action": "execute", "action_parameters": {"id": "ids-managed"}, "expression": "true", "enabled": true}]}}`)
+	})
+
+	actual, err := client.UpdateMagicIDSSettings(context.Background(), AccountIdentifier(testAccountID), MagicIDSSettings{Enabled: true})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Enabled)
+	}
+}
@@ -13,6 +13,7 @@ import (
 // Magic Transit GRE Tunnel Error messages.
 const (
 	errMagicTransitGRETunnelNotModified = "When trying to modify GRE tunnel, API returned modified: false"
+	errMagicTransitGRETunnelMissingID   = "When trying to modify GRE tunnels, every tunnel requires an ID"
 	errMagicTransitGRETunnelNotDeleted  = "When trying to delete GRE tunnel, API returned deleted: false"
 )
 
@@ -33,9 +34,11 @@ type MagicTransitGRETunnel struct {
 
 // MagicTransitGRETunnelHealthcheck contains information about a GRE tunnel health check.
 type MagicTransitGRETunnelHealthcheck struct {
-	Enabled bool   `json:"enabled"`
-	Target  string `json:"target,omitempty"`
-	Type    string `json:"type,omitempty"`
+	Enabled   bool   `json:"enabled"`
+	Target    string `json:"target,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Rate      string `json:"rate,omitempty"`
+	Direction string `json:"direction,omitempty"`
 }
 
 // ListMagicTransitGRETunnelsResponse contains a response including GRE tunnels.
@@ -68,6 +71,21 @@ type UpdateMagicTransitGRETunnelResponse struct {
 	} `json:"result"`
 }
 
+// UpdateMagicTransitGRETunnelsRequest is an array of GRE tunnels to update.
+type UpdateMagicTransitGRETunnelsRequest struct {
+	GRETunnels []MagicTransitGRETunnel `json:"gre_tunnels"`
+}
+
+// UpdateMagicTransitGRETunnelsResponse contains a response after updating
+// several GRE tunnels.
+type UpdateMagicTransitGRETunnelsResponse struct {
+	Response
+	Result struct {
+		Modified           bool                    `json:"modified"`
+		ModifiedGRETunnels []MagicTransitGRETunnel `json:"modified_gre_tunnels"`
+	} `json:"result"`
+}
+
 // DeleteMagicTransitGRETunnelResponse contains a response after deleting a GRE Tunnel.
 type DeleteMagicTransitGRETunnelResponse struct {
 	Response
@@ -157,6 +175,39 @@ func (api *API) UpdateMagicTransitGRETunnel(ctx context.Context, accountID strin
 	return result.Result.ModifiedGRETunnel, nil
 }
 
+// UpdateMagicTransitGRETunnels updates several GRE tunnels, identified by
+// their IDs, in a single request. Either all tunnels are updated or none
+// are.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-gre-tunnels-update-multiple-gre-tunnels
+func (api *API) UpdateMagicTransitGRETunnels(ctx context.Context, accountID string, tunnels []MagicTransitGRETunnel) ([]MagicTransitGRETunnel, error) {
+	for _, tunnel := range tunnels {
+		if tunnel.ID == "" {
+			return []MagicTransitGRETunnel{}, errors.New(errMagicTransitGRETunnelMissingID)
+		}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, UpdateMagicTransitGRETunnelsRequest{
+		GRETunnels: tunnels,
+	})
+
+	if err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	result := UpdateMagicTransitGRETunnelsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitGRETunnel{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if !result.Result.Modified {
+		return []MagicTransitGRETunnel{}, errors.New(errMagicTransitGRETunnelNotModified)
+	}
+
+	return result.Result.ModifiedGRETunnels, nil
+}
+
 // DeleteMagicTransitGRETunnel deletes a GRE tunnel.
 //
 // API reference: https://api.cloudflare.com/#magic-gre-tunnels-delete-gre-tunnel
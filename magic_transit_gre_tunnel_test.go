@@ -267,6 +267,65 @@ func TestUpdateMagicTransitGRETunnel(t *testing.T) {
 	}
 }
 
+func TestUpdateMagicTransitGRETunnels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "modified": true,
+        "modified_gre_tunnels": [
+          {
+            "id": "c4a7362d577a6c3019a474fd6f485821",
+            "name": "GRE_1",
+            "customer_gre_endpoint": "203.0.113.1",
+            "cloudflare_gre_endpoint": "203.0.113.2",
+            "interface_address": "192.0.2.0/31",
+            "health_check": {
+              "enabled": true,
+              "target": "203.0.113.1",
+              "type": "request",
+              "rate": "low",
+              "direction": "bidirectional"
+            }
+          }
+        ]
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/gre_tunnels", handler)
+
+	want := []MagicTransitGRETunnel{{
+		ID:                    "c4a7362d577a6c3019a474fd6f485821",
+		Name:                  "GRE_1",
+		CustomerGREEndpoint:   "203.0.113.1",
+		CloudflareGREEndpoint: "203.0.113.2",
+		InterfaceAddress:      "192.0.2.0/31",
+		HealthCheck: &MagicTransitGRETunnelHealthcheck{
+			Enabled:   true,
+			Target:    "203.0.113.1",
+			Type:      MagicTransitTunnelHealthcheckTypeRequest,
+			Rate:      MagicTransitTunnelHealthcheckRateLow,
+			Direction: MagicTransitTunnelHealthcheckDirectionBidirectional,
+		},
+	}}
+
+	actual, err := client.UpdateMagicTransitGRETunnels(context.Background(), testAccountID, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateMagicTransitGRETunnels(context.Background(), testAccountID, []MagicTransitGRETunnel{{Name: "GRE_2"}})
+	assert.Error(t, err)
+}
+
 func TestDeleteMagicTransitGRETunnel(t *testing.T) {
 	setup()
 	defer teardown()
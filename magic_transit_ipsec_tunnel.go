@@ -13,6 +13,7 @@ import (
 // Magic Transit IPsec Tunnel Error messages.
 const (
 	errMagicTransitIPsecTunnelNotModified = "When trying to modify IPsec tunnel, API returned modified: false"
+	errMagicTransitIPsecTunnelMissingID   = "When trying to modify IPsec tunnels, every tunnel requires an ID"
 	errMagicTransitIPsecTunnelNotDeleted  = "When trying to delete IPsec tunnel, API returned deleted: false"
 )
 
@@ -75,6 +76,21 @@ type UpdateMagicTransitIPsecTunnelResponse struct {
 	} `json:"result"`
 }
 
+// UpdateMagicTransitIPsecTunnelsRequest is an array of IPsec tunnels to update.
+type UpdateMagicTransitIPsecTunnelsRequest struct {
+	IPsecTunnels []MagicTransitIPsecTunnel `json:"ipsec_tunnels"`
+}
+
+// UpdateMagicTransitIPsecTunnelsResponse contains a response after updating
+// several IPsec tunnels.
+type UpdateMagicTransitIPsecTunnelsResponse struct {
+	Response
+	Result struct {
+		Modified             bool                      `json:"modified"`
+		ModifiedIPsecTunnels []MagicTransitIPsecTunnel `json:"modified_ipsec_tunnels"`
+	} `json:"result"`
+}
+
 // DeleteMagicTransitIPsecTunnelResponse contains a response after deleting an IPsec Tunnel.
 type DeleteMagicTransitIPsecTunnelResponse struct {
 	Response
@@ -173,6 +189,39 @@ func (api *API) UpdateMagicTransitIPsecTunnel(ctx context.Context, accountID str
 	return result.Result.ModifiedIPsecTunnel, nil
 }
 
+// UpdateMagicTransitIPsecTunnels updates several IPsec tunnels, identified
+// by their IDs, in a single request. Either all tunnels are updated or none
+// are.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-ipsec-tunnels-update-multiple-ipsec-tunnels
+func (api *API) UpdateMagicTransitIPsecTunnels(ctx context.Context, accountID string, tunnels []MagicTransitIPsecTunnel) ([]MagicTransitIPsecTunnel, error) {
+	for _, tunnel := range tunnels {
+		if tunnel.ID == "" {
+			return []MagicTransitIPsecTunnel{}, errors.New(errMagicTransitIPsecTunnelMissingID)
+		}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/ipsec_tunnels", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, UpdateMagicTransitIPsecTunnelsRequest{
+		IPsecTunnels: tunnels,
+	})
+
+	if err != nil {
+		return []MagicTransitIPsecTunnel{}, err
+	}
+
+	result := UpdateMagicTransitIPsecTunnelsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitIPsecTunnel{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if !result.Result.Modified {
+		return []MagicTransitIPsecTunnel{}, errors.New(errMagicTransitIPsecTunnelNotModified)
+	}
+
+	return result.Result.ModifiedIPsecTunnels, nil
+}
+
 // DeleteMagicTransitIPsecTunnel deletes an IPsec Tunnel
 //
 // API reference: https://api.cloudflare.com/#magic-ipsec-tunnels-delete-ipsec-tunnel
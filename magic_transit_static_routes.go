@@ -14,6 +14,7 @@ import (
 const (
 	errMagicTransitStaticRouteNotModified = "When trying to modify static route, API returned modified: false"
 	errMagicTransitStaticRouteNotDeleted  = "When trying to delete static route, API returned deleted: false"
+	errMagicTransitStaticRouteMissingID   = "When trying to modify static routes, every route requires an ID"
 )
 
 // MagicTransitStaticRouteScope contains information about a static route's scope.
@@ -69,6 +70,16 @@ type DeleteMagicTransitStaticRouteResponse struct {
 	} `json:"result"`
 }
 
+// UpdateMagicTransitStaticRoutesResponse contains a response after updating
+// several static routes.
+type UpdateMagicTransitStaticRoutesResponse struct {
+	Response
+	Result struct {
+		Modified       bool                      `json:"modified"`
+		ModifiedRoutes []MagicTransitStaticRoute `json:"modified_routes"`
+	} `json:"result"`
+}
+
 // CreateMagicTransitStaticRoutesRequest is an array of static routes to create.
 type CreateMagicTransitStaticRoutesRequest struct {
 	Routes []MagicTransitStaticRoute `json:"routes"`
@@ -133,6 +144,60 @@ func (api *API) CreateMagicTransitStaticRoute(ctx context.Context, accountID str
 	return result.Result.Routes, nil
 }
 
+// CreateMagicTransitStaticRoutes creates several static routes in a single
+// request.
+//
+// API reference: https://api.cloudflare.com/#magic-transit-static-routes-create-routes
+func (api *API) CreateMagicTransitStaticRoutes(ctx context.Context, accountID string, routes []MagicTransitStaticRoute) ([]MagicTransitStaticRoute, error) {
+	uri := fmt.Sprintf("/accounts/%s/magic/routes", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, CreateMagicTransitStaticRoutesRequest{
+		Routes: routes,
+	})
+
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	result := ListMagicTransitStaticRoutesResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result.Routes, nil
+}
+
+// UpdateMagicTransitStaticRoutes updates several static routes, identified
+// by their IDs, in a single request.
+//
+// API reference: https://developers.cloudflare.com/api/operations/magic-static-routes-update-many-routes
+func (api *API) UpdateMagicTransitStaticRoutes(ctx context.Context, accountID string, routes []MagicTransitStaticRoute) ([]MagicTransitStaticRoute, error) {
+	for _, route := range routes {
+		if route.ID == "" {
+			return []MagicTransitStaticRoute{}, errors.New(errMagicTransitStaticRouteMissingID)
+		}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/routes", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, CreateMagicTransitStaticRoutesRequest{
+		Routes: routes,
+	})
+
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	result := UpdateMagicTransitStaticRoutesResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if !result.Result.Modified {
+		return []MagicTransitStaticRoute{}, errors.New(errMagicTransitStaticRouteNotModified)
+	}
+
+	return result.Result.ModifiedRoutes, nil
+}
+
 // UpdateMagicTransitStaticRoute updates a static route
 //
 // API reference: https://api.cloudflare.com/#magic-transit-static-routes-update-route
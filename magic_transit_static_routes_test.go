@@ -275,6 +275,57 @@ func TestUpdateMagicTransitStaticRoute(t *testing.T) {
 	}
 }
 
+func TestUpdateMagicTransitStaticRoutes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "modified": true,
+        "modified_routes": [
+          {
+            "id": "c4a7362d577a6c3019a474fd6f485821",
+            "prefix": "192.0.2.0/24",
+            "nexthop": "203.0.113.1",
+            "priority": 200,
+            "scope": {
+              "colo_names": [
+                "den01"
+              ]
+            }
+          }
+        ]
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/routes", handler)
+
+	want := []MagicTransitStaticRoute{{
+		ID:       "c4a7362d577a6c3019a474fd6f485821",
+		Prefix:   "192.0.2.0/24",
+		Nexthop:  "203.0.113.1",
+		Priority: 200,
+		Scope: MagicTransitStaticRouteScope{
+			ColoNames: []string{"den01"},
+		},
+	}}
+
+	actual, err := client.UpdateMagicTransitStaticRoutes(context.Background(), testAccountID, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateMagicTransitStaticRoutes(context.Background(), testAccountID, []MagicTransitStaticRoute{{Prefix: "198.51.100.0/24"}})
+	assert.Error(t, err)
+}
+
 func TestDeleteMagicTransitStaticRoute(t *testing.T) {
 	setup()
 	defer teardown()
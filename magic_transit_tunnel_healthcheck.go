@@ -1,5 +1,26 @@
 package cloudflare
 
+// Magic Transit tunnel health check types. Reply checks send ICMP echo
+// replies; request checks send ICMP echo requests.
+const (
+	MagicTransitTunnelHealthcheckTypeReply   = "reply"
+	MagicTransitTunnelHealthcheckTypeRequest = "request"
+)
+
+// Magic Transit tunnel health check rates, the frequency of health checks.
+const (
+	MagicTransitTunnelHealthcheckRateLow  = "low"
+	MagicTransitTunnelHealthcheckRateMid  = "mid"
+	MagicTransitTunnelHealthcheckRateHigh = "high"
+)
+
+// Magic Transit tunnel health check directions. Bidirectional checks
+// require the customer router to respond to health check probes.
+const (
+	MagicTransitTunnelHealthcheckDirectionUnidirectional = "unidirectional"
+	MagicTransitTunnelHealthcheckDirectionBidirectional  = "bidirectional"
+)
+
 // MagicTransitTunnelHealthcheck contains information about a tunnel health check.
 type MagicTransitTunnelHealthcheck struct {
 	Enabled   bool   `json:"enabled"`
@@ -0,0 +1,217 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingMagicWANConnectorID = errors.New("required Magic WAN connector ID missing")
+
+// MagicWANConnectorDevice is the appliance a Magic WAN connector runs on.
+type MagicWANConnectorDevice struct {
+	ID           string `json:"id"`
+	SerialNumber string `json:"serial_number,omitempty"`
+}
+
+// MagicWANConnector is a Magic WAN Connector appliance of an account.
+// Software updates are applied during the daily interrupt window.
+type MagicWANConnector struct {
+	ID                           string                   `json:"id"`
+	Activated                    bool                     `json:"activated"`
+	InterruptWindowDurationHours float64                  `json:"interrupt_window_duration_hours"`
+	InterruptWindowHourOfDay     float64                  `json:"interrupt_window_hour_of_day"`
+	Notes                        string                   `json:"notes"`
+	Timezone                     string                   `json:"timezone"`
+	Device                       *MagicWANConnectorDevice `json:"device,omitempty"`
+	LastHeartbeat                string                   `json:"last_heartbeat,omitempty"`
+	LastSeenVersion              string                   `json:"last_seen_version,omitempty"`
+	LastUpdated                  string                   `json:"last_updated,omitempty"`
+}
+
+// UpdateMagicWANConnectorParams configures a Magic WAN connector. Only the
+// fields set are changed.
+type UpdateMagicWANConnectorParams struct {
+	ConnectorID                  string   `json:"-"`
+	Activated                    *bool    `json:"activated,omitempty"`
+	InterruptWindowDurationHours *float64 `json:"interrupt_window_duration_hours,omitempty"`
+	InterruptWindowHourOfDay     *float64 `json:"interrupt_window_hour_of_day,omitempty"`
+	Notes                        *string  `json:"notes,omitempty"`
+	Timezone                     *string  `json:"timezone,omitempty"`
+}
+
+// ListMagicWANConnectorEventsParams filters the telemetry events of a
+// Magic WAN connector. From and To are required.
+type ListMagicWANConnectorEventsParams struct {
+	ConnectorID string    `url:"-"`
+	From        time.Time `url:"from,unix"`
+	To          time.Time `url:"to,unix"`
+	Limit       int       `url:"limit,omitempty"`
+	Cursor      string    `url:"cursor,omitempty"`
+}
+
+// MagicWANConnectorEvent is a telemetry event reported by a connector, such
+// as an activation, a reboot or a software update. Details depend on the
+// kind of event.
+type MagicWANConnectorEvent struct {
+	At       float64                `json:"a"`
+	Kind     string                 `json:"k"`
+	Sequence int                    `json:"n"`
+	Details  map[string]interface{} `json:"e"`
+}
+
+// MagicWANConnectorResponse is the API response, containing a Magic WAN
+// connector.
+type MagicWANConnectorResponse struct {
+	Response
+	Result MagicWANConnector `json:"result"`
+}
+
+// MagicWANConnectorsResponse is the API response, containing Magic WAN
+// connectors.
+type MagicWANConnectorsResponse struct {
+	Response
+	Result []MagicWANConnector `json:"result"`
+}
+
+// MagicWANConnectorEventsResponse is the API response, containing a page of
+// Magic WAN connector events. Cursor is empty on the last page.
+type MagicWANConnectorEventsResponse struct {
+	Response
+	Result struct {
+		Count  int                      `json:"count"`
+		Items  []MagicWANConnectorEvent `json:"items"`
+		Cursor string                   `json:"cursor"`
+	} `json:"result"`
+}
+
+// ListMagicWANConnectors returns the Magic WAN connectors of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/mconn-connector-list
+func (api *API) ListMagicWANConnectors(ctx context.Context, rc *ResourceContainer) ([]MagicWANConnector, error) {
+	if rc.Level != AccountRouteLevel {
+		return []MagicWANConnector{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []MagicWANConnector{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/connectors", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicWANConnector{}, err
+	}
+
+	var r MagicWANConnectorsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicWANConnector{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetMagicWANConnector returns a Magic WAN connector.
+//
+// API reference: https://developers.cloudflare.com/api/operations/mconn-connector-fetch
+func (api *API) GetMagicWANConnector(ctx context.Context, rc *ResourceContainer, connectorID string) (MagicWANConnector, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicWANConnector{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicWANConnector{}, ErrMissingAccountID
+	}
+
+	if connectorID == "" {
+		return MagicWANConnector{}, ErrMissingMagicWANConnectorID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/connectors/%s", rc.Identifier, connectorID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicWANConnector{}, err
+	}
+
+	var r MagicWANConnectorResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicWANConnector{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateMagicWANConnector activates a Magic WAN connector or changes its
+// interrupt window, timezone or notes.
+//
+// API reference: https://developers.cloudflare.com/api/operations/mconn-connector-update
+func (api *API) UpdateMagicWANConnector(ctx context.Context, rc *ResourceContainer, params UpdateMagicWANConnectorParams) (MagicWANConnector, error) {
+	if rc.Level != AccountRouteLevel {
+		return MagicWANConnector{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return MagicWANConnector{}, ErrMissingAccountID
+	}
+
+	if params.ConnectorID == "" {
+		return MagicWANConnector{}, ErrMissingMagicWANConnectorID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/connectors/%s", rc.Identifier, params.ConnectorID)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	if err != nil {
+		return MagicWANConnector{}, err
+	}
+
+	var r MagicWANConnectorResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicWANConnector{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// ListMagicWANConnectorEvents returns a page of the telemetry events of a
+// Magic WAN connector and the cursor of the next page, which is empty on the
+// last page.
+//
+// API reference: https://developers.cloudflare.com/api/operations/mconn-connector-telemetry-events-list
+func (api *API) ListMagicWANConnectorEvents(ctx context.Context, rc *ResourceContainer, params ListMagicWANConnectorEventsParams) ([]MagicWANConnectorEvent, string, error) {
+	if rc.Level != AccountRouteLevel {
+		return []MagicWANConnectorEvent{}, "", ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []MagicWANConnectorEvent{}, "", ErrMissingAccountID
+	}
+
+	if params.ConnectorID == "" {
+		return []MagicWANConnectorEvent{}, "", ErrMissingMagicWANConnectorID
+	}
+
+	if params.From.IsZero() || params.To.IsZero() {
+		return []MagicWANConnectorEvent{}, "", errors.New("required Magic WAN connector event time range (from and to) missing")
+	}
+
+	uri := buildURI(fmt.Sprintf("/accounts/%s/magic/connectors/%s/telemetry/events", rc.Identifier, params.ConnectorID), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicWANConnectorEvent{}, "", err
+	}
+
+	var r MagicWANConnectorEventsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicWANConnectorEvent{}, "", fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.Items, r.Result.Cursor, nil
+}
@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMagicWANConnectorID = "8d3ecb2b1c8c4d53a1e4f9c25b4f8a5e"
+
+func TestListMagicWANConnectors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "%s",
+				"activated": true,
+				"interrupt_window_duration_hours": 2,
+				"interrupt_window_hour_of_day": 3,
+				"notes": "branch office",
+				"timezone": "Europe/Lisbon",
+				"device": {"id": "device-1", "serial_number": "SN123"},
+				"last_seen_version": "2024.9.1"
+			}]
+		}`, testMagicWANConnectorID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/connectors", handler)
+
+	actual, err := client.ListMagicWANConnectors(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []MagicWANConnector{{
+			ID:                           testMagicWANConnectorID,
+			Activated:                    true,
+			InterruptWindowDurationHours: 2,
+			InterruptWindowHourOfDay:     3,
+			Notes:                        "branch office",
+			Timezone:                     "Europe/Lisbon",
+			Device:                       &MagicWANConnectorDevice{ID: "device-1", SerialNumber: "SN123"},
+			LastSeenVersion:              "2024.9.1",
+		}}, actual)
+	}
+}
+
+func TestUpdateMagicWANConnector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"activated": true, "interrupt_window_hour_of_day": 0}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "%s", "activated": true, "interrupt_window_hour_of_day": 0, "timezone": "UTC"}
+		}`, testMagicWANConnectorID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/connectors/"+testMagicWANConnectorID, handler)
+
+	hour := float64(0)
+	actual, err := client.UpdateMagicWANConnector(context.Background(), AccountIdentifier(testAccountID), UpdateMagicWANConnectorParams{
+		ConnectorID:              testMagicWANConnectorID,
+		Activated:                BoolPtr(true),
+		InterruptWindowHourOfDay: &hour,
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Activated)
+		assert.Equal(t, "UTC", actual.Timezone)
+	}
+
+	_, err = client.UpdateMagicWANConnector(context.Background(), AccountIdentifier(testAccountID), UpdateMagicWANConnectorParams{})
+	assert.Equal(t, ErrMissingMagicWANConnectorID, err)
+}
+
+func TestListMagicWANConnectorEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "1727740800", r.URL.Query().Get("from"))
+		assert.Equal(t, "1727744400", r.URL.Query().Get("to"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"count": 1,
+				"items": [{"a": 1727741000.5, "k": "Init", "n": 1, "e": {"k": "Init"}}],
+				"cursor": "next-page"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/magic/connectors/"+testMagicWANConnectorID+"/telemetry/events", handler)
+
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	actual, cursor, err := client.ListMagicWANConnectorEvents(context.Background(), AccountIdentifier(testAccountID), ListMagicWANConnectorEventsParams{
+		ConnectorID: testMagicWANConnectorID,
+		From:        from,
+		To:          from.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "next-page", cursor)
+	require.Len(t, actual, 1)
+	assert.Equal(t, "Init", actual[0].Kind)
+	assert.Equal(t, 1, actual[0].Sequence)
+}
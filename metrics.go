@@ -0,0 +1,68 @@
+package cloudflare
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestMetrics describes a completed API call.
+type RequestMetrics struct {
+	Method string
+
+	// PathTemplate is the request path with IDs replaced, as described by
+	// TraceRequest.
+	PathTemplate string
+
+	// StatusCode is the status of the last response, or 0 if none was
+	// received.
+	StatusCode int
+
+	// Duration is the time taken by the call, including retries and
+	// waiting for the client rate limiter. For streaming calls it ends
+	// once the response is received.
+	Duration time.Duration
+
+	// Retries is the number of attempts made after the first one.
+	Retries int
+
+	Err error
+
+	// RateLimitRemaining is the number of requests left in the current API
+	// rate limit window, or -1 if the response did not include it.
+	RateLimitRemaining int
+}
+
+// MetricsRecorder records metrics about API calls, for example as
+// Prometheus metrics: a request counter and a latency histogram labeled by
+// method, path template and status, an error counter for calls where Err
+// is set and a gauge set to RateLimitRemaining when it is not -1.
+// ObserveRequest is called once for each call, after it completes, and
+// must be safe for concurrent use.
+type MetricsRecorder interface {
+	ObserveRequest(metrics RequestMetrics)
+}
+
+// rateLimitRemaining returns the remaining requests of the API rate limit
+// from the Ratelimit header, as in `"default";r=1199;t=300`, or the
+// X-RateLimit-Remaining header, or -1.
+func rateLimitRemaining(header http.Header) int {
+	if v := header.Get("Ratelimit"); v != "" {
+		for _, param := range strings.Split(v, ";") {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "r=") {
+				continue
+			}
+			if n, err := strconv.Atoi(strings.TrimPrefix(param, "r=")); err == nil {
+				return n
+			}
+		}
+	}
+
+	if n, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		return n
+	}
+
+	return -1
+}
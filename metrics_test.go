@@ -0,0 +1,50 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testMetricsRecorder struct {
+	observed []RequestMetrics
+}
+
+func (r *testMetricsRecorder) ObserveRequest(metrics RequestMetrics) {
+	r.observed = append(r.observed, metrics)
+}
+
+func TestMetricsRecorder(t *testing.T) {
+	recorder := &testMetricsRecorder{}
+	setup(UsingMetrics(recorder))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("Ratelimit", `"default";r=1199;t=300`)
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	})
+
+	_, err := client.UserDetails(context.Background())
+	assert.NoError(t, err)
+
+	if assert.Len(t, recorder.observed, 1) {
+		m := recorder.observed[0]
+		assert.Equal(t, http.MethodGet, m.Method)
+		assert.Equal(t, "/user", m.PathTemplate)
+		assert.Equal(t, http.StatusOK, m.StatusCode)
+		assert.Equal(t, 1199, m.RateLimitRemaining)
+		assert.Greater(t, m.Duration.Nanoseconds(), int64(0))
+		assert.NoError(t, m.Err)
+	}
+}
+
+func TestRateLimitRemaining(t *testing.T) {
+	assert.Equal(t, 42, rateLimitRemaining(http.Header{"Ratelimit": []string{`"default";r=42;t=10`}}))
+	assert.Equal(t, 7, rateLimitRemaining(http.Header{"X-Ratelimit-Remaining": []string{"7"}}))
+	assert.Equal(t, -1, rateLimitRemaining(http.Header{}))
+	assert.Equal(t, -1, rateLimitRemaining(nil))
+}
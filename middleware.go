@@ -0,0 +1,44 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RequestMiddleware edits each request before it is sent, for example to add
+// correlation IDs or proxy credentials. Returning an error aborts the
+// request without retrying it.
+type RequestMiddleware func(*http.Request) error
+
+// requestMiddlewareError is returned when a RequestMiddleware fails, so that
+// the request is not retried.
+type requestMiddlewareError struct {
+	err error
+}
+
+func (e *requestMiddlewareError) Error() string {
+	return fmt.Sprintf("request middleware failed: %s", e.err)
+}
+
+func (e *requestMiddlewareError) Unwrap() error {
+	return e.err
+}
+
+type requestHeadersContextKey struct{}
+
+// WithRequestHeaders returns a context that adds headers to the requests
+// made with it, overriding the client headers and those set by the method
+// called. Authentication headers cannot be overridden this way. Headers of
+// an outer WithRequestHeaders are kept unless overridden.
+func WithRequestHeaders(ctx context.Context, headers http.Header) context.Context {
+	combined := make(http.Header)
+	copyHeader(combined, requestHeadersFromContext(ctx))
+	copyHeader(combined, headers)
+	return context.WithValue(ctx, requestHeadersContextKey{}, combined)
+}
+
+func requestHeadersFromContext(ctx context.Context) http.Header {
+	headers, _ := ctx.Value(requestHeadersContextKey{}).(http.Header)
+	return headers
+}
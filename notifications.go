@@ -83,6 +83,23 @@ type NotificationUpsertWebhooks struct {
 	Secret string `json:"secret"`
 }
 
+// String implements fmt.Stringer, redacting the webhook secret.
+func (n NotificationUpsertWebhooks) String() string {
+	return redactedString(n.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the webhook secret.
+func (n NotificationUpsertWebhooks) GoString() string {
+	return redactedGoString("NotificationUpsertWebhooks", n.redacted())
+}
+
+func (n NotificationUpsertWebhooks) redacted() interface{} {
+	type redacted NotificationUpsertWebhooks
+	r := redacted(n)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
 // NotificationPagerDutyResource describes a PagerDuty integration.
 type NotificationPagerDutyResource struct {
 	ID   string `json:"id"`
@@ -436,6 +453,36 @@ func (api *API) ListNotificationHistory(ctx context.Context, accountID string, a
 	return r.Result, r.ResultInfo, nil
 }
 
+// ListAllNotificationHistory returns the alerts sent for an account in the
+// time range of the filter, following every page of the history from the
+// page set in the filter onwards.
+//
+// API Reference: https://api.cloudflare.com/#notification-history-list-history
+func (api *API) ListAllNotificationHistory(ctx context.Context, accountID string, alertHistoryFilter AlertHistoryFilter) ([]NotificationHistory, error) {
+	if alertHistoryFilter.PerPage < 1 {
+		alertHistoryFilter.PerPage = 25
+	}
+	if alertHistoryFilter.Page < 1 {
+		alertHistoryFilter.Page = 1
+	}
+
+	var history []NotificationHistory
+	for {
+		page, resultInfo, err := api.ListNotificationHistory(ctx, accountID, alertHistoryFilter)
+		if err != nil {
+			return []NotificationHistory{}, err
+		}
+		history = append(history, page...)
+
+		if len(page) == 0 || !resultInfo.HasMorePages() {
+			break
+		}
+		alertHistoryFilter.Page = resultInfo.Page + 1
+	}
+
+	return history, nil
+}
+
 // unmarshal will unmarshal bytes and return a SaveResponse.
 func unmarshalNotificationSaveResponse(res []byte) (SaveResponse, error) {
 	var r SaveResponse
@@ -0,0 +1,137 @@
+package cloudflare
+
+// Alert types used by the notification policy presets.
+//
+// There are no presets for failures of Worker Cron Triggers or Queue
+// consumers, as the notifications API has no alert type for either.
+// GetAvailableNotificationTypes lists the alert types of an account.
+const (
+	NotificationAlertTypeLogpushJobDisabled            = "failing_logpush_job_disabled_alert"
+	NotificationAlertTypeTunnelHealth                  = "tunnel_health_event"
+	NotificationAlertTypeZoneCustomCertificateExpiring = "zone_aop_custom_certificate_expiration_type"
+)
+
+// Tunnel statuses accepted by the `new_status` filter of a tunnel health
+// notification policy.
+const (
+	TunnelStatusHealthy  = "TUNNEL_STATUS_TYPE_HEALTHY"
+	TunnelStatusDegraded = "TUNNEL_STATUS_TYPE_DEGRADED"
+	TunnelStatusDown     = "TUNNEL_STATUS_TYPE_DOWN"
+)
+
+// Delivery mechanism types, the keys of NotificationPolicy.Mechanisms.
+const (
+	NotificationMechanismTypeEmail     = "email"
+	NotificationMechanismTypeWebhooks  = "webhooks"
+	NotificationMechanismTypePagerDuty = "pagerduty"
+)
+
+// Common keys of NotificationPolicy.Filters. The filters accepted by each
+// alert type are listed by GetAvailableNotificationTypes.
+const (
+	NotificationFilterZones                   = "zones"
+	NotificationFilterServices                = "services"
+	NotificationFilterProduct                 = "product"
+	NotificationFilterEnabled                 = "enabled"
+	NotificationFilterPoolID                  = "pool_id"
+	NotificationFilterHealthCheckID           = "health_check_id"
+	NotificationFilterNewHealth               = "new_health"
+	NotificationFilterNewStatus               = "new_status"
+	NotificationFilterTunnelID                = "tunnel_id"
+	NotificationFilterEventSource             = "event_source"
+	NotificationFilterSLO                     = "slo"
+	NotificationFilterAlertTriggerPreferences = "alert_trigger_preferences"
+)
+
+// NotificationEmailMechanisms builds the `email` delivery mechanism for a
+// notification policy from one or more email addresses.
+func NotificationEmailMechanisms(emails ...string) map[string]NotificationMechanismIntegrations {
+	return notificationMechanisms(NotificationMechanismTypeEmail, emails)
+}
+
+// NotificationWebhookMechanisms builds the `webhooks` delivery mechanism for
+// a notification policy from the IDs of one or more destination webhooks.
+func NotificationWebhookMechanisms(webhookIDs ...string) map[string]NotificationMechanismIntegrations {
+	return notificationMechanisms(NotificationMechanismTypeWebhooks, webhookIDs)
+}
+
+// NotificationPagerDutyMechanisms builds the `pagerduty` delivery mechanism
+// for a notification policy from the IDs of one or more PagerDuty services.
+func NotificationPagerDutyMechanisms(serviceIDs ...string) map[string]NotificationMechanismIntegrations {
+	return notificationMechanisms(NotificationMechanismTypePagerDuty, serviceIDs)
+}
+
+// MergeNotificationMechanisms combines delivery mechanisms so a policy can
+// notify through more than one of them.
+func MergeNotificationMechanisms(mechanisms ...map[string]NotificationMechanismIntegrations) map[string]NotificationMechanismIntegrations {
+	merged := make(map[string]NotificationMechanismIntegrations)
+	for _, m := range mechanisms {
+		for kind, integrations := range m {
+			merged[kind] = append(merged[kind], integrations...)
+		}
+	}
+
+	return merged
+}
+
+func notificationMechanisms(kind string, ids []string) map[string]NotificationMechanismIntegrations {
+	integrations := make(NotificationMechanismIntegrations, 0, len(ids))
+	for _, id := range ids {
+		integrations = append(integrations, NotificationMechanismData{ID: id})
+	}
+
+	return map[string]NotificationMechanismIntegrations{kind: integrations}
+}
+
+// NewLogpushJobDisabledNotificationPolicy returns an enabled notification
+// policy that fires when a Logpush job is disabled after repeated delivery
+// failures.
+func NewLogpushJobDisabledNotificationPolicy(name string, mechanisms map[string]NotificationMechanismIntegrations) NotificationPolicy {
+	return NotificationPolicy{
+		Name:       name,
+		Enabled:    true,
+		AlertType:  NotificationAlertTypeLogpushJobDisabled,
+		Mechanisms: mechanisms,
+		Filters:    map[string][]string{},
+	}
+}
+
+// NewTunnelHealthNotificationPolicy returns an enabled notification policy
+// that fires when any of the given tunnels transitions to one of statuses. An
+// empty tunnelIDs matches every tunnel in the account and an empty statuses
+// defaults to degraded and down.
+func NewTunnelHealthNotificationPolicy(name string, mechanisms map[string]NotificationMechanismIntegrations, tunnelIDs []string, statuses ...string) NotificationPolicy {
+	if len(statuses) == 0 {
+		statuses = []string{TunnelStatusDegraded, TunnelStatusDown}
+	}
+
+	filters := map[string][]string{
+		NotificationFilterNewStatus: statuses,
+	}
+	if len(tunnelIDs) > 0 {
+		filters[NotificationFilterTunnelID] = tunnelIDs
+	}
+
+	return NotificationPolicy{
+		Name:       name,
+		Enabled:    true,
+		AlertType:  NotificationAlertTypeTunnelHealth,
+		Mechanisms: mechanisms,
+		Filters:    filters,
+	}
+}
+
+// NewCertificateExpiryNotificationPolicy returns an enabled notification
+// policy that fires ahead of the expiry of the zone level Authenticated
+// Origin Pulls certificates uploaded to zoneIDs.
+func NewCertificateExpiryNotificationPolicy(name string, mechanisms map[string]NotificationMechanismIntegrations, zoneIDs []string) NotificationPolicy {
+	return NotificationPolicy{
+		Name:       name,
+		Enabled:    true,
+		AlertType:  NotificationAlertTypeZoneCustomCertificateExpiring,
+		Mechanisms: mechanisms,
+		Filters: map[string][]string{
+			NotificationFilterZones: zoneIDs,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationEmailMechanisms(t *testing.T) {
+	want := map[string]NotificationMechanismIntegrations{
+		"email": {
+			{ID: "ops@example.com"},
+			{ID: "oncall@example.com"},
+		},
+	}
+
+	assert.Equal(t, want, NotificationEmailMechanisms("ops@example.com", "oncall@example.com"))
+}
+
+func TestMergeNotificationMechanisms(t *testing.T) {
+	want := map[string]NotificationMechanismIntegrations{
+		"email":     {{ID: "ops@example.com"}},
+		"webhooks":  {{ID: "wh-1"}, {ID: "wh-2"}},
+		"pagerduty": {{ID: "pd-1"}},
+	}
+
+	assert.Equal(t, want, MergeNotificationMechanisms(
+		NotificationEmailMechanisms("ops@example.com"),
+		NotificationWebhookMechanisms("wh-1"),
+		NotificationWebhookMechanisms("wh-2"),
+		NotificationPagerDutyMechanisms("pd-1"),
+	))
+}
+
+func TestNewTunnelHealthNotificationPolicy(t *testing.T) {
+	mechanisms := NotificationEmailMechanisms("ops@example.com")
+
+	policy := NewTunnelHealthNotificationPolicy("tunnel health", mechanisms, []string{testTunnelID})
+	assert.Equal(t, NotificationAlertTypeTunnelHealth, policy.AlertType)
+	assert.True(t, policy.Enabled)
+	assert.Equal(t, map[string][]string{
+		"tunnel_id":  {testTunnelID},
+		"new_status": {TunnelStatusDegraded, TunnelStatusDown},
+	}, policy.Filters)
+
+	policy = NewTunnelHealthNotificationPolicy("tunnel down", mechanisms, nil, TunnelStatusDown)
+	assert.Equal(t, map[string][]string{
+		"new_status": {TunnelStatusDown},
+	}, policy.Filters)
+}
+
+func TestNewCertificateExpiryNotificationPolicy(t *testing.T) {
+	policy := NewCertificateExpiryNotificationPolicy("cert expiry", NotificationEmailMechanisms("ops@example.com"), []string{testZoneID})
+	assert.Equal(t, NotificationAlertTypeZoneCustomCertificateExpiring, policy.AlertType)
+	assert.Equal(t, map[string][]string{"zones": {testZoneID}}, policy.Filters)
+}
+
+func TestCreateNotificationPolicy_LogpushJobDisabledPreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, _ := io.ReadAll(r.Body)
+		var p NotificationPolicy
+		_ = json.Unmarshal(body, &p)
+		assert.Equal(t, "logpush", p.Name)
+		assert.Equal(t, NotificationAlertTypeLogpushJobDisabled, p.AlertType)
+		assert.True(t, p.Enabled)
+		assert.Equal(t, NotificationEmailMechanisms("ops@example.com"), p.Mechanisms)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": { "id": "0da2b59e-f118-439d-8097-bdfb215203c9" }
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/alerting/v3/policies", handler)
+
+	policy := NewLogpushJobDisabledNotificationPolicy("logpush", NotificationEmailMechanisms("ops@example.com"))
+	res, err := client.CreateNotificationPolicy(context.Background(), testAccountID, policy)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0da2b59e-f118-439d-8097-bdfb215203c9", res.Result.ID)
+	}
+}
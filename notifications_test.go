@@ -572,3 +572,35 @@ func TestListNotificationHistory(t *testing.T) {
 	require.Equal(t, expected, actualResult)
 	require.Equal(t, expectedResultInfo, actualResultInfo)
 }
+
+func TestListAllNotificationHistory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2024-10-01T00:00:00Z", r.URL.Query().Get("since"))
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2},
+			"result": [{"id": "alert-%s", "alert_type": "tunnel_health_event", "sent": "2024-10-01T00:00:00Z"}]
+		}`, page, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/alerting/v3/history", handler)
+
+	history, err := client.ListAllNotificationHistory(context.Background(), testAccountID, AlertHistoryFilter{
+		TimeRange:         TimeRange{Since: "2024-10-01T00:00:00Z"},
+		PaginationOptions: PaginationOptions{PerPage: 1},
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, history, 2)
+		assert.Equal(t, "alert-1", history[0].ID)
+		assert.Equal(t, "alert-2", history[1].ID)
+	}
+}
@@ -17,6 +17,18 @@ var (
 	ErrMissingObservatoryTestID = errors.New("missing required test id")
 )
 
+// Frequencies of scheduled tests.
+const (
+	ObservatoryFrequencyDaily  = "DAILY"
+	ObservatoryFrequencyWeekly = "WEEKLY"
+)
+
+// Device types of test reports and trends.
+const (
+	ObservatoryDeviceDesktop = "DESKTOP"
+	ObservatoryDeviceMobile  = "MOBILE"
+)
+
 // ObservatoryPage describes all the tests for a web page.
 type ObservatoryPage struct {
 	URL               string                `json:"url"`
@@ -185,7 +197,7 @@ func (api *API) ListObservatoryPageTests(ctx context.Context, rc *ResourceContai
 		params.Page = 1
 	}
 	var tests []ObservatoryPageTest
-	var lastResultInfo ResultInfo
+	var r ObservatoryPageTestsResponse
 	for {
 		// cannot use buildURI because params.URL contains "/" that should be encoded and buildURI will double encode %2F into %252F
 		v, _ := query.Values(params)
@@ -194,18 +206,18 @@ func (api *API) ListObservatoryPageTests(ctx context.Context, rc *ResourceContai
 		if err != nil {
 			return nil, nil, err
 		}
-		var r ObservatoryPageTestsResponse
+		r = ObservatoryPageTestsResponse{}
 		err = json.Unmarshal(res, &r)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
 		}
 		tests = append(tests, r.Result...)
-		lastResultInfo = r.ResultInfo.Next()
+		params.ResultInfo = r.ResultInfo.Next()
 		if params.ResultInfo.Done() || !autoPaginate {
 			break
 		}
 	}
-	return tests, &lastResultInfo, nil
+	return tests, &r.ResultInfo, nil
 }
 
 type CreateObservatoryPageTestParams struct {
@@ -399,3 +411,40 @@ func (api *API) DeleteObservatoryScheduledPageTest(ctx context.Context, rc *Reso
 	}
 	return &r.Result.Count, nil
 }
+
+// ObservatoryQuota describes the tests and schedules left for a zone.
+type ObservatoryQuota struct {
+	Plan               string `json:"plan"`
+	RemainingTests     int    `json:"remainingTests"`
+	RemainingSchedules int    `json:"remainingSchedules"`
+}
+
+// ObservatoryAvailabilities describes the quota of a zone and the regions
+// tests can be run in.
+type ObservatoryAvailabilities struct {
+	Quota   ObservatoryQuota `json:"quota"`
+	Regions []labeledRegion  `json:"regions"`
+}
+
+type ObservatoryAvailabilitiesResponse struct {
+	Response
+	Result ObservatoryAvailabilities `json:"result"`
+}
+
+// GetObservatoryAvailabilities returns the remaining test quota of a zone
+// and the regions available to its plan.
+//
+// API reference: https://developers.cloudflare.com/api/operations/speed-get-availabilities
+func (api *API) GetObservatoryAvailabilities(ctx context.Context, rc *ResourceContainer) (*ObservatoryAvailabilities, error) {
+	uri := fmt.Sprintf("/zones/%s/speed_api/availabilities", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var r ObservatoryAvailabilitiesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return &r.Result, nil
+}
@@ -462,3 +462,58 @@ func TestDeleteObservatoryScheduledPageTest(t *testing.T) {
 		assert.Equal(t, &want, count)
 	}
 }
+
+func TestListObservatoryPageTests_AutoPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			  "success": true,
+			  "errors": [],
+			  "messages": [],
+			  "result": [%s],
+			  "result_info": {"page": %s, "per_page": 1, "count": 1, "total_count": 2}
+			}
+		`, pageTestJSON, page)
+	}
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages/"+testURL+"/tests", handler)
+
+	tests, _, err := client.ListObservatoryPageTests(context.Background(), ZoneIdentifier(testZoneID), ListObservatoryPageTestParams{
+		URL:    testURL,
+		Region: region,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, tests, 2)
+	}
+}
+
+func TestGetObservatoryAvailabilities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			  "success": true,
+			  "errors": [],
+			  "messages": [],
+			  "result": {
+				"quota": {"plan": "business", "remainingTests": 42, "remainingSchedules": 3},
+				"regions": [{"value": "%s", "label": "%s"}]
+			  }
+			}
+		`, region, regionLabel)
+	}
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/availabilities", handler)
+
+	availabilities, err := client.GetObservatoryAvailabilities(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, ObservatoryQuota{Plan: "business", RemainingTests: 42, RemainingSchedules: 3}, availabilities.Quota)
+		assert.Equal(t, region, availabilities.Regions[0].Value)
+	}
+}
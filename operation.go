@@ -0,0 +1,133 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OperationState is the state of a long-running asynchronous operation.
+type OperationState string
+
+// States of an Operation. Succeeded and failed are terminal.
+const (
+	OperationStatePending   OperationState = "pending"
+	OperationStateRunning   OperationState = "running"
+	OperationStateSucceeded OperationState = "succeeded"
+	OperationStateFailed    OperationState = "failed"
+)
+
+// Operation is the status of a long-running asynchronous operation, such as
+// a list bulk operation or a Cache Reserve clear, in a form common to all of
+// them.
+type Operation struct {
+	ID    string
+	State OperationState
+
+	// Error is the reason a failed operation failed, if given.
+	Error string
+}
+
+// Done reports whether the operation reached a terminal state.
+func (o Operation) Done() bool {
+	return o.State == OperationStateSucceeded || o.State == OperationStateFailed
+}
+
+// OperationError is returned by WaitForOperation when an operation fails.
+type OperationError struct {
+	Operation Operation
+}
+
+func (e *OperationError) Error() string {
+	if e.Operation.Error != "" {
+		return e.Operation.Error
+	}
+	return fmt.Sprintf("operation %s failed", e.Operation.ID)
+}
+
+// OperationFetcher returns the current status of the operation id.
+type OperationFetcher func(ctx context.Context, id string) (Operation, error)
+
+// WaitForOperationParams configures how WaitForOperation polls.
+type WaitForOperationParams struct {
+	// Interval is the delay between polls. It defaults to one second.
+	Interval time.Duration
+
+	// MaxInterval, if larger than Interval, doubles the delay after each
+	// poll up to MaxInterval.
+	MaxInterval time.Duration
+
+	// MaxAttempts limits the number of polls. Zero polls until ctx is done.
+	MaxAttempts int
+
+	// Delay, if set, returns how long to wait before each poll, counting
+	// from 1, including the first. It replaces Interval and MaxInterval.
+	Delay func(attempt int) time.Duration
+}
+
+// WaitForOperation polls the operation id using fetch until it succeeds or
+// fails and returns its final status. An *OperationError is returned if the
+// operation failed. An error is also returned if it is still running after
+// MaxAttempts polls or ctx is done.
+func (api *API) WaitForOperation(ctx context.Context, id string, fetch OperationFetcher, params WaitForOperationParams) (Operation, error) {
+	interval := params.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var op Operation
+	for attempt := 1; ; attempt++ {
+		if params.Delay != nil {
+			if err := waitForOperationDelay(ctx, id, params.Delay(attempt)); err != nil {
+				return op, err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return op, fmt.Errorf("waiting for operation %s: %w", id, err)
+		}
+
+		var err error
+		op, err = fetch(ctx, id)
+		if err != nil {
+			return op, err
+		}
+
+		switch op.State {
+		case OperationStateSucceeded:
+			return op, nil
+		case OperationStateFailed:
+			return op, &OperationError{Operation: op}
+		case OperationStatePending, OperationStateRunning:
+		default:
+			return op, fmt.Errorf("%s: %s", errOperationUnexpectedStatus, op.State)
+		}
+
+		if params.MaxAttempts > 0 && attempt >= params.MaxAttempts {
+			return op, errors.New(errOperationStillRunning)
+		}
+
+		if params.Delay != nil {
+			continue
+		}
+
+		if err := waitForOperationDelay(ctx, id, interval); err != nil {
+			return op, err
+		}
+
+		if params.MaxInterval > interval {
+			interval *= 2
+			if interval > params.MaxInterval {
+				interval = params.MaxInterval
+			}
+		}
+	}
+}
+
+func waitForOperationDelay(ctx context.Context, id string, delay time.Duration) error {
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for operation %s: %w", id, ctx.Err())
+	}
+}
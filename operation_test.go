@@ -0,0 +1,85 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForOperation(t *testing.T) {
+	states := []OperationState{OperationStatePending, OperationStateRunning, OperationStateSucceeded}
+	polls := 0
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		state := states[polls]
+		polls++
+		return Operation{ID: id, State: state}, nil
+	}
+
+	op, err := client.WaitForOperation(context.Background(), "op1", fetch, WaitForOperationParams{Interval: time.Millisecond})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Operation{ID: "op1", State: OperationStateSucceeded}, op)
+		assert.True(t, op.Done())
+		assert.Equal(t, 3, polls)
+	}
+}
+
+func TestWaitForOperationFailed(t *testing.T) {
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		return Operation{ID: id, State: OperationStateFailed, Error: "invalid item"}, nil
+	}
+
+	_, err := client.WaitForOperation(context.Background(), "op1", fetch, WaitForOperationParams{})
+	var opErr *OperationError
+	if assert.True(t, errors.As(err, &opErr)) {
+		assert.Equal(t, "invalid item", opErr.Error())
+		assert.Equal(t, "op1", opErr.Operation.ID)
+	}
+}
+
+func TestWaitForOperationMaxAttempts(t *testing.T) {
+	polls := 0
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		polls++
+		return Operation{ID: id, State: OperationStateRunning}, nil
+	}
+
+	op, err := client.WaitForOperation(context.Background(), "op1", fetch, WaitForOperationParams{
+		Interval:    time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+		MaxAttempts: 5,
+	})
+	assert.EqualError(t, err, errOperationStillRunning)
+	assert.False(t, op.Done())
+	assert.Equal(t, 5, polls)
+}
+
+func TestWaitForOperationContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		cancel()
+		return Operation{ID: id, State: OperationStatePending}, nil
+	}
+
+	_, err := client.WaitForOperation(ctx, "op1", fetch, WaitForOperationParams{Interval: time.Hour})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWaitForOperationDelay(t *testing.T) {
+	var attempts []int
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		return Operation{ID: id, State: OperationStateRunning}, nil
+	}
+
+	_, err := client.WaitForOperation(context.Background(), "op1", fetch, WaitForOperationParams{
+		MaxAttempts: 3,
+		Delay: func(attempt int) time.Duration {
+			attempts = append(attempts, attempt)
+			return time.Millisecond
+		},
+	})
+	assert.EqualError(t, err, errOperationStillRunning)
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+}
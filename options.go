@@ -55,10 +55,11 @@ func UsingRetryPolicy(maxRetries int, minRetryDelaySecs int, maxRetryDelaySecs i
 }
 
 // UsingLogger can be set if you want to get log output from this API instance
-// By default no log output is emitted.
+// By default no log output is emitted. Messages are formatted as by
+// UsingStructuredLogger, with the level and attributes inline.
 func UsingLogger(logger Logger) Option {
 	return func(api *API) error {
-		api.logger = logger
+		api.logger = printfLogger{logger: logger}
 		return nil
 	}
 }
@@ -82,6 +83,71 @@ func BaseURL(baseURL string) Option {
 	}
 }
 
+// UsingDeprecationHandler registers a handler that is called whenever the API
+// responds with deprecation or sunset headers for an endpoint. Use
+// DeprecationWarningLogger to log these as warnings.
+func UsingDeprecationHandler(handler DeprecationHandler) Option {
+	return func(api *API) error {
+		api.deprecationHandler = handler
+		return nil
+	}
+}
+
+// UsingWarningHandler registers a handler that is called for each message
+// included in a successful API response. These are otherwise discarded.
+func UsingWarningHandler(handler WarningHandler) Option {
+	return func(api *API) error {
+		api.warningHandler = handler
+		return nil
+	}
+}
+
+// UsingUserServiceKey sets the Origin CA key used to authenticate Origin CA
+// certificate requests, so that a client created with an API token or API
+// key can also manage Origin CA certificates.
+func UsingUserServiceKey(key string) Option {
+	return func(api *API) error {
+		api.APIUserServiceKey = key
+		return nil
+	}
+}
+
+// UsingRequestMiddleware registers middleware applied, in order, to every
+// request after its headers and authentication are set. It can be given
+// more than once.
+func UsingRequestMiddleware(middleware ...RequestMiddleware) Option {
+	return func(api *API) error {
+		api.requestMiddleware = append(api.requestMiddleware, middleware...)
+		return nil
+	}
+}
+
+// UsingTracer wraps each API call in a span started by tracer.
+func UsingTracer(tracer Tracer) Option {
+	return func(api *API) error {
+		api.tracer = tracer
+		return nil
+	}
+}
+
+// UsingMetrics reports metrics about each API call to recorder.
+func UsingMetrics(recorder MetricsRecorder) Option {
+	return func(api *API) error {
+		api.metrics = recorder
+		return nil
+	}
+}
+
+// UsingStructuredLogger logs each API call with its method, URI, status and
+// duration to logger, as well as retries. With Debug, the redacted requests
+// and responses are logged to it instead of the standard logger.
+func UsingStructuredLogger(logger StructuredLogger) Option {
+	return func(api *API) error {
+		api.logger = logger
+		return nil
+	}
+}
+
 func Debug(debug bool) Option {
 	return func(api *API) error {
 		api.Debug = debug
@@ -102,7 +102,7 @@ type originCACertificateResponseRevoke struct {
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca-create-certificate
 func (api *API) CreateOriginCACertificate(ctx context.Context, params CreateOriginCertificateParams) (*OriginCACertificate, error) {
-	res, err := api.makeRequestContext(ctx, http.MethodPost, "/certificates", params)
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodPost, "/certificates", params, api.originCAAuthType())
 	if err != nil {
 		return &OriginCACertificate{}, err
 	}
@@ -127,7 +127,7 @@ func (api *API) CreateOriginCACertificate(ctx context.Context, params CreateOrig
 // API reference: https://api.cloudflare.com/#cloudflare-ca-list-certificates
 func (api *API) ListOriginCACertificates(ctx context.Context, params ListOriginCertificatesParams) ([]OriginCACertificate, error) {
 	uri := buildURI("/certificates", params)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodGet, uri, nil, api.originCAAuthType())
 
 	if err != nil {
 		return nil, err
@@ -154,7 +154,7 @@ func (api *API) ListOriginCACertificates(ctx context.Context, params ListOriginC
 // API reference: https://api.cloudflare.com/#cloudflare-ca-certificate-details
 func (api *API) GetOriginCACertificate(ctx context.Context, certificateID string) (*OriginCACertificate, error) {
 	uri := fmt.Sprintf("/certificates/%s", certificateID)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodGet, uri, nil, api.originCAAuthType())
 
 	if err != nil {
 		return nil, err
@@ -180,7 +180,7 @@ func (api *API) GetOriginCACertificate(ctx context.Context, certificateID string
 // API reference: https://api.cloudflare.com/#cloudflare-ca-revoke-certificate
 func (api *API) RevokeOriginCACertificate(ctx context.Context, certificateID string) (*OriginCACertificateID, error) {
 	uri := fmt.Sprintf("/certificates/%s", certificateID)
-	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodDelete, uri, nil, api.originCAAuthType())
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,180 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// Key types of Origin CA certificates, used as their request type.
+const (
+	OriginCAKeyTypeRSA = "origin-rsa"
+	OriginCAKeyTypeECC = "origin-ecc"
+)
+
+// originCAValidityDays are the validity periods Origin CA certificates can
+// be requested with.
+var originCAValidityDays = []int{7, 30, 90, 365, 730, 1095, 5475}
+
+// ErrMissingOriginCAHostnames is returned when an Origin CA certificate is
+// requested without hostnames.
+var ErrMissingOriginCAHostnames = errors.New("at least one hostname is required for an Origin CA certificate")
+
+// OriginCAKeyPair is an issued Origin CA certificate with the private key
+// generated for it.
+type OriginCAKeyPair struct {
+	Certificate OriginCACertificate
+
+	// PrivateKey is the PEM encoded private key of the certificate. It is
+	// only known locally and cannot be retrieved from the API later.
+	PrivateKey string
+}
+
+// String implements fmt.Stringer, redacting the private key.
+func (p OriginCAKeyPair) String() string {
+	return redactedString(p.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the private key.
+func (p OriginCAKeyPair) GoString() string {
+	return redactedGoString("OriginCAKeyPair", p.redacted())
+}
+
+func (p OriginCAKeyPair) redacted() interface{} {
+	type redacted OriginCAKeyPair
+	r := redacted(p)
+	r.PrivateKey = redact(r.PrivateKey)
+	return r
+}
+
+// IssueOriginCACertificateParams configures the certificate issued by
+// IssueOriginCACertificate.
+type IssueOriginCACertificateParams struct {
+	Hostnames []string
+
+	// KeyType is OriginCAKeyTypeRSA (2048 bit RSA) or OriginCAKeyTypeECC
+	// (P-256 ECDSA). It defaults to OriginCAKeyTypeRSA.
+	KeyType string
+
+	// ValidityDays is one of 7, 30, 90, 365, 730, 1095 or 5475, the
+	// default.
+	ValidityDays int
+}
+
+// GenerateOriginCACSR generates a private key of the given key type and a
+// certificate signing request for hostnames, both PEM encoded.
+func GenerateOriginCACSR(keyType string, hostnames []string) (csrPEM, keyPEM []byte, err error) {
+	if len(hostnames) == 0 {
+		return nil, nil, ErrMissingOriginCAHostnames
+	}
+
+	var key crypto.Signer
+	switch keyType {
+	case OriginCAKeyTypeRSA, "":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case OriginCAKeyTypeECC:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, nil, fmt.Errorf("invalid Origin CA key type %q", keyType)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating private key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding private key: %w", err)
+	}
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: hostnames[0]},
+		DNSNames: hostnames,
+	}, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating certificate signing request: %w", err)
+	}
+	csrPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+
+	return csrPEM, keyPEM, nil
+}
+
+// IssueOriginCACertificate generates a private key and certificate signing
+// request locally and has Cloudflare's Origin CA sign it, returning the
+// certificate with its private key. The private key never leaves the
+// process. The Origin CA root certificate for the chain is available from
+// GetOriginCARootCertificate.
+//
+// API reference: https://developers.cloudflare.com/api/operations/origin-ca-create-certificate
+func (api *API) IssueOriginCACertificate(ctx context.Context, params IssueOriginCACertificateParams) (OriginCAKeyPair, error) {
+	keyType := params.KeyType
+	if keyType == "" {
+		keyType = OriginCAKeyTypeRSA
+	}
+
+	validity := params.ValidityDays
+	if validity == 0 {
+		validity = 5475
+	}
+	if !containsInt(originCAValidityDays, validity) {
+		return OriginCAKeyPair{}, fmt.Errorf("invalid Origin CA certificate validity of %d days", validity)
+	}
+
+	csr, key, err := GenerateOriginCACSR(keyType, params.Hostnames)
+	if err != nil {
+		return OriginCAKeyPair{}, err
+	}
+
+	cert, err := api.CreateOriginCACertificate(ctx, CreateOriginCertificateParams{
+		Hostnames:       params.Hostnames,
+		RequestType:     keyType,
+		RequestValidity: validity,
+		CSR:             string(csr),
+	})
+	if err != nil {
+		return OriginCAKeyPair{}, err
+	}
+
+	return OriginCAKeyPair{Certificate: *cert, PrivateKey: string(key)}, nil
+}
+
+// RotateOriginCACertificate issues a replacement for an Origin CA
+// certificate with a new private key and then revokes the old certificate.
+// Hostnames, key type and validity default to those of the old
+// certificate. The old certificate is only revoked once the replacement is
+// issued; if revoking fails, the replacement is returned with the error.
+func (api *API) RotateOriginCACertificate(ctx context.Context, certificateID string, params IssueOriginCACertificateParams) (OriginCAKeyPair, error) {
+	old, err := api.GetOriginCACertificate(ctx, certificateID)
+	if err != nil {
+		return OriginCAKeyPair{}, err
+	}
+
+	if len(params.Hostnames) == 0 {
+		params.Hostnames = old.Hostnames
+	}
+	if params.KeyType == "" {
+		params.KeyType = old.RequestType
+	}
+	if params.ValidityDays == 0 {
+		params.ValidityDays = old.RequestValidity
+	}
+
+	pair, err := api.IssueOriginCACertificate(ctx, params)
+	if err != nil {
+		return OriginCAKeyPair{}, err
+	}
+
+	if _, err := api.RevokeOriginCACertificate(ctx, certificateID); err != nil {
+		return pair, fmt.Errorf("revoking Origin CA certificate %s: %w", certificateID, err)
+	}
+
+	return pair, nil
+}
@@ -0,0 +1,111 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOriginCACSR(t *testing.T) {
+	csrPEM, keyPEM, err := GenerateOriginCACSR(OriginCAKeyTypeECC, []string{"example.com", "*.example.com"})
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	assert.NoError(t, csr.CheckSignature())
+	assert.Equal(t, "example.com", csr.Subject.CommonName)
+	assert.Equal(t, []string{"example.com", "*.example.com"}, csr.DNSNames)
+
+	block, _ = pem.Decode(keyPEM)
+	require.NotNil(t, block)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PrivateKey{}, key)
+
+	_, _, err = GenerateOriginCACSR(OriginCAKeyTypeRSA, nil)
+	assert.Equal(t, ErrMissingOriginCAHostnames, err)
+
+	_, _, err = GenerateOriginCACSR("origin-dsa", []string{"example.com"})
+	assert.Error(t, err)
+}
+
+func TestRotateOriginCACertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates/0x47530d8f561faa08", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "0x47530d8f561faa08",
+					"hostnames": ["example.com"],
+					"expires_on": "2014-01-01T05:20:00Z",
+					"request_type": "origin-ecc",
+					"requested_validity": 90
+				}
+			}`)
+		case http.MethodDelete:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "0x47530d8f561faa08"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		var params CreateOriginCertificateParams
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		assert.Equal(t, []string{"example.com"}, params.Hostnames)
+		assert.Equal(t, OriginCAKeyTypeECC, params.RequestType)
+		assert.Equal(t, 90, params.RequestValidity)
+		block, _ := pem.Decode([]byte(params.CSR))
+		if assert.NotNil(t, block) {
+			assert.Equal(t, "CERTIFICATE REQUEST", block.Type)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "0x5a8f2c1e9b3d7f40",
+				"certificate": "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+				"hostnames": ["example.com"],
+				"expires_on": "2014-04-01T05:20:00Z",
+				"request_type": "origin-ecc",
+				"requested_validity": 90
+			}
+		}`)
+	})
+
+	pair, err := client.RotateOriginCACertificate(context.Background(), "0x47530d8f561faa08", IssueOriginCACertificateParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "0x5a8f2c1e9b3d7f40", pair.Certificate.ID)
+	assert.Contains(t, pair.PrivateKey, "BEGIN PRIVATE KEY")
+	assert.NotContains(t, fmt.Sprintf("%+v", pair), "BEGIN PRIVATE KEY")
+}
+
+func TestIssueOriginCACertificateInvalidValidity(t *testing.T) {
+	_, err := client.IssueOriginCACertificate(context.Background(), IssueOriginCACertificateParams{
+		Hostnames:    []string{"example.com"},
+		ValidityDays: 60,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+)
+
+const originMaxHTTPVersionSetting = "origin_max_http_version"
+
+// Values of the origin_max_http_version zone setting.
+const (
+	OriginMaxHTTPVersion1 = "1"
+	OriginMaxHTTPVersion2 = "2"
+)
+
+// GetOriginMaxHTTPVersion returns the highest HTTP version Cloudflare uses
+// to connect to the zone's origins, OriginMaxHTTPVersion1 or
+// OriginMaxHTTPVersion2.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-cache-settings-get-origin-max-http-version-setting
+func (api *API) GetOriginMaxHTTPVersion(ctx context.Context, rc *ResourceContainer) (string, error) {
+	setting, err := api.GetZoneSetting(ctx, rc, GetZoneSettingParams{Name: originMaxHTTPVersionSetting})
+	if err != nil {
+		return "", err
+	}
+
+	version, _ := setting.Value.(string)
+	return version, nil
+}
+
+// UpdateOriginMaxHTTPVersion sets the highest HTTP version Cloudflare uses to
+// connect to the zone's origins. HTTP/2 to origin requires the origin to
+// support HTTP/2 over TLS.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-cache-settings-change-origin-max-http-version-setting
+func (api *API) UpdateOriginMaxHTTPVersion(ctx context.Context, rc *ResourceContainer, version string) (string, error) {
+	if version != OriginMaxHTTPVersion1 && version != OriginMaxHTTPVersion2 {
+		return "", fmt.Errorf("invalid origin max HTTP version %q, must be %s or %s", version, OriginMaxHTTPVersion1, OriginMaxHTTPVersion2)
+	}
+
+	setting, err := api.UpdateZoneSetting(ctx, rc, UpdateZoneSettingParams{Name: originMaxHTTPVersionSetting, Value: version})
+	if err != nil {
+		return "", err
+	}
+
+	updated, _ := setting.Value.(string)
+	return updated, nil
+}
@@ -0,0 +1,32 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateOriginMaxHTTPVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/origin_max_http_version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"value": "2"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "origin_max_http_version", "value": "2", "editable": true}}`)
+	})
+
+	actual, err := client.UpdateOriginMaxHTTPVersion(context.Background(), ZoneIdentifier(testZoneID), OriginMaxHTTPVersion2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, OriginMaxHTTPVersion2, actual)
+	}
+
+	_, err = client.UpdateOriginMaxHTTPVersion(context.Background(), ZoneIdentifier(testZoneID), "3")
+	assert.EqualError(t, err, `invalid origin max HTTP version "3", must be 1 or 2`)
+}
@@ -0,0 +1,33 @@
+module github.com/cloudflare/cloudflare-go/otel
+
+go 1.20
+
+require (
+	github.com/cloudflare/cloudflare-go v0.109.0
+	github.com/stretchr/testify v1.9.0
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/sdk v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/goccy/go-json v0.10.3 // indirect
+	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.opentelemetry.io/otel/metric v1.24.0 // indirect
+	golang.org/x/net v0.30.0 // indirect
+	golang.org/x/sys v0.26.0 // indirect
+	golang.org/x/text v0.19.0 // indirect
+	golang.org/x/time v0.7.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The tracer hooks this module uses are first released in cloudflare-go
+// v0.109.0. Until then, and to test against the current tree, the parent
+// directory is used instead. The replace has no effect on modules that
+// depend on this one, which need v0.109.0 or later.
+replace github.com/cloudflare/cloudflare-go => ../
@@ -0,0 +1,76 @@
+// Package otel traces Cloudflare API calls with OpenTelemetry.
+//
+// It is a separate module so that the cloudflare package does not depend on
+// OpenTelemetry, and requires cloudflare-go v0.109.0 or later:
+//
+//	api, err := cloudflare.New(key, email, otel.WithTracerProvider(provider))
+package otel
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer spans are started from.
+const instrumentationName = "github.com/cloudflare/cloudflare-go/otel"
+
+// WithTracerProvider returns a client option wrapping each API call,
+// including its retries, in a client span started from a tracer of
+// provider.
+func WithTracerProvider(provider trace.TracerProvider) cloudflare.Option {
+	return cloudflare.UsingTracer(NewTracer(provider))
+}
+
+// NewTracer returns a cloudflare.Tracer starting spans from a tracer of
+// provider, for use with cloudflare.UsingTracer.
+//
+// Spans are named after the method and path template of the call, such as
+// "GET /zones/{id}/dns_records", and follow the OpenTelemetry semantic
+// conventions for HTTP clients. The Cloudflare ray ID of the last response
+// is recorded as the cloudflare.ray_id attribute.
+func NewTracer(provider trace.TracerProvider) cloudflare.Tracer {
+	return &tracer{tracer: provider.Tracer(instrumentationName)}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) Start(ctx context.Context, request cloudflare.TraceRequest) (context.Context, cloudflare.TraceSpan) {
+	ctx, s := t.tracer.Start(ctx, request.Method+" "+request.PathTemplate,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", request.Method),
+			attribute.String("url.template", request.PathTemplate),
+		),
+	)
+
+	return ctx, &span{span: s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) End(result cloudflare.TraceResult) {
+	if result.StatusCode != 0 {
+		s.span.SetAttributes(attribute.Int("http.response.status_code", result.StatusCode))
+	}
+	if result.Retries > 0 {
+		s.span.SetAttributes(attribute.Int("http.request.resend_count", result.Retries))
+	}
+	if result.RayID != "" {
+		s.span.SetAttributes(attribute.String("cloudflare.ray_id", result.RayID))
+	}
+
+	if result.Err != nil {
+		s.span.RecordError(result.Err)
+		s.span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	s.span.End()
+}
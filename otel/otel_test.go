@@ -0,0 +1,62 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("cf-ray", "8d2c5e1d8b3a1f2e-LHR")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353"}}`)
+	})
+	mux.HandleFunc("/zones/c5fd0d3a2d8d5ff8e0d0f6c3e4bd4a4b", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 1001, "message": "not found"}], "messages": [], "result": null}`)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	api, err := cloudflare.New("deadbeef", "cloudflare@example.org", WithTracerProvider(provider), cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+
+	_, err = api.ZoneDetails(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353")
+	require.NoError(t, err)
+	_, err = api.ZoneDetails(context.Background(), "c5fd0d3a2d8d5ff8e0d0f6c3e4bd4a4b")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	ok := spans[0]
+	assert.Equal(t, "GET /zones/{id}", ok.Name())
+	assert.Equal(t, trace.SpanKindClient, ok.SpanKind())
+	assert.Equal(t, codes.Unset, ok.Status().Code)
+	assert.Contains(t, ok.Attributes(), attribute.String("http.request.method", http.MethodGet))
+	assert.Contains(t, ok.Attributes(), attribute.String("url.template", "/zones/{id}"))
+	assert.Contains(t, ok.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Contains(t, ok.Attributes(), attribute.String("cloudflare.ray_id", "8d2c5e1d8b3a1f2e-LHR"))
+
+	failed := spans[1]
+	assert.Equal(t, codes.Error, failed.Status().Code)
+	assert.Contains(t, failed.Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+}
@@ -10,18 +10,18 @@ import (
 
 // ListPageShieldConnectionsParams represents parameters for a page shield connection request.
 type ListPageShieldConnectionsParams struct {
-	Direction           string `url:"direction"`
+	Direction           string `url:"direction,omitempty"`
 	ExcludeCdnCgi       *bool  `url:"exclude_cdn_cgi,omitempty"`
-	ExcludeUrls         string `url:"exclude_urls"`
-	Export              string `url:"export"`
-	Hosts               string `url:"hosts"`
-	OrderBy             string `url:"order_by"`
-	Page                string `url:"page"`
-	PageURL             string `url:"page_url"`
-	PerPage             int    `url:"per_page"`
+	ExcludeUrls         string `url:"exclude_urls,omitempty"`
+	Export              string `url:"export,omitempty"`
+	Hosts               string `url:"hosts,omitempty"`
+	OrderBy             string `url:"order_by,omitempty"`
+	Page                string `url:"page,omitempty"`
+	PageURL             string `url:"page_url,omitempty"`
+	PerPage             int    `url:"per_page,omitempty"`
 	PrioritizeMalicious *bool  `url:"prioritize_malicious,omitempty"`
-	Status              string `url:"status"`
-	URLs                string `url:"urls"`
+	Status              string `url:"status,omitempty"`
+	URLs                string `url:"urls,omitempty"`
 }
 
 // PageShieldConnection represents a page shield connection.
@@ -45,6 +45,12 @@ type ListPageShieldConnectionsResponse struct {
 	ResultInfo `json:"result_info"`
 }
 
+// PageShieldConnectionResponse represents the response from the get page shield connection endpoint.
+type PageShieldConnectionResponse struct {
+	Result PageShieldConnection `json:"result"`
+	Response
+}
+
 // ListPageShieldConnections lists all page shield connections for a zone.
 //
 // API documentation: https://developers.cloudflare.com/api/operations/page-shield-list-page-shield-connections
@@ -78,11 +84,11 @@ func (api *API) GetPageShieldConnection(ctx context.Context, rc *ResourceContain
 		return nil, err
 	}
 
-	var psResponse PageShieldConnection
+	var psResponse PageShieldConnectionResponse
 	err = json.Unmarshal(res, &psResponse)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 
-	return &psResponse, nil
+	return &psResponse.Result, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -70,6 +71,35 @@ func TestListPageShieldConnections(t *testing.T) {
 	assert.Equal(t, mockPageShieldConnections, result)
 }
 
+func TestListPageShieldConnectionsFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/connections", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, url.Values{
+			"hosts":                []string{"blog.cloudflare.com"},
+			"prioritize_malicious": []string{"true"},
+			"per_page":             []string{"50"},
+		}, r.URL.Query())
+		w.Header().Set("content-type", "application/json")
+		response := ListPageShieldConnectionsResponse{
+			Result: mockPageShieldConnections[:1],
+		}
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	result, _, err := client.ListPageShieldConnections(context.Background(), ZoneIdentifier(testZoneID), ListPageShieldConnectionsParams{
+		Hosts:               "blog.cloudflare.com",
+		PrioritizeMalicious: BoolPtr(true),
+		PerPage:             50,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, mockPageShieldConnections[:1], result)
+}
+
 func TestGetPageShieldConnection(t *testing.T) {
 	setup()
 	defer teardown()
@@ -78,7 +108,9 @@ func TestGetPageShieldConnection(t *testing.T) {
 	mux.HandleFunc(fmt.Sprintf("/zones/"+testZoneID+"/page_shield/connections/%s", connectionID), func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
 		w.Header().Set("content-type", "application/json")
-		response := mockPageShieldConnections[0] // Assuming it's the first mock connection
+		response := PageShieldConnectionResponse{
+			Result: mockPageShieldConnections[0],
+		}
 		err := json.NewEncoder(w).Encode(response)
 		if err != nil {
 			t.Fatal(err)
@@ -8,6 +8,13 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// Actions of Page Shield policies. Allow enforces the policy's
+// Content-Security-Policy, log only reports violations.
+const (
+	PageShieldPolicyActionAllow = "allow"
+	PageShieldPolicyActionLog   = "log"
+)
+
 // PageShieldPolicy represents a page shield policy.
 type PageShieldPolicy struct {
 	Action      string `json:"action"`
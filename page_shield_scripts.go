@@ -29,19 +29,19 @@ type PageShieldScript struct {
 //
 // API reference: https://developers.cloudflare.com/api/operations/page-shield-list-page-shield-scripts#Query-Parameters
 type ListPageShieldScriptsParams struct {
-	Direction           string `url:"direction"`
+	Direction           string `url:"direction,omitempty"`
 	ExcludeCdnCgi       *bool  `url:"exclude_cdn_cgi,omitempty"`
 	ExcludeDuplicates   *bool  `url:"exclude_duplicates,omitempty"`
-	ExcludeUrls         string `url:"exclude_urls"`
-	Export              string `url:"export"`
-	Hosts               string `url:"hosts"`
-	OrderBy             string `url:"order_by"`
-	Page                string `url:"page"`
-	PageURL             string `url:"page_url"`
-	PerPage             int    `url:"per_page"`
+	ExcludeUrls         string `url:"exclude_urls,omitempty"`
+	Export              string `url:"export,omitempty"`
+	Hosts               string `url:"hosts,omitempty"`
+	OrderBy             string `url:"order_by,omitempty"`
+	Page                string `url:"page,omitempty"`
+	PageURL             string `url:"page_url,omitempty"`
+	PerPage             int    `url:"per_page,omitempty"`
 	PrioritizeMalicious *bool  `url:"prioritize_malicious,omitempty"`
-	Status              string `url:"status"`
-	URLs                string `url:"urls"`
+	Status              string `url:"status,omitempty"`
+	URLs                string `url:"urls,omitempty"`
 }
 
 // PageShieldScriptsResponse represents the response from the PageShield Script API.
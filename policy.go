@@ -1,8 +1,32 @@
 package cloudflare
 
+// Access granted by a member policy, also used as the Effect of an API token
+// policy.
+const (
+	PolicyAccessAllow = "allow"
+	PolicyAccessDeny  = "deny"
+)
+
+// Policy grants or denies the permissions of its permission groups on the
+// resources of its resource groups.
 type Policy struct {
 	ID               string            `json:"id"`
 	PermissionGroups []PermissionGroup `json:"permission_groups"`
 	ResourceGroups   []ResourceGroup   `json:"resource_groups"`
 	Access           string            `json:"access"`
 }
+
+// NewPolicy builds a member policy with the given access that applies the
+// permission groups with permissionGroupIDs to resourceGroups.
+func NewPolicy(access string, permissionGroupIDs []string, resourceGroups ...ResourceGroup) Policy {
+	permissionGroups := make([]PermissionGroup, 0, len(permissionGroupIDs))
+	for _, id := range permissionGroupIDs {
+		permissionGroups = append(permissionGroups, PermissionGroup{ID: id})
+	}
+
+	return Policy{
+		PermissionGroups: permissionGroups,
+		ResourceGroups:   resourceGroups,
+		Access:           access,
+	}
+}
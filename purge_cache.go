@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// purgeCacheChunkSize is the number of files, tags, hosts or prefixes
+	// accepted by a single purge request.
+	purgeCacheChunkSize = 30
+
+	// purgeCacheConcurrency is the number of purge requests PurgeCacheChunked
+	// sends at a time.
+	purgeCacheConcurrency = 4
+)
+
+// PurgeCacheChunkError is the failure of one of the requests sent by
+// PurgeCacheChunked.
+type PurgeCacheChunkError struct {
+	Request PurgeCacheRequest
+	Err     error
+}
+
+func (e PurgeCacheChunkError) Error() string {
+	return e.Err.Error()
+}
+
+func (e PurgeCacheChunkError) Unwrap() error {
+	return e.Err
+}
+
+// PurgeCacheErrors holds the failed requests of PurgeCacheChunked. The files,
+// tags, hosts and prefixes of the failed requests can be retried with
+// another call.
+type PurgeCacheErrors []PurgeCacheChunkError
+
+func (e PurgeCacheErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d of the purge requests failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// PurgeCacheChunked purges any number of files, tags, hosts and prefixes by
+// splitting them into requests within the per-request limit, sending them
+// concurrently. Everything is purged with PurgeEverything instead.
+//
+// The responses of the successful requests are returned. If any request
+// fails, a PurgeCacheErrors is returned with the failed requests.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-purge
+func (api *API) PurgeCacheChunked(ctx context.Context, zoneID string, pcr PurgeCacheRequest) ([]PurgeCacheResponse, error) {
+	if zoneID == "" {
+		return []PurgeCacheResponse{}, ErrMissingZoneID
+	}
+
+	if pcr.Everything {
+		return []PurgeCacheResponse{}, errors.New("use PurgeEverything to purge everything")
+	}
+
+	var requests []PurgeCacheRequest
+	for _, files := range chunkStrings(pcr.Files, purgeCacheChunkSize) {
+		requests = append(requests, PurgeCacheRequest{Files: files})
+	}
+	for _, tags := range chunkStrings(pcr.Tags, purgeCacheChunkSize) {
+		requests = append(requests, PurgeCacheRequest{Tags: tags})
+	}
+	for _, hosts := range chunkStrings(pcr.Hosts, purgeCacheChunkSize) {
+		requests = append(requests, PurgeCacheRequest{Hosts: hosts})
+	}
+	for _, prefixes := range chunkStrings(pcr.Prefixes, purgeCacheChunkSize) {
+		requests = append(requests, PurgeCacheRequest{Prefixes: prefixes})
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		sem       = make(chan struct{}, purgeCacheConcurrency)
+		responses = make([]PurgeCacheResponse, 0, len(requests))
+		errs      PurgeCacheErrors
+	)
+
+	for _, req := range requests {
+		req := req
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			res, err := api.PurgeCacheContext(ctx, zoneID, req)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, PurgeCacheChunkError{Request: req, Err: err})
+				return
+			}
+			responses = append(responses, res)
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return responses, errs
+	}
+
+	return responses, nil
+}
+
+// chunkStrings splits s into slices of at most size elements.
+func chunkStrings(s []string, size int) [][]string {
+	var chunks [][]string
+	for len(s) > size {
+		chunks = append(chunks, s[:size:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
@@ -0,0 +1,70 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgeCacheChunked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var (
+		mu    sync.Mutex
+		files []string
+		tags  []string
+	)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		var req PurgeCacheRequest
+		assert.NoError(t, json.Unmarshal(b, &req))
+		assert.LessOrEqual(t, len(req.Files)+len(req.Tags), 30)
+
+		w.Header().Set("content-type", "application/json")
+		if len(req.Tags) > 0 && req.Tags[0] == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1012, "message": "Request must contain one of \"purge_everything\", \"files\", \"tags\", \"hosts\" or \"prefixes\""}], "messages": [], "result": null}`)
+			return
+		}
+
+		mu.Lock()
+		files = append(files, req.Files...)
+		tags = append(tags, req.Tags...)
+		mu.Unlock()
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testZoneID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/purge_cache", handler)
+
+	pcr := PurgeCacheRequest{Tags: []string{"a", "b"}}
+	for i := 0; i < 75; i++ {
+		pcr.Files = append(pcr.Files, fmt.Sprintf("https://example.com/%d", i))
+	}
+
+	responses, err := client.PurgeCacheChunked(context.Background(), testZoneID, pcr)
+	if assert.NoError(t, err) {
+		assert.Len(t, responses, 4)
+		assert.ElementsMatch(t, pcr.Files, files)
+		assert.ElementsMatch(t, pcr.Tags, tags)
+	}
+
+	responses, err = client.PurgeCacheChunked(context.Background(), testZoneID, PurgeCacheRequest{
+		Files: []string{"https://example.com/"},
+		Tags:  []string{"bad"},
+	})
+	assert.Len(t, responses, 1)
+	var purgeErrs PurgeCacheErrors
+	if assert.True(t, errors.As(err, &purgeErrs)) && assert.Len(t, purgeErrs, 1) {
+		assert.Equal(t, []string{"bad"}, purgeErrs[0].Request.Tags)
+	}
+}
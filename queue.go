@@ -46,6 +46,39 @@ type QueueConsumerSettings struct {
 	BatchSize   int `json:"batch_size,omitempty"`
 	MaxRetires  int `json:"max_retries,omitempty"`
 	MaxWaitTime int `json:"max_wait_time_ms,omitempty"`
+
+	// MaxConcurrency caps the number of concurrent consumer invocations. A
+	// nil value lets the consumer autoscale.
+	MaxConcurrency *int `json:"max_concurrency,omitempty"`
+
+	// RetryDelay is the number of seconds retried messages are delayed by.
+	RetryDelay int `json:"retry_delay,omitempty"`
+}
+
+// Validate checks the settings are not negative. Their upper limits depend
+// on the plan and are left for the API to check.
+func (s QueueConsumerSettings) Validate() error {
+	if s.BatchSize < 0 {
+		return fmt.Errorf("invalid queue consumer batch size %d", s.BatchSize)
+	}
+
+	if s.MaxRetires < 0 {
+		return fmt.Errorf("invalid queue consumer max retries %d", s.MaxRetires)
+	}
+
+	if s.MaxWaitTime < 0 {
+		return fmt.Errorf("invalid queue consumer max wait time %dms", s.MaxWaitTime)
+	}
+
+	if s.MaxConcurrency != nil && *s.MaxConcurrency < 0 {
+		return fmt.Errorf("invalid queue consumer max concurrency %d", *s.MaxConcurrency)
+	}
+
+	if s.RetryDelay < 0 {
+		return fmt.Errorf("invalid queue consumer retry delay %ds", s.RetryDelay)
+	}
+
+	return nil
 }
 
 type QueueListResponse struct {
@@ -308,6 +341,10 @@ func (api *API) CreateQueueConsumer(ctx context.Context, rc *ResourceContainer,
 		return QueueConsumer{}, ErrMissingQueueName
 	}
 
+	if err := params.Consumer.Settings.Validate(); err != nil {
+		return QueueConsumer{}, err
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/workers/queues/%s/consumers", rc.Identifier, params.QueueName)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params.Consumer)
 	if err != nil {
@@ -363,6 +400,10 @@ func (api *API) UpdateQueueConsumer(ctx context.Context, rc *ResourceContainer,
 		return QueueConsumer{}, ErrMissingQueueConsumerName
 	}
 
+	if err := params.Consumer.Settings.Validate(); err != nil {
+		return QueueConsumer{}, err
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/workers/queues/%s/consumers/%s", rc.Identifier, params.QueueName, params.Consumer.Name)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params.Consumer)
 	if err != nil {
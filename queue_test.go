@@ -483,3 +483,27 @@ func TestQueue_UpdateConsumer(t *testing.T) {
 		assert.Equal(t, testQueueConsumer(), result)
 	}
 }
+
+func TestQueueConsumerSettings_Validate(t *testing.T) {
+	settings := QueueConsumerSettings{
+		BatchSize:      50,
+		MaxRetires:     5,
+		MaxWaitTime:    10000,
+		MaxConcurrency: IntPtr(10),
+		RetryDelay:     300,
+	}
+	assert.NoError(t, settings.Validate())
+
+	settings.BatchSize = 500
+	settings.RetryDelay = 86400
+	assert.NoError(t, settings.Validate())
+
+	settings.MaxConcurrency = IntPtr(-1)
+	assert.EqualError(t, settings.Validate(), "invalid queue consumer max concurrency -1")
+
+	_, err := client.UpdateQueueConsumer(context.Background(), AccountIdentifier(testAccountID), UpdateQueueConsumerParams{QueueName: testQueueName, Consumer: QueueConsumer{
+		Name:     testQueueConsumerName,
+		Settings: QueueConsumerSettings{BatchSize: -1},
+	}})
+	assert.EqualError(t, err, "invalid queue consumer batch size -1")
+}
@@ -0,0 +1,357 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingRadarDomain = errors.New("required Radar domain missing")
+
+// RadarParams are the filters shared by most Radar endpoints. Name,
+// DateRange, DateStart and DateEnd are positional: the nth value of each
+// describes the nth series returned, so that several series, such as a
+// period and its control period, can be compared in a single request.
+type RadarParams struct {
+	Name []string `url:"name,omitempty"`
+
+	// DateRange is a relative period such as "1d", "7d" or "28dControl".
+	DateRange []string    `url:"dateRange,omitempty"`
+	DateStart []time.Time `url:"dateStart,omitempty"`
+	DateEnd   []time.Time `url:"dateEnd,omitempty"`
+
+	// Location filters by alpha-2 country code, prefixed with "-" to
+	// exclude it.
+	Location  []string `url:"location,omitempty"`
+	ASN       []string `url:"asn,omitempty"`
+	Continent []string `url:"continent,omitempty"`
+
+	// AggInterval is the width of each timeseries point: "15m", "1h",
+	// "1d" or "1w".
+	AggInterval string `url:"aggInterval,omitempty"`
+	Limit       int    `url:"limit,omitempty"`
+}
+
+// RadarDNSTopParams filters the top locations or ASes querying a domain.
+type RadarDNSTopParams struct {
+	Domain []string `url:"domain"`
+	RadarParams
+}
+
+// RadarRankingParams filters the domain ranking.
+type RadarRankingParams struct {
+	Name     []string `url:"name,omitempty"`
+	Location []string `url:"location,omitempty"`
+
+	// Date is the day of the ranking, formatted as 2006-01-02. The latest
+	// ranking is returned if empty.
+	Date  []string `url:"date,omitempty"`
+	Limit int      `url:"limit,omitempty"`
+}
+
+// RadarBGPHijackEventsParams filters BGP hijack events.
+type RadarBGPHijackEventsParams struct {
+	InvolvedASN   int        `url:"involvedAsn,omitempty"`
+	HijackerASN   int        `url:"hijackerAsn,omitempty"`
+	VictimASN     int        `url:"victimAsn,omitempty"`
+	Prefix        string     `url:"prefix,omitempty"`
+	MinConfidence int        `url:"minConfidence,omitempty"`
+	MaxConfidence int        `url:"maxConfidence,omitempty"`
+	DateRange     string     `url:"dateRange,omitempty"`
+	DateStart     *time.Time `url:"dateStart,omitempty"`
+	DateEnd       *time.Time `url:"dateEnd,omitempty"`
+
+	PaginationOptions
+}
+
+// RadarDateRange is the period covered by a series.
+type RadarDateRange struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// RadarMeta describes the data returned by a Radar endpoint.
+type RadarMeta struct {
+	DateRange     []RadarDateRange `json:"dateRange"`
+	LastUpdated   string           `json:"lastUpdated"`
+	AggInterval   string           `json:"aggInterval"`
+	Normalization string           `json:"normalization"`
+}
+
+// RadarTimeseries is a series of values over time. Values are returned as
+// decimal strings and are usually normalized rather than absolute.
+type RadarTimeseries struct {
+	Timestamps []time.Time `json:"timestamps"`
+	Values     []string    `json:"values"`
+}
+
+// Float64s returns the values of the series parsed as numbers.
+func (s RadarTimeseries) Float64s() ([]float64, error) {
+	values := make([]float64, len(s.Values))
+	for i, v := range s.Values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing Radar value %q: %w", v, err)
+		}
+		values[i] = f
+	}
+	return values, nil
+}
+
+// RadarTimeseriesResult holds the series requested, keyed by name.
+type RadarTimeseriesResult struct {
+	Series map[string]RadarTimeseries
+	Meta   RadarMeta
+}
+
+// RadarSummaryResult holds the summaries requested, keyed by name. Each
+// summary maps a dimension value, such as a protocol, to its share as a
+// decimal string percentage.
+type RadarSummaryResult struct {
+	Summaries map[string]map[string]string
+	Meta      RadarMeta
+}
+
+// RadarTopLocation is a location ranked by its share of traffic.
+type RadarTopLocation struct {
+	Alpha2 string `json:"clientCountryAlpha2"`
+	Name   string `json:"clientCountryName"`
+	Value  string `json:"value"`
+}
+
+// RadarTopAS is an autonomous system ranked by its share of traffic.
+type RadarTopAS struct {
+	ASN   int    `json:"clientASN"`
+	Name  string `json:"clientASName"`
+	Value string `json:"value"`
+}
+
+// RadarDomainCategory is a content category of a ranked domain.
+type RadarDomainCategory struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	SuperCategoryID int    `json:"superCategoryId"`
+}
+
+// RadarDomainRank is a domain and its position in the ranking.
+type RadarDomainRank struct {
+	Rank       int                   `json:"rank"`
+	Domain     string                `json:"domain"`
+	Categories []RadarDomainCategory `json:"categories"`
+}
+
+// RadarBGPHijackEvent is a detected BGP origin hijack.
+type RadarBGPHijackEvent struct {
+	ID              int        `json:"id"`
+	ConfidenceScore int        `json:"confidence_score"`
+	EventType       int        `json:"event_type"`
+	HijackerASN     int        `json:"hijacker_asn"`
+	HijackerCountry string     `json:"hijacker_country"`
+	VictimASNs      []int      `json:"victim_asns"`
+	VictimCountries []string   `json:"victim_countries"`
+	Prefixes        []string   `json:"prefixes"`
+	Duration        int        `json:"duration"`
+	MessagesCount   int        `json:"hijack_msgs_count"`
+	IsStale         bool       `json:"is_stale"`
+	DetectedAt      *time.Time `json:"detected_ts"`
+	MinHijackAt     *time.Time `json:"min_hijack_ts"`
+	MaxHijackAt     *time.Time `json:"max_hijack_ts"`
+}
+
+type radarResponse struct {
+	Response
+	Result     json.RawMessage `json:"result"`
+	ResultInfo ResultInfo      `json:"result_info"`
+}
+
+func (api *API) getRadar(ctx context.Context, path string, params interface{}) (radarResponse, error) {
+	res, err := api.makeRequestContext(ctx, http.MethodGet, buildURI("/radar"+path, params), nil)
+	if err != nil {
+		return radarResponse{}, err
+	}
+
+	var r radarResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return radarResponse{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
+
+// parseRadarSeries splits a Radar result into its meta and the series named
+// by every other key.
+func parseRadarSeries[T any](result json.RawMessage) (map[string]T, RadarMeta, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(result, &fields); err != nil {
+		return nil, RadarMeta{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	var meta RadarMeta
+	series := make(map[string]T, len(fields))
+	for name, raw := range fields {
+		if name == "meta" {
+			if err := json.Unmarshal(raw, &meta); err != nil {
+				return nil, RadarMeta{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+			}
+			continue
+		}
+
+		var s T
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, RadarMeta{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+		series[name] = s
+	}
+
+	return series, meta, nil
+}
+
+func (api *API) getRadarTimeseries(ctx context.Context, path string, params interface{}) (RadarTimeseriesResult, error) {
+	r, err := api.getRadar(ctx, path, params)
+	if err != nil {
+		return RadarTimeseriesResult{}, err
+	}
+
+	series, meta, err := parseRadarSeries[RadarTimeseries](r.Result)
+	if err != nil {
+		return RadarTimeseriesResult{}, err
+	}
+
+	return RadarTimeseriesResult{Series: series, Meta: meta}, nil
+}
+
+func (api *API) getRadarSummary(ctx context.Context, path string, params interface{}) (RadarSummaryResult, error) {
+	r, err := api.getRadar(ctx, path, params)
+	if err != nil {
+		return RadarSummaryResult{}, err
+	}
+
+	summaries, meta, err := parseRadarSeries[map[string]string](r.Result)
+	if err != nil {
+		return RadarSummaryResult{}, err
+	}
+
+	return RadarSummaryResult{Summaries: summaries, Meta: meta}, nil
+}
+
+// GetRadarHTTPTimeseries returns the HTTP request traffic trend.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-http-timeseries
+func (api *API) GetRadarHTTPTimeseries(ctx context.Context, params RadarParams) (RadarTimeseriesResult, error) {
+	return api.getRadarTimeseries(ctx, "/http/timeseries", params)
+}
+
+// GetRadarAttacksLayer3Timeseries returns the network layer DDoS attack
+// trend.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-attacks-layer3-timeseries-by-bytes
+func (api *API) GetRadarAttacksLayer3Timeseries(ctx context.Context, params RadarParams) (RadarTimeseriesResult, error) {
+	return api.getRadarTimeseries(ctx, "/attacks/layer3/timeseries", params)
+}
+
+// GetRadarAttacksLayer7Timeseries returns the application layer attack
+// trend.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-attacks-layer7-timeseries
+func (api *API) GetRadarAttacksLayer7Timeseries(ctx context.Context, params RadarParams) (RadarTimeseriesResult, error) {
+	return api.getRadarTimeseries(ctx, "/attacks/layer7/timeseries", params)
+}
+
+// GetRadarAttacksLayer3Summary returns the share of network layer DDoS
+// attacks by protocol.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-attacks-layer3-summary
+func (api *API) GetRadarAttacksLayer3Summary(ctx context.Context, params RadarParams) (RadarSummaryResult, error) {
+	return api.getRadarSummary(ctx, "/attacks/layer3/summary", params)
+}
+
+// GetRadarAttacksLayer7Summary returns the share of application layer
+// attacks by mitigation product.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-attacks-layer7-summary
+func (api *API) GetRadarAttacksLayer7Summary(ctx context.Context, params RadarParams) (RadarSummaryResult, error) {
+	return api.getRadarSummary(ctx, "/attacks/layer7/summary", params)
+}
+
+// GetRadarBGPTimeseries returns the BGP update trend.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-bgp-timeseries
+func (api *API) GetRadarBGPTimeseries(ctx context.Context, params RadarParams) (RadarTimeseriesResult, error) {
+	return api.getRadarTimeseries(ctx, "/bgp/timeseries", params)
+}
+
+// ListRadarBGPHijackEvents returns detected BGP origin hijacks.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-bgp-hijacks-events
+func (api *API) ListRadarBGPHijackEvents(ctx context.Context, params RadarBGPHijackEventsParams) ([]RadarBGPHijackEvent, *ResultInfo, error) {
+	r, err := api.getRadar(ctx, "/bgp/hijacks/events", params)
+	if err != nil {
+		return []RadarBGPHijackEvent{}, &ResultInfo{}, err
+	}
+
+	var result struct {
+		Events []RadarBGPHijackEvent `json:"events"`
+	}
+	err = json.Unmarshal(r.Result, &result)
+	if err != nil {
+		return []RadarBGPHijackEvent{}, &ResultInfo{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Events, &r.ResultInfo, nil
+}
+
+// GetRadarDNSTopLocations returns the locations querying a domain the most
+// on 1.1.1.1, keyed by series name.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-dns-top-locations
+func (api *API) GetRadarDNSTopLocations(ctx context.Context, params RadarDNSTopParams) (map[string][]RadarTopLocation, error) {
+	if len(params.Domain) == 0 {
+		return nil, ErrMissingRadarDomain
+	}
+
+	r, err := api.getRadar(ctx, "/dns/top/locations", params)
+	if err != nil {
+		return nil, err
+	}
+
+	top, _, err := parseRadarSeries[[]RadarTopLocation](r.Result)
+	return top, err
+}
+
+// GetRadarDNSTopASes returns the autonomous systems querying a domain the
+// most on 1.1.1.1, keyed by series name.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-dns-top-ases
+func (api *API) GetRadarDNSTopASes(ctx context.Context, params RadarDNSTopParams) (map[string][]RadarTopAS, error) {
+	if len(params.Domain) == 0 {
+		return nil, ErrMissingRadarDomain
+	}
+
+	r, err := api.getRadar(ctx, "/dns/top/ases", params)
+	if err != nil {
+		return nil, err
+	}
+
+	top, _, err := parseRadarSeries[[]RadarTopAS](r.Result)
+	return top, err
+}
+
+// GetRadarRankingTopDomains returns the most popular domains, keyed by
+// series name.
+//
+// API reference: https://developers.cloudflare.com/api/operations/radar-get-ranking-top-domains
+func (api *API) GetRadarRankingTopDomains(ctx context.Context, params RadarRankingParams) (map[string][]RadarDomainRank, error) {
+	r, err := api.getRadar(ctx, "/ranking/top", params)
+	if err != nil {
+		return nil, err
+	}
+
+	top, _, err := parseRadarSeries[[]RadarDomainRank](r.Result)
+	return top, err
+}
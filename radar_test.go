@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRadarHTTPTimeseries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, []string{"main", "control"}, r.URL.Query()["name"])
+		assert.Equal(t, []string{"7d", "7dControl"}, r.URL.Query()["dateRange"])
+		assert.Equal(t, []string{"PT", "PT"}, r.URL.Query()["location"])
+		assert.Equal(t, "1d", r.URL.Query().Get("aggInterval"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"meta": {
+					"dateRange": [{"startTime": "2024-09-24T00:00:00Z", "endTime": "2024-10-01T00:00:00Z"}],
+					"aggInterval": "1d",
+					"lastUpdated": "2024-10-01T00:00:00Z"
+				},
+				"main": {"timestamps": ["2024-09-30T00:00:00Z"], "values": ["0.75"]},
+				"control": {"timestamps": ["2024-09-23T00:00:00Z"], "values": ["0.5"]}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/radar/http/timeseries", handler)
+
+	actual, err := client.GetRadarHTTPTimeseries(context.Background(), RadarParams{
+		Name:        []string{"main", "control"},
+		DateRange:   []string{"7d", "7dControl"},
+		Location:    []string{"PT", "PT"},
+		AggInterval: "1d",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1d", actual.Meta.AggInterval)
+	require.Len(t, actual.Series, 2)
+	assert.Equal(t, []time.Time{time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)}, actual.Series["main"].Timestamps)
+
+	values, err := actual.Series["control"].Float64s()
+	if assert.NoError(t, err) {
+		assert.Equal(t, []float64{0.5}, values)
+	}
+}
+
+func TestGetRadarAttacksLayer3Summary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"meta": {"dateRange": [{"startTime": "2024-09-24T00:00:00Z", "endTime": "2024-10-01T00:00:00Z"}]},
+				"summary_0": {"udp": "60.5", "tcp": "38.1", "icmp": "1.2", "gre": "0.2"}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/radar/attacks/layer3/summary", handler)
+
+	actual, err := client.GetRadarAttacksLayer3Summary(context.Background(), RadarParams{DateRange: []string{"7d"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "60.5", actual.Summaries["summary_0"]["udp"])
+		assert.Len(t, actual.Meta.DateRange, 1)
+	}
+}
+
+func TestGetRadarDNSTopLocations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "example.com", r.URL.Query().Get("domain"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"meta": {"dateRange": []},
+				"top_0": [{"clientCountryAlpha2": "US", "clientCountryName": "United States", "value": "42.1"}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/radar/dns/top/locations", handler)
+
+	actual, err := client.GetRadarDNSTopLocations(context.Background(), RadarDNSTopParams{Domain: []string{"example.com"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string][]RadarTopLocation{
+			"top_0": {{Alpha2: "US", Name: "United States", Value: "42.1"}},
+		}, actual)
+	}
+
+	_, err = client.GetRadarDNSTopLocations(context.Background(), RadarDNSTopParams{})
+	assert.Equal(t, ErrMissingRadarDomain, err)
+}
+
+func TestListRadarBGPHijackEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "13335", r.URL.Query().Get("involvedAsn"))
+		assert.Equal(t, "8", r.URL.Query().Get("minConfidence"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"events": [{
+					"id": 1234,
+					"confidence_score": 9,
+					"hijacker_asn": 64512,
+					"victim_asns": [13335],
+					"prefixes": ["1.1.1.0/24"],
+					"detected_ts": "2024-10-01T00:00:00Z"
+				}],
+				"total_monitors": 100
+			},
+			"result_info": {"count": 1, "page": 1, "per_page": 20, "total_count": 1}
+		}`)
+	}
+
+	mux.HandleFunc("/radar/bgp/hijacks/events", handler)
+
+	actual, info, err := client.ListRadarBGPHijackEvents(context.Background(), RadarBGPHijackEventsParams{
+		InvolvedASN:   13335,
+		MinConfidence: 8,
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, actual, 1)
+		assert.Equal(t, 64512, actual[0].HijackerASN)
+		assert.Equal(t, []string{"1.1.1.0/24"}, actual[0].Prefixes)
+		assert.Equal(t, 1, info.Total)
+	}
+}
+
+func TestGetRadarRankingTopDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"meta": {"top_0": {"date": "2024-09-30"}},
+				"top_0": [{"rank": 1, "domain": "google.com", "categories": [{"id": 81, "name": "Content Servers", "superCategoryId": 26}]}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/radar/ranking/top", handler)
+
+	actual, err := client.GetRadarRankingTopDomains(context.Background(), RadarRankingParams{Limit: 5})
+	if assert.NoError(t, err) {
+		require.Len(t, actual["top_0"], 1)
+		assert.Equal(t, "google.com", actual["top_0"][0].Domain)
+		assert.Equal(t, 26, actual["top_0"][0].Categories[0].SuperCategoryID)
+	}
+}
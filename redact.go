@@ -0,0 +1,35 @@
+package cloudflare
+
+import (
+	"fmt"
+	"strings"
+)
+
+// redactedValue replaces secrets when formatting structs containing them.
+const redactedValue = "REDACTED"
+
+// redact returns redactedValue in place of a non-empty secret, so that
+// formatted values still show whether the secret was set.
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redactedValue
+}
+
+// redactedString formats v, a copy of a struct with its secrets redacted, as
+// %+v does.
+func redactedString(v interface{}) string {
+	return fmt.Sprintf("%+v", v)
+}
+
+// redactedGoString formats v, a copy of a struct with its secrets redacted
+// converted to a local type without methods, as %#v does for the original
+// type name.
+func redactedGoString(name string, v interface{}) string {
+	s := fmt.Sprintf("%#v", v)
+	if i := strings.Index(s, "{"); i >= 0 {
+		return "cloudflare." + name + s[i:]
+	}
+	return s
+}
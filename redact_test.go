@@ -0,0 +1,42 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactedSecrets(t *testing.T) {
+	token := APIToken{ID: "ed17574386854bf78a67040be0a770b0", Name: "readonly", Value: "8M7wS6hCpXVc-DoRnPPY_UCWPgy8aea4Wy6kCe5T"}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		s := fmt.Sprintf(format, token)
+		assert.NotContains(t, s, token.Value, format)
+		assert.Contains(t, s, redactedValue, format)
+		assert.Contains(t, s, token.Name, format)
+	}
+	assert.Contains(t, fmt.Sprintf("%#v", token), "cloudflare.APIToken{")
+
+	response := APITokenResponse{Result: token}
+	assert.NotContains(t, fmt.Sprintf("%+v", response), token.Value)
+	assert.NotContains(t, fmt.Sprintf("%v", &token), token.Value)
+
+	b, err := json.Marshal(token)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), token.Value)
+	}
+
+	tsig := SecondaryDNSTSIG{Name: "tsig.customer.cf.", Secret: "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"}
+	assert.NotContains(t, tsig.String(), tsig.Secret)
+	assert.NotContains(t, tsig.GoString(), tsig.Secret)
+
+	origin := HyperdriveConfigOriginWithSecrets{
+		HyperdriveConfigOrigin: HyperdriveConfigOrigin{Host: "db.example.com"},
+		Password:               "hunter2",
+	}
+	assert.Equal(t, "{HyperdriveConfigOrigin:{Database: Host:db.example.com Port:0 Scheme: User: AccessClientID:} Password:REDACTED AccessClientSecret:}", origin.String())
+
+	assert.NotContains(t, fmt.Sprint(Tunnel{Secret: "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="}), "AQIDBAUG")
+}
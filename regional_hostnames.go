@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/goccy/go-json"
@@ -27,7 +28,28 @@ type regionalHostnameResponse struct {
 }
 
 type ListDataLocalizationRegionsParams struct{}
-type ListDataLocalizationRegionalHostnamesParams struct{}
+
+// RegionalHostnameOrder is a field regional hostnames can be ordered by.
+type RegionalHostnameOrder string
+
+const (
+	RegionalHostnameOrderHostname  RegionalHostnameOrder = "hostname"
+	RegionalHostnameOrderCreatedOn RegionalHostnameOrder = "created_on"
+)
+
+type ListDataLocalizationRegionalHostnamesParams struct {
+	// Order sorts the hostnames by a field, with Direction being either
+	// "asc" (default) or "desc". Ties are broken by hostname.
+	Order     RegionalHostnameOrder `url:"order,omitempty"`
+	Direction string                `url:"direction,omitempty"`
+
+	// CreatedAfter and CreatedBefore limit the hostnames to those created
+	// within the time range, inclusive.
+	CreatedAfter  *time.Time `url:"created_on_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_on_before,omitempty"`
+
+	ResultInfo
+}
 
 type CreateDataLocalizationRegionalHostnameParams struct {
 	Hostname  string `json:"hostname"`
@@ -67,6 +89,7 @@ func (api *API) ListDataLocalizationRegions(ctx context.Context, rc *ResourceCon
 }
 
 // ListDataLocalizationRegionalHostnames lists all regional hostnames for a zone.
+// All pages are fetched unless Page or PerPage is set.
 //
 // API reference: https://developers.cloudflare.com/data-localization/regional-services/get-started/#configure-regional-services-via-api
 func (api *API) ListDataLocalizationRegionalHostnames(ctx context.Context, rc *ResourceContainer, params ListDataLocalizationRegionalHostnamesParams) ([]RegionalHostname, error) {
@@ -78,19 +101,106 @@ func (api *API) ListDataLocalizationRegionalHostnames(ctx context.Context, rc *R
 		return []RegionalHostname{}, ErrMissingZoneID
 	}
 
-	uri := fmt.Sprintf("/zones/%s/addressing/regional_hostnames", rc.Identifier)
+	autoPaginate := true
+	if params.PerPage >= 1 || params.Page >= 1 {
+		autoPaginate = false
+	}
 
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return []RegionalHostname{}, err
+	if params.PerPage < 1 {
+		params.PerPage = 100
 	}
-	result := struct {
-		Result []RegionalHostname `json:"result"`
-	}{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return []RegionalHostname{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+
+	if params.Page < 1 {
+		params.Page = 1
 	}
-	return result.Result, nil
+
+	baseURL := fmt.Sprintf("/zones/%s/addressing/regional_hostnames", rc.Identifier)
+
+	var hostnames []RegionalHostname
+	seen := make(map[string]bool)
+	for {
+		uri := buildURI(baseURL, params)
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []RegionalHostname{}, err
+		}
+
+		var r struct {
+			Response
+			Result     []RegionalHostname `json:"result"`
+			ResultInfo ResultInfo         `json:"result_info"`
+		}
+		if err := json.Unmarshal(res, &r); err != nil {
+			return []RegionalHostname{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		}
+
+		// Hostnames created or deleted while paging can shift results
+		// between pages, so skip any already returned.
+		for _, h := range r.Result {
+			if seen[h.Hostname] || !regionalHostnameCreatedWithin(h, params.CreatedAfter, params.CreatedBefore) {
+				continue
+			}
+			seen[h.Hostname] = true
+			hostnames = append(hostnames, h)
+		}
+
+		if !autoPaginate || !r.ResultInfo.HasMorePages() {
+			break
+		}
+		params.ResultInfo = r.ResultInfo.Next()
+	}
+
+	if params.Order != "" {
+		sortRegionalHostnames(hostnames, params.Order, params.Direction == "desc")
+	}
+
+	if hostnames == nil {
+		return []RegionalHostname{}, nil
+	}
+
+	return hostnames, nil
+}
+
+func regionalHostnameCreatedWithin(h RegionalHostname, after, before *time.Time) bool {
+	if after == nil && before == nil {
+		return true
+	}
+
+	if h.CreatedOn == nil {
+		return false
+	}
+
+	if after != nil && h.CreatedOn.Before(*after) {
+		return false
+	}
+
+	return before == nil || !h.CreatedOn.After(*before)
+}
+
+// sortRegionalHostnames orders hostnames deterministically so that listings
+// are reproducible across calls regardless of how the API paged them.
+func sortRegionalHostnames(hostnames []RegionalHostname, order RegionalHostnameOrder, desc bool) {
+	sort.SliceStable(hostnames, func(i, j int) bool {
+		a, b := hostnames[i], hostnames[j]
+		if desc {
+			a, b = b, a
+		}
+
+		if order == RegionalHostnameOrderCreatedOn {
+			var at, bt time.Time
+			if a.CreatedOn != nil {
+				at = *a.CreatedOn
+			}
+			if b.CreatedOn != nil {
+				bt = *b.CreatedOn
+			}
+			if !at.Equal(bt) {
+				return at.Before(bt)
+			}
+		}
+
+		return a.Hostname < b.Hostname
+	})
 }
 
 // CreateDataLocalizationRegionalHostname lists all regional hostnames for a zone.
@@ -93,6 +93,51 @@ func TestListRegionalHostnames(t *testing.T) {
 	}
 }
 
+func TestListRegionalHostnames_OrderedAndPaginated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "created_on", r.URL.Query().Get("order"))
+		assert.Equal(t, "2023-01-01T00:00:00Z", r.URL.Query().Get("created_on_after"))
+		w.Header().Set("content-type", "application/json")
+
+		result := `
+			{"hostname": "b.example.com", "region_key": "ca", "created_on": "2023-01-14T00:00:00Z"},
+			{"hostname": "a.example.com", "region_key": "eu", "created_on": "2023-01-14T00:00:00Z"}`
+		if r.URL.Query().Get("page") == "2" {
+			result = `
+			{"hostname": "a.example.com", "region_key": "eu", "created_on": "2023-01-14T00:00:00Z"},
+			{"hostname": "c.example.com", "region_key": "us", "created_on": "2023-01-02T00:00:00Z"},
+			{"hostname": "old.example.com", "region_key": "us", "created_on": "2022-12-31T00:00:00Z"}`
+		}
+
+		fmt.Fprintf(w, `{
+		  "result": [%s],
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result_info": {"page": %s, "per_page": 100, "total_pages": 2}
+		}`, result, r.URL.Query().Get("page"))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/addressing/regional_hostnames", handler)
+
+	after := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	actual, err := client.ListDataLocalizationRegionalHostnames(context.Background(), ZoneIdentifier(testZoneID), ListDataLocalizationRegionalHostnamesParams{
+		Order:        RegionalHostnameOrderCreatedOn,
+		CreatedAfter: &after,
+	})
+	if assert.NoError(t, err) {
+		hostnames := make([]string, 0, len(actual))
+		for _, h := range actual {
+			hostnames = append(hostnames, h.Hostname)
+		}
+		assert.Equal(t, []string{"c.example.com", "a.example.com", "b.example.com"}, hostnames)
+	}
+}
+
 func TestCreateRegionalHostname(t *testing.T) {
 	setup()
 	defer teardown()
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,6 +10,10 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// ErrMissingRegistrarDomainName is for when a Registrar domain name is
+// needed but not given.
+var ErrMissingRegistrarDomainName = errors.New("required registrar domain name missing")
+
 // RegistrarDomain is the structure of the API response for a new
 // Cloudflare Registrar domain.
 type RegistrarDomain struct {
@@ -21,6 +26,8 @@ type RegistrarDomain struct {
 	ExpiresAt         time.Time           `json:"expires_at"`
 	RegistryStatuses  string              `json:"registry_statuses"`
 	Locked            bool                `json:"locked"`
+	AutoRenew         bool                `json:"auto_renew"`
+	Privacy           bool                `json:"privacy"`
 	CreatedAt         time.Time           `json:"created_at"`
 	UpdatedAt         time.Time           `json:"updated_at"`
 	RegistrantContact RegistrantContact   `json:"registrant_contact"`
@@ -174,3 +181,44 @@ func (api *API) UpdateRegistrarDomain(ctx context.Context, accountID, domainName
 	}
 	return r.Result, nil
 }
+
+// UpdateRegistrarDomainParams changes the settings of a Registrar domain.
+// Only the settings that are set are changed.
+type UpdateRegistrarDomainParams struct {
+	DomainName string `json:"-"`
+	AutoRenew  *bool  `json:"auto_renew,omitempty"`
+	Locked     *bool  `json:"locked,omitempty"`
+	Privacy    *bool  `json:"privacy,omitempty"`
+}
+
+// UpdateRegistrarDomainSettings changes the auto-renew, transfer lock and
+// WHOIS privacy settings of a Registrar domain. Unlike
+// UpdateRegistrarDomain, settings that are not set are left unchanged.
+//
+// API reference: https://developers.cloudflare.com/api/operations/registrar-domains-update-domain
+func (api *API) UpdateRegistrarDomainSettings(ctx context.Context, rc *ResourceContainer, params UpdateRegistrarDomainParams) (RegistrarDomain, error) {
+	if rc.Level != AccountRouteLevel {
+		return RegistrarDomain{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return RegistrarDomain{}, ErrMissingAccountID
+	}
+
+	if params.DomainName == "" {
+		return RegistrarDomain{}, ErrMissingRegistrarDomainName
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/registrar/domains/%s", rc.Identifier, params.DomainName)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return RegistrarDomain{}, err
+	}
+
+	var r RegistrarDomainDetailResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegistrarDomain{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
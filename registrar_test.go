@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -373,3 +374,44 @@ func TestUpdateRegistrarDomain(t *testing.T) {
 		assert.Equal(t, expectedRegistrarDomain, actual)
 	}
 }
+
+func TestUpdateRegistrarDomainSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"auto_renew": true}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ea95132c15732412d22c1476fa83f27a",
+				"locked": true,
+				"auto_renew": true,
+				"privacy": true
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/registrar/domains/cloudflare.com", handler)
+
+	actual, err := client.UpdateRegistrarDomainSettings(context.Background(), AccountIdentifier(testAccountID), UpdateRegistrarDomainParams{
+		DomainName: "cloudflare.com",
+		AutoRenew:  BoolPtr(true),
+	})
+
+	if assert.NoError(t, err) {
+		assert.True(t, actual.AutoRenew)
+		assert.True(t, actual.Locked)
+		assert.True(t, actual.Privacy)
+	}
+
+	_, err = client.UpdateRegistrarDomainSettings(context.Background(), AccountIdentifier(testAccountID), UpdateRegistrarDomainParams{})
+	assert.Equal(t, ErrMissingRegistrarDomainName, err)
+}
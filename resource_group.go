@@ -1,6 +1,15 @@
 package cloudflare
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingResourceGroupID = errors.New("missing required resource group ID")
 
 type ResourceGroup struct {
 	ID    string            `json:"id"`
@@ -51,3 +60,197 @@ func NewResourceGroup(key string) ResourceGroup {
 	}
 	return resourceGroup
 }
+
+// NewResourceGroupForZones returns a resource group scoped to a set of zones
+// within an account, for use in policies that delegate access to only those
+// zones.
+func NewResourceGroupForZones(accountID string, zoneIDs ...string) ResourceGroup {
+	objects := make([]ScopeObject, 0, len(zoneIDs))
+	for _, id := range zoneIDs {
+		objects = append(objects, ScopeObject{Key: fmt.Sprintf("com.cloudflare.api.account.zone.%s", id)})
+	}
+
+	return ResourceGroup{
+		Scope: Scope{
+			Key:          fmt.Sprintf("com.cloudflare.api.account.%s", accountID),
+			ScopeObjects: objects,
+		},
+	}
+}
+
+type ListResourceGroupsParams struct {
+	ID   string `url:"id,omitempty"`
+	Name string `url:"name,omitempty"`
+}
+
+type CreateResourceGroupParams struct {
+	Name  string            `json:"name,omitempty"`
+	Meta  map[string]string `json:"meta,omitempty"`
+	Scope Scope             `json:"scope"`
+}
+
+type UpdateResourceGroupParams struct {
+	ID    string            `json:"-"`
+	Name  string            `json:"name,omitempty"`
+	Meta  map[string]string `json:"meta,omitempty"`
+	Scope Scope             `json:"scope"`
+}
+
+type resourceGroupResponse struct {
+	Response
+	Result ResourceGroup `json:"result"`
+}
+
+type resourceGroupsResponse struct {
+	Response
+	Result []ResourceGroup `json:"result"`
+}
+
+// ListResourceGroups returns the resource groups of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-resource-group-list-resource-groups
+func (api *API) ListResourceGroups(ctx context.Context, rc *ResourceContainer, params ListResourceGroupsParams) ([]ResourceGroup, error) {
+	if rc.Level != AccountRouteLevel {
+		return []ResourceGroup{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []ResourceGroup{}, ErrMissingAccountID
+	}
+
+	uri := buildURI(fmt.Sprintf("/accounts/%s/iam/resource_groups", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []ResourceGroup{}, err
+	}
+
+	var r resourceGroupsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []ResourceGroup{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetResourceGroup returns a single resource group of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-resource-group-resource-group-details
+func (api *API) GetResourceGroup(ctx context.Context, rc *ResourceContainer, resourceGroupID string) (ResourceGroup, error) {
+	if rc.Level != AccountRouteLevel {
+		return ResourceGroup{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ResourceGroup{}, ErrMissingAccountID
+	}
+
+	if resourceGroupID == "" {
+		return ResourceGroup{}, ErrMissingResourceGroupID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/iam/resource_groups/%s", rc.Identifier, resourceGroupID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+
+	var r resourceGroupResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ResourceGroup{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateResourceGroup creates a resource group in an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-resource-group-create-resource-group
+func (api *API) CreateResourceGroup(ctx context.Context, rc *ResourceContainer, params CreateResourceGroupParams) (ResourceGroup, error) {
+	if rc.Level != AccountRouteLevel {
+		return ResourceGroup{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ResourceGroup{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/iam/resource_groups", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+
+	var r resourceGroupResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ResourceGroup{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateResourceGroupForZones creates a resource group granting access to a
+// set of zones in the account.
+func (api *API) CreateResourceGroupForZones(ctx context.Context, rc *ResourceContainer, name string, zoneIDs []string) (ResourceGroup, error) {
+	if len(zoneIDs) == 0 {
+		return ResourceGroup{}, ErrMissingZoneID
+	}
+
+	group := NewResourceGroupForZones(rc.Identifier, zoneIDs...)
+
+	return api.CreateResourceGroup(ctx, rc, CreateResourceGroupParams{
+		Name:  name,
+		Scope: group.Scope,
+	})
+}
+
+// UpdateResourceGroup updates a resource group in an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-resource-group-update-resource-group
+func (api *API) UpdateResourceGroup(ctx context.Context, rc *ResourceContainer, params UpdateResourceGroupParams) (ResourceGroup, error) {
+	if rc.Level != AccountRouteLevel {
+		return ResourceGroup{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ResourceGroup{}, ErrMissingAccountID
+	}
+
+	if params.ID == "" {
+		return ResourceGroup{}, ErrMissingResourceGroupID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/iam/resource_groups/%s", rc.Identifier, params.ID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+
+	var r resourceGroupResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ResourceGroup{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteResourceGroup deletes a resource group from an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-resource-group-remove-resource-group
+func (api *API) DeleteResourceGroup(ctx context.Context, rc *ResourceContainer, resourceGroupID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if resourceGroupID == "" {
+		return ErrMissingResourceGroupID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/iam/resource_groups/%s", rc.Identifier, resourceGroupID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	return err
+}
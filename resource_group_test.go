@@ -1,7 +1,10 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,3 +48,61 @@ func TestNewResourceGroupForZone(t *testing.T) {
 	assert.Equal(t, rg.Name, key)
 	assert.Equal(t, rg.Scope.Key, key)
 }
+
+func TestNewResourceGroupForZones(t *testing.T) {
+	rg := NewResourceGroupForZones(testAccountID, "zone-a", "zone-b")
+
+	assert.Equal(t, Scope{
+		Key: "com.cloudflare.api.account." + testAccountID,
+		ScopeObjects: []ScopeObject{
+			{Key: "com.cloudflare.api.account.zone.zone-a"},
+			{Key: "com.cloudflare.api.account.zone.zone-b"},
+		},
+	}, rg.Scope)
+}
+
+func TestCreateResourceGroupForZones(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"name": "delegated zones",
+			"scope": {
+				"key": "com.cloudflare.api.account.`+testAccountID+`",
+				"objects": [{"key": "com.cloudflare.api.account.zone.`+testZoneID+`"}]
+			}
+		}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "6d7f2f5f5b1d4a0e9081fdc98d432fd1",
+				"name": "delegated zones",
+				"meta": {"editable": "false"},
+				"scope": {
+					"key": "com.cloudflare.api.account.%s",
+					"objects": [{"key": "com.cloudflare.api.account.zone.%s"}]
+				}
+			}
+		}`, testAccountID, testZoneID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/iam/resource_groups", handler)
+
+	rg, err := client.CreateResourceGroupForZones(context.Background(), AccountIdentifier(testAccountID), "delegated zones", []string{testZoneID})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "6d7f2f5f5b1d4a0e9081fdc98d432fd1", rg.ID)
+		assert.Equal(t, NewResourceGroupForZones(testAccountID, testZoneID).Scope, rg.Scope)
+	}
+
+	_, err = client.CreateResourceGroupForZones(context.Background(), AccountIdentifier(testAccountID), "empty", nil)
+	assert.ErrorIs(t, err, ErrMissingZoneID)
+
+	_, err = client.GetResourceGroup(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingResourceGroupID)
+}
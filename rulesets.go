@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	ErrMissingRulesetPhase = errors.New("missing required phase")
+	ErrMissingRulesetPhase   = errors.New("missing required phase")
+	ErrMissingRulesetVersion = errors.New("missing required ruleset version")
 )
 
 const (
@@ -36,11 +37,14 @@ const (
 	RulesetPhaseHTTPRequestRedirect          RulesetPhase = "http_request_redirect"
 	RulesetPhaseHTTPRequestSanitize          RulesetPhase = "http_request_sanitize"
 	RulesetPhaseHTTPRequestSBFM              RulesetPhase = "http_request_sbfm"
+	RulesetPhaseHTTPRequestSnippets          RulesetPhase = "http_request_snippets"
 	RulesetPhaseHTTPRequestTransform         RulesetPhase = "http_request_transform"
 	RulesetPhaseHTTPResponseCompression      RulesetPhase = "http_response_compression"
 	RulesetPhaseHTTPResponseFirewallManaged  RulesetPhase = "http_response_firewall_managed"
 	RulesetPhaseHTTPResponseHeadersTransform RulesetPhase = "http_response_headers_transform"
 	RulesetPhaseMagicTransit                 RulesetPhase = "magic_transit"
+	RulesetPhaseMagicTransitIDSManaged       RulesetPhase = "magic_transit_ids_managed"
+	RulesetPhaseMagicTransitManaged          RulesetPhase = "magic_transit_managed"
 
 	RulesetRuleActionBlock                RulesetRuleAction = "block"
 	RulesetRuleActionChallenge            RulesetRuleAction = "challenge"
@@ -105,11 +109,14 @@ func RulesetPhaseValues() []string {
 		string(RulesetPhaseHTTPRequestRedirect),
 		string(RulesetPhaseHTTPRequestSanitize),
 		string(RulesetPhaseHTTPRequestSBFM),
+		string(RulesetPhaseHTTPRequestSnippets),
 		string(RulesetPhaseHTTPRequestTransform),
 		string(RulesetPhaseHTTPResponseCompression),
 		string(RulesetPhaseHTTPResponseFirewallManaged),
 		string(RulesetPhaseHTTPResponseHeadersTransform),
 		string(RulesetPhaseMagicTransit),
+		string(RulesetPhaseMagicTransitIDSManaged),
+		string(RulesetPhaseMagicTransitManaged),
 	}
 }
 
@@ -433,9 +440,10 @@ type RulesetRuleActionParametersOverrides struct {
 }
 
 type RulesetRuleActionParametersCategories struct {
-	Category string `json:"category"`
-	Action   string `json:"action,omitempty"`
-	Enabled  *bool  `json:"enabled,omitempty"`
+	Category         string `json:"category"`
+	Action           string `json:"action,omitempty"`
+	Enabled          *bool  `json:"enabled,omitempty"`
+	SensitivityLevel string `json:"sensitivity_level,omitempty"`
 }
 
 type RulesetRuleActionParametersRules struct {
@@ -913,3 +921,56 @@ func (api *API) UpdateEntrypointRuleset(ctx context.Context, rc *ResourceContain
 
 	return result.Result, nil
 }
+
+// ListEntrypointRulesetVersions returns the versions of an entry point ruleset
+// for the phase. Rules are not included in the returned rulesets; use
+// GetEntrypointRulesetVersion to fetch the rules of a version.
+//
+// API reference: https://developers.cloudflare.com/api/operations/listAccountEntrypointRulesetVersions
+// API reference: https://developers.cloudflare.com/api/operations/listZoneEntrypointRulesetVersions
+func (api *API) ListEntrypointRulesetVersions(ctx context.Context, rc *ResourceContainer, phase string) ([]Ruleset, error) {
+	if phase == "" {
+		return []Ruleset{}, ErrMissingRulesetPhase
+	}
+
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint/versions", rc.Level, rc.Identifier, phase)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Ruleset{}, err
+	}
+
+	result := ListRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []Ruleset{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
+// GetEntrypointRulesetVersion returns a single version of an entry point
+// ruleset for the phase.
+//
+// API reference: https://developers.cloudflare.com/api/operations/getAccountEntrypointRulesetVersion
+// API reference: https://developers.cloudflare.com/api/operations/getZoneEntrypointRulesetVersion
+func (api *API) GetEntrypointRulesetVersion(ctx context.Context, rc *ResourceContainer, phase, version string) (Ruleset, error) {
+	if phase == "" {
+		return Ruleset{}, ErrMissingRulesetPhase
+	}
+
+	if version == "" {
+		return Ruleset{}, ErrMissingRulesetVersion
+	}
+
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint/versions/%s", rc.Level, rc.Identifier, phase, version)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	result := GetRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return Ruleset{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
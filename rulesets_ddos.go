@@ -0,0 +1,54 @@
+package cloudflare
+
+import "context"
+
+// DDoSL7ManagedRulesetID is the ID of the HTTP DDoS Attack Protection
+// managed ruleset, deployed in the ddos_l7 phase of every zone.
+const DDoSL7ManagedRulesetID = "4d21379b4f9f4bb088e0729962c8b3cf"
+
+// Sensitivity levels of DDoS rules and tags. Lower sensitivities require
+// more traffic before a rule triggers; DDoSSensitivityEssentiallyOff
+// only mitigates the largest attacks.
+const (
+	DDoSSensitivityDefault        = "default"
+	DDoSSensitivityMedium         = "medium"
+	DDoSSensitivityLow            = "low"
+	DDoSSensitivityEssentiallyOff = "eoff"
+)
+
+// UpdateDDoSL7OverridesParams configures the overrides of the HTTP DDoS
+// Attack Protection managed ruleset. Categories of the overrides match
+// ruleset tags and Rules its individual rules; each can set an Action,
+// such as "log" or "managed_challenge", and a SensitivityLevel.
+type UpdateDDoSL7OverridesParams struct {
+	// Overrides are the desired overrides, merged with the current ones as
+	// described by UpdateManagedRulesetOverridesParams.
+	Overrides RulesetRuleActionParametersOverrides
+
+	// Base is the overrides Overrides was derived from, typically the result
+	// of GetDDoSL7Overrides. When nil, nothing is removed.
+	Base *RulesetRuleActionParametersOverrides
+}
+
+// GetDDoSL7Overrides returns the overrides of the HTTP DDoS Attack
+// Protection managed ruleset. Empty overrides are returned if the
+// defaults are in use.
+//
+// API reference: https://developers.cloudflare.com/ddos-protection/managed-rulesets/http/http-overrides/configure-api/
+func (api *API) GetDDoSL7Overrides(ctx context.Context, rc *ResourceContainer) (RulesetRuleActionParametersOverrides, error) {
+	return api.getPhaseManagedRulesetOverrides(ctx, rc, RulesetPhaseDDoSL7, DDoSL7ManagedRulesetID)
+}
+
+// UpdateDDoSL7Overrides applies overrides to the HTTP DDoS Attack
+// Protection managed ruleset in the ddos_l7 entry point ruleset, merging
+// them with the current overrides so that those of other rules and tags are
+// preserved. The resulting overrides are returned.
+//
+// API reference: https://developers.cloudflare.com/ddos-protection/managed-rulesets/http/http-overrides/configure-api/
+func (api *API) UpdateDDoSL7Overrides(ctx context.Context, rc *ResourceContainer, params UpdateDDoSL7OverridesParams) (RulesetRuleActionParametersOverrides, error) {
+	return api.updatePhaseManagedRulesetOverrides(ctx, rc, RulesetPhaseDDoSL7, UpdateManagedRulesetOverridesParams{
+		RulesetID: DDoSL7ManagedRulesetID,
+		Overrides: params.Overrides,
+		Base:      params.Base,
+	})
+}
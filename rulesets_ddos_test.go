@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDDoSL7Overrides(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the ddos_l7 phase"}], "messages": [], "result": null}`)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			var body UpdateEntrypointRulesetParams
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 1) {
+				assert.Equal(t, "execute", body.Rules[0].Action)
+				assert.Equal(t, "true", body.Rules[0].Expression)
+				assert.Equal(t, DDoSL7ManagedRulesetID, body.Rules[0].ActionParameters.ID)
+				assert.Equal(t, &RulesetRuleActionParametersOverrides{
+					SensitivityLevel: DDoSSensitivityMedium,
+					Categories: []RulesetRuleActionParametersCategories{
+						{Category: "http-flood", Action: "log"},
+					},
+					Rules: []RulesetRuleActionParametersRules{
+						{ID: "fdfdac75430c4c47a959592f0aa5e68a", SensitivityLevel: DDoSSensitivityLow},
+					},
+				}, body.Rules[0].ActionParameters.Overrides)
+			}
+
+			result, _ := json.Marshal(body.Rules)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "entrypoint", "phase": "ddos_l7", "rules": %s}}`, result)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/ddos_l7/entrypoint", handler)
+
+	overrides, err := client.UpdateDDoSL7Overrides(context.Background(), ZoneIdentifier(testZoneID), UpdateDDoSL7OverridesParams{
+		Overrides: RulesetRuleActionParametersOverrides{
+			SensitivityLevel: DDoSSensitivityMedium,
+			Categories: []RulesetRuleActionParametersCategories{
+				{Category: "http-flood", Action: "log"},
+			},
+			Rules: []RulesetRuleActionParametersRules{
+				{ID: "fdfdac75430c4c47a959592f0aa5e68a", SensitivityLevel: DDoSSensitivityLow},
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, DDoSSensitivityMedium, overrides.SensitivityLevel)
+		assert.Len(t, overrides.Rules, 1)
+	}
+}
@@ -0,0 +1,404 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RulesetExpressionFieldType is the type of a field in the Rules language.
+type RulesetExpressionFieldType int
+
+const (
+	RulesetExpressionFieldString RulesetExpressionFieldType = iota
+	RulesetExpressionFieldInt
+	RulesetExpressionFieldIP
+	RulesetExpressionFieldBool
+)
+
+func (t RulesetExpressionFieldType) String() string {
+	switch t {
+	case RulesetExpressionFieldString:
+		return "string"
+	case RulesetExpressionFieldInt:
+		return "integer"
+	case RulesetExpressionFieldIP:
+		return "IP address"
+	case RulesetExpressionFieldBool:
+		return "boolean"
+	}
+	return "unknown"
+}
+
+// rulesetExpressionFields are the commonly used fields of the Rules language.
+// Fields not listed here can be used with RulesetFieldOfType.
+//
+// Reference: https://developers.cloudflare.com/ruleset-engine/rules-language/fields/
+var rulesetExpressionFields = map[string]RulesetExpressionFieldType{
+	"http.cookie":                       RulesetExpressionFieldString,
+	"http.host":                         RulesetExpressionFieldString,
+	"http.referer":                      RulesetExpressionFieldString,
+	"http.request.full_uri":             RulesetExpressionFieldString,
+	"http.request.method":               RulesetExpressionFieldString,
+	"http.request.uri":                  RulesetExpressionFieldString,
+	"http.request.uri.path":             RulesetExpressionFieldString,
+	"http.request.uri.path.extension":   RulesetExpressionFieldString,
+	"http.request.uri.query":            RulesetExpressionFieldString,
+	"http.request.version":              RulesetExpressionFieldString,
+	"http.user_agent":                   RulesetExpressionFieldString,
+	"http.x_forwarded_for":              RulesetExpressionFieldString,
+	"raw.http.request.full_uri":         RulesetExpressionFieldString,
+	"raw.http.request.uri":              RulesetExpressionFieldString,
+	"raw.http.request.uri.path":         RulesetExpressionFieldString,
+	"raw.http.request.uri.query":        RulesetExpressionFieldString,
+	"ip.src.city":                       RulesetExpressionFieldString,
+	"ip.src.continent":                  RulesetExpressionFieldString,
+	"ip.src.country":                    RulesetExpressionFieldString,
+	"ip.src.postal_code":                RulesetExpressionFieldString,
+	"ip.src.region_code":                RulesetExpressionFieldString,
+	"ip.geoip.continent":                RulesetExpressionFieldString,
+	"ip.geoip.country":                  RulesetExpressionFieldString,
+	"cf.bot_management.ja3_hash":        RulesetExpressionFieldString,
+	"cf.bot_management.ja4":             RulesetExpressionFieldString,
+	"cf.ray_id":                         RulesetExpressionFieldString,
+	"ip.src.asnum":                      RulesetExpressionFieldInt,
+	"ip.geoip.asnum":                    RulesetExpressionFieldInt,
+	"cf.bot_management.score":           RulesetExpressionFieldInt,
+	"cf.edge.server_port":               RulesetExpressionFieldInt,
+	"cf.threat_score":                   RulesetExpressionFieldInt,
+	"cf.waf.score":                      RulesetExpressionFieldInt,
+	"http.response.code":                RulesetExpressionFieldInt,
+	"ip.src":                            RulesetExpressionFieldIP,
+	"cf.edge.server_ip":                 RulesetExpressionFieldIP,
+	"ssl":                               RulesetExpressionFieldBool,
+	"cf.bot_management.static_resource": RulesetExpressionFieldBool,
+	"cf.bot_management.verified_bot":    RulesetExpressionFieldBool,
+	"cf.client.bot":                     RulesetExpressionFieldBool,
+	"cf.tls_client_auth.cert_verified":  RulesetExpressionFieldBool,
+	"http.request.headers.truncated":    RulesetExpressionFieldBool,
+	"ip.src.is_in_european_union":       RulesetExpressionFieldBool,
+}
+
+var (
+	rulesetExpressionFieldNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z0-9_]+)*(\["[^"]*"\])?(\[(\d+|\*)\])?$`)
+	rulesetExpressionListNameRegex  = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// RulesetExpression is a Rules language expression built from fields and
+// combinators. Errors found while building are carried along and reported
+// by Build, so expressions can be composed without checking each step.
+//
+//	expr, err := cloudflare.RulesetAnd(
+//		cloudflare.RulesetField("http.request.uri.path").StartsWith("/api/"),
+//		cloudflare.RulesetNot(cloudflare.RulesetField("ip.src").InList("office_ips")),
+//	).Build()
+//	// starts_with(http.request.uri.path, "/api/") and not ip.src in $office_ips
+type RulesetExpression struct {
+	expr     string
+	compound bool
+	err      error
+}
+
+// Build returns the expression string or the first error found while
+// constructing it.
+func (e RulesetExpression) Build() (string, error) {
+	if e.err != nil {
+		return "", e.err
+	}
+	if e.expr == "" {
+		return "", errors.New("empty expression")
+	}
+	return e.expr, nil
+}
+
+// String returns the expression string, or an empty string if it is invalid.
+func (e RulesetExpression) String() string {
+	if e.err != nil {
+		return ""
+	}
+	return e.expr
+}
+
+// RulesetExpressionField is a field of the Rules language that comparisons
+// are made against.
+type RulesetExpressionField struct {
+	name string
+	typ  RulesetExpressionFieldType
+	err  error
+}
+
+// RulesetField returns a known field of the Rules language. Using a field the
+// builder does not know about results in an error; use RulesetFieldOfType for
+// those.
+func RulesetField(name string) RulesetExpressionField {
+	typ, ok := rulesetExpressionFields[name]
+	if !ok {
+		return RulesetExpressionField{name: name, err: fmt.Errorf("unknown field %q", name)}
+	}
+	return RulesetExpressionField{name: name, typ: typ}
+}
+
+// RulesetFieldOfType returns a field of the given type, such as
+// `http.request.headers["x-api-key"][0]`, that RulesetField does not know.
+func RulesetFieldOfType(name string, typ RulesetExpressionFieldType) RulesetExpressionField {
+	if !rulesetExpressionFieldNameRegex.MatchString(name) {
+		return RulesetExpressionField{name: name, typ: typ, err: fmt.Errorf("invalid field name %q", name)}
+	}
+	return RulesetExpressionField{name: name, typ: typ}
+}
+
+// Eq matches when the field equals value. Boolean fields render as the bare
+// field, or its negation for false.
+func (f RulesetExpressionField) Eq(value interface{}) RulesetExpression {
+	if f.typ == RulesetExpressionFieldBool {
+		b, ok := value.(bool)
+		if !ok {
+			return f.invalid("eq", value)
+		}
+		if b {
+			return f.IsTrue()
+		}
+		return RulesetNot(f.IsTrue())
+	}
+	return f.compare("eq", value)
+}
+
+// Ne matches when the field does not equal value.
+func (f RulesetExpressionField) Ne(value interface{}) RulesetExpression {
+	if f.typ == RulesetExpressionFieldBool {
+		b, ok := value.(bool)
+		if !ok {
+			return f.invalid("ne", value)
+		}
+		return f.Eq(!b)
+	}
+	return f.compare("ne", value)
+}
+
+// Lt matches when an integer field is less than value.
+func (f RulesetExpressionField) Lt(value int) RulesetExpression {
+	return f.ordered("lt", value)
+}
+
+// Le matches when an integer field is less than or equal to value.
+func (f RulesetExpressionField) Le(value int) RulesetExpression {
+	return f.ordered("le", value)
+}
+
+// Gt matches when an integer field is greater than value.
+func (f RulesetExpressionField) Gt(value int) RulesetExpression {
+	return f.ordered("gt", value)
+}
+
+// Ge matches when an integer field is greater than or equal to value.
+func (f RulesetExpressionField) Ge(value int) RulesetExpression {
+	return f.ordered("ge", value)
+}
+
+// Contains matches when a string field contains value.
+func (f RulesetExpressionField) Contains(value string) RulesetExpression {
+	if err := f.require("contains", RulesetExpressionFieldString); err != nil {
+		return RulesetExpression{err: err}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s contains %s", f.name, quoteRulesetString(value))}
+}
+
+// Matches matches when a string field matches the regular expression
+// pattern. The pattern is validated with the RE2 syntax used by the Rules
+// language.
+func (f RulesetExpressionField) Matches(pattern string) RulesetExpression {
+	if err := f.require("matches", RulesetExpressionFieldString); err != nil {
+		return RulesetExpression{err: err}
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return RulesetExpression{err: fmt.Errorf("%s matches: invalid regular expression: %w", f.name, err)}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s matches %s", f.name, quoteRulesetString(pattern))}
+}
+
+// StartsWith matches when a string field starts with prefix.
+func (f RulesetExpressionField) StartsWith(prefix string) RulesetExpression {
+	return f.function("starts_with", prefix)
+}
+
+// EndsWith matches when a string field ends with suffix.
+func (f RulesetExpressionField) EndsWith(suffix string) RulesetExpression {
+	return f.function("ends_with", suffix)
+}
+
+// In matches when the field equals one of values. IP fields accept addresses
+// and CIDR ranges.
+func (f RulesetExpressionField) In(values ...interface{}) RulesetExpression {
+	if f.err != nil {
+		return RulesetExpression{err: f.err}
+	}
+	if f.typ == RulesetExpressionFieldBool {
+		return RulesetExpression{err: fmt.Errorf("%s in: not supported for %s fields", f.name, f.typ)}
+	}
+	if len(values) == 0 {
+		return RulesetExpression{err: fmt.Errorf("%s in: at least one value is required", f.name)}
+	}
+
+	formatted := make([]string, 0, len(values))
+	for _, v := range values {
+		s, err := f.formatValue(v, true)
+		if err != nil {
+			return RulesetExpression{err: fmt.Errorf("%s in: %w", f.name, err)}
+		}
+		formatted = append(formatted, s)
+	}
+
+	return RulesetExpression{expr: fmt.Sprintf("%s in {%s}", f.name, strings.Join(formatted, " "))}
+}
+
+// InList matches when the field is in the named account list, referenced in
+// the expression as $name.
+func (f RulesetExpressionField) InList(name string) RulesetExpression {
+	if f.err != nil {
+		return RulesetExpression{err: f.err}
+	}
+	if f.typ == RulesetExpressionFieldBool {
+		return RulesetExpression{err: fmt.Errorf("%s in: not supported for %s fields", f.name, f.typ)}
+	}
+	name = strings.TrimPrefix(name, "$")
+	if !rulesetExpressionListNameRegex.MatchString(name) {
+		return RulesetExpression{err: fmt.Errorf("%s in: invalid list name %q", f.name, name)}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s in $%s", f.name, name)}
+}
+
+// IsTrue matches when a boolean field is true.
+func (f RulesetExpressionField) IsTrue() RulesetExpression {
+	if err := f.require("", RulesetExpressionFieldBool); err != nil {
+		return RulesetExpression{err: err}
+	}
+	return RulesetExpression{expr: f.name}
+}
+
+// RulesetAnd matches when all of the expressions match.
+func RulesetAnd(exprs ...RulesetExpression) RulesetExpression {
+	return joinRulesetExpressions("and", exprs)
+}
+
+// RulesetOr matches when any of the expressions match.
+func RulesetOr(exprs ...RulesetExpression) RulesetExpression {
+	return joinRulesetExpressions("or", exprs)
+}
+
+// RulesetNot matches when the expression does not match.
+func RulesetNot(expr RulesetExpression) RulesetExpression {
+	if expr.err != nil {
+		return expr
+	}
+	if expr.compound {
+		return RulesetExpression{expr: fmt.Sprintf("not (%s)", expr.expr)}
+	}
+	return RulesetExpression{expr: "not " + expr.expr}
+}
+
+func joinRulesetExpressions(operator string, exprs []RulesetExpression) RulesetExpression {
+	if len(exprs) == 0 {
+		return RulesetExpression{err: fmt.Errorf("%s: at least one expression is required", operator)}
+	}
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+
+	parts := make([]string, 0, len(exprs))
+	for _, e := range exprs {
+		if e.err != nil {
+			return e
+		}
+		if e.compound {
+			parts = append(parts, "("+e.expr+")")
+			continue
+		}
+		parts = append(parts, e.expr)
+	}
+
+	return RulesetExpression{expr: strings.Join(parts, " "+operator+" "), compound: true}
+}
+
+func (f RulesetExpressionField) compare(operator string, value interface{}) RulesetExpression {
+	if f.err != nil {
+		return RulesetExpression{err: f.err}
+	}
+	s, err := f.formatValue(value, false)
+	if err != nil {
+		return RulesetExpression{err: fmt.Errorf("%s %s: %w", f.name, operator, err)}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s %s %s", f.name, operator, s)}
+}
+
+func (f RulesetExpressionField) ordered(operator string, value int) RulesetExpression {
+	if err := f.require(operator, RulesetExpressionFieldInt); err != nil {
+		return RulesetExpression{err: err}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s %s %d", f.name, operator, value)}
+}
+
+func (f RulesetExpressionField) function(name, value string) RulesetExpression {
+	if err := f.require(name, RulesetExpressionFieldString); err != nil {
+		return RulesetExpression{err: err}
+	}
+	return RulesetExpression{expr: fmt.Sprintf("%s(%s, %s)", name, f.name, quoteRulesetString(value))}
+}
+
+func (f RulesetExpressionField) require(operator string, typ RulesetExpressionFieldType) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.typ != typ {
+		if operator == "" {
+			return fmt.Errorf("%s: expected %s field, got %s", f.name, typ, f.typ)
+		}
+		return fmt.Errorf("%s %s: not supported for %s fields", f.name, operator, f.typ)
+	}
+	return nil
+}
+
+func (f RulesetExpressionField) invalid(operator string, value interface{}) RulesetExpression {
+	if f.err != nil {
+		return RulesetExpression{err: f.err}
+	}
+	return RulesetExpression{err: fmt.Errorf("%s %s: expected %s value, got %T", f.name, operator, f.typ, value)}
+}
+
+func (f RulesetExpressionField) formatValue(value interface{}, allowCIDR bool) (string, error) {
+	switch f.typ {
+	case RulesetExpressionFieldString:
+		if s, ok := value.(string); ok {
+			return quoteRulesetString(s), nil
+		}
+	case RulesetExpressionFieldInt:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		}
+	case RulesetExpressionFieldIP:
+		if s, ok := value.(string); ok {
+			if net.ParseIP(s) != nil {
+				return s, nil
+			}
+			if _, _, err := net.ParseCIDR(s); err == nil {
+				if !allowCIDR {
+					return "", fmt.Errorf("CIDR range %q is only supported with in", s)
+				}
+				return s, nil
+			}
+			return "", fmt.Errorf("invalid IP address %q", s)
+		}
+	}
+
+	return "", fmt.Errorf("expected %s value, got %T", f.typ, value)
+}
+
+// quoteRulesetString quotes s as a Rules language string literal.
+func quoteRulesetString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulesetExpression(t *testing.T) {
+	testCases := map[string]struct {
+		expr RulesetExpression
+		want string
+	}{
+		"string equality": {
+			expr: RulesetField("http.host").Eq("example.com"),
+			want: `http.host eq "example.com"`,
+		},
+		"escaped string": {
+			expr: RulesetField("http.user_agent").Contains(`say "hi" \o/`),
+			want: `http.user_agent contains "say \"hi\" \\o/"`,
+		},
+		"matches": {
+			expr: RulesetField("http.request.uri.path").Matches(`^/api/v[0-9]+/`),
+			want: `http.request.uri.path matches "^/api/v[0-9]+/"`,
+		},
+		"integer comparison": {
+			expr: RulesetField("cf.bot_management.score").Lt(30),
+			want: `cf.bot_management.score lt 30`,
+		},
+		"ip set": {
+			expr: RulesetField("ip.src").In("192.0.2.1", "198.51.100.0/24"),
+			want: `ip.src in {192.0.2.1 198.51.100.0/24}`,
+		},
+		"list": {
+			expr: RulesetField("ip.src").InList("$office_ips"),
+			want: `ip.src in $office_ips`,
+		},
+		"boolean": {
+			expr: RulesetField("ssl").Eq(false),
+			want: `not ssl`,
+		},
+		"nested combinators": {
+			expr: RulesetAnd(
+				RulesetField("http.request.uri.path").StartsWith("/api/"),
+				RulesetOr(
+					RulesetField("ip.src.country").In("CN", "RU"),
+					RulesetField("cf.threat_score").Ge(10),
+				),
+				RulesetNot(RulesetAnd(
+					RulesetField("cf.bot_management.verified_bot").IsTrue(),
+					RulesetFieldOfType(`http.request.headers["x-api-key"][0]`, RulesetExpressionFieldString).Ne(""),
+				)),
+			),
+			want: `starts_with(http.request.uri.path, "/api/") and (ip.src.country in {"CN" "RU"} or cf.threat_score ge 10) and not (cf.bot_management.verified_bot and http.request.headers["x-api-key"][0] ne "")`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.expr.Build()
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.want, got)
+				assert.Equal(t, tc.want, tc.expr.String())
+			}
+		})
+	}
+}
+
+func TestRulesetExpression_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		expr RulesetExpression
+		err  string
+	}{
+		"unknown field": {
+			expr: RulesetField("http.request.path").Eq("/"),
+			err:  `unknown field "http.request.path"`,
+		},
+		"invalid field name": {
+			expr: RulesetFieldOfType("http.request.headers[x]", RulesetExpressionFieldString).Eq("y"),
+			err:  `invalid field name "http.request.headers[x]"`,
+		},
+		"type mismatch": {
+			expr: RulesetField("ip.src.asnum").Eq("13335"),
+			err:  "ip.src.asnum eq: expected integer value, got string",
+		},
+		"invalid ip": {
+			expr: RulesetField("ip.src").Eq("192.0.2.256"),
+			err:  `ip.src eq: invalid IP address "192.0.2.256"`,
+		},
+		"cidr equality": {
+			expr: RulesetField("ip.src").Eq("192.0.2.0/24"),
+			err:  `ip.src eq: CIDR range "192.0.2.0/24" is only supported with in`,
+		},
+		"invalid regex": {
+			expr: RulesetField("http.request.uri.path").Matches("(unclosed"),
+			err:  "http.request.uri.path matches: invalid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+		},
+		"ordering on string": {
+			expr: RulesetField("http.host").Gt(1),
+			err:  "http.host gt: not supported for string fields",
+		},
+		"invalid list name": {
+			expr: RulesetField("ip.src").InList("Office IPs"),
+			err:  `ip.src in: invalid list name "Office IPs"`,
+		},
+		"error propagates through combinators": {
+			expr: RulesetOr(RulesetField("ssl").IsTrue(), RulesetNot(RulesetField("http.host").IsTrue())),
+			err:  "http.host: expected boolean field, got string",
+		},
+		"empty combinator": {
+			expr: RulesetAnd(),
+			err:  "and: at least one expression is required",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.expr.Build()
+			assert.EqualError(t, err, tc.err)
+			assert.Empty(t, tc.expr.String())
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var ErrMissingManagedRulesetID = errors.New("missing required managed ruleset ID")
+
+// UpdateManagedRulesetOverridesParams configures the overrides of a managed
+// ruleset deployed in the http_request_firewall_managed phase.
+type UpdateManagedRulesetOverridesParams struct {
+	// RulesetID is the ID of the managed ruleset, such as the Cloudflare
+	// Managed Ruleset or the OWASP Core Ruleset.
+	RulesetID string
+
+	// Overrides are the desired overrides. Top level fields, categories and
+	// rules that are the same as in Base are left as they currently are, so
+	// changes made by others since Base was read are preserved.
+	Overrides RulesetRuleActionParametersOverrides
+
+	// Base is the overrides Overrides was derived from, typically the result
+	// of GetManagedRulesetOverrides. Categories and rules present in Base but
+	// not in Overrides are removed. When nil, Overrides is merged on top of
+	// the current overrides and nothing is removed.
+	Base *RulesetRuleActionParametersOverrides
+
+	// Expression selects the traffic the managed ruleset is deployed for
+	// when it is not deployed yet. It defaults to "true", all traffic.
+	Expression string
+}
+
+// ListManagedRulesets returns the managed rulesets available to a zone or
+// account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/listZoneRulesets
+func (api *API) ListManagedRulesets(ctx context.Context, rc *ResourceContainer) ([]Ruleset, error) {
+	rulesets, err := api.ListRulesets(ctx, rc, ListRulesetsParams{})
+	if err != nil {
+		return []Ruleset{}, err
+	}
+
+	managed := make([]Ruleset, 0, len(rulesets))
+	for _, r := range rulesets {
+		if r.Kind == string(RulesetKindManaged) {
+			managed = append(managed, r)
+		}
+	}
+
+	return managed, nil
+}
+
+// GetManagedRulesetOverrides returns the overrides of a managed ruleset
+// deployed in the http_request_firewall_managed phase. Empty overrides are
+// returned if the ruleset is deployed without overrides or not deployed.
+func (api *API) GetManagedRulesetOverrides(ctx context.Context, rc *ResourceContainer, rulesetID string) (RulesetRuleActionParametersOverrides, error) {
+	if rulesetID == "" {
+		return RulesetRuleActionParametersOverrides{}, ErrMissingManagedRulesetID
+	}
+
+	return api.getPhaseManagedRulesetOverrides(ctx, rc, RulesetPhaseHTTPRequestFirewallManaged, rulesetID)
+}
+
+func (api *API) getPhaseManagedRulesetOverrides(ctx context.Context, rc *ResourceContainer, phase RulesetPhase, rulesetID string) (RulesetRuleActionParametersOverrides, error) {
+	entrypoint, err := api.getPhaseEntrypoint(ctx, rc, phase)
+	if err != nil {
+		return RulesetRuleActionParametersOverrides{}, err
+	}
+
+	if i := managedRulesetExecuteRule(entrypoint.Rules, rulesetID); i >= 0 && entrypoint.Rules[i].ActionParameters.Overrides != nil {
+		return *entrypoint.Rules[i].ActionParameters.Overrides, nil
+	}
+
+	return RulesetRuleActionParametersOverrides{}, nil
+}
+
+// UpdateManagedRulesetOverrides applies overrides to a managed ruleset in the
+// http_request_firewall_managed entry point ruleset, deploying the managed
+// ruleset if it is not deployed yet. The desired overrides are merged with
+// the current ones, using Base to work out what the caller changed, so that
+// overrides of other rules and categories are preserved. The resulting
+// overrides are returned.
+func (api *API) UpdateManagedRulesetOverrides(ctx context.Context, rc *ResourceContainer, params UpdateManagedRulesetOverridesParams) (RulesetRuleActionParametersOverrides, error) {
+	if params.RulesetID == "" {
+		return RulesetRuleActionParametersOverrides{}, ErrMissingManagedRulesetID
+	}
+
+	return api.updatePhaseManagedRulesetOverrides(ctx, rc, RulesetPhaseHTTPRequestFirewallManaged, params)
+}
+
+// updatePhaseManagedRulesetOverrides merges overrides into the rule of the
+// phase entry point ruleset executing the managed ruleset.
+func (api *API) updatePhaseManagedRulesetOverrides(ctx context.Context, rc *ResourceContainer, phase RulesetPhase, params UpdateManagedRulesetOverridesParams) (RulesetRuleActionParametersOverrides, error) {
+	entrypoint, err := api.getPhaseEntrypoint(ctx, rc, phase)
+	if err != nil {
+		return RulesetRuleActionParametersOverrides{}, err
+	}
+
+	rules := entrypoint.Rules
+	i := managedRulesetExecuteRule(rules, params.RulesetID)
+	if i < 0 {
+		expression := params.Expression
+		if expression == "" {
+			expression = "true"
+		}
+		rules = append(rules, RulesetRule{
+			Action:           string(RulesetRuleActionExecute),
+			Expression:       expression,
+			ActionParameters: &RulesetRuleActionParameters{ID: params.RulesetID},
+		})
+		i = len(rules) - 1
+	}
+
+	var current RulesetRuleActionParametersOverrides
+	if rules[i].ActionParameters.Overrides != nil {
+		current = *rules[i].ActionParameters.Overrides
+	}
+
+	var base RulesetRuleActionParametersOverrides
+	if params.Base != nil {
+		base = *params.Base
+	}
+
+	merged := mergeRulesetOverrides(base, params.Overrides, current)
+	rules[i].ActionParameters.Overrides = &merged
+
+	updated, err := api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase:       string(phase),
+		Description: entrypoint.Description,
+		Rules:       rules,
+	})
+	if err != nil {
+		return RulesetRuleActionParametersOverrides{}, err
+	}
+
+	// Rules without overrides, such as after clearing all of them, are
+	// returned without the overrides field.
+	if j := managedRulesetExecuteRule(updated.Rules, params.RulesetID); j >= 0 {
+		if updated.Rules[j].ActionParameters.Overrides != nil {
+			return *updated.Rules[j].ActionParameters.Overrides, nil
+		}
+		return RulesetRuleActionParametersOverrides{}, nil
+	}
+
+	return RulesetRuleActionParametersOverrides{}, fmt.Errorf("managed ruleset %s missing from entry point ruleset", params.RulesetID)
+}
+
+// getPhaseEntrypoint returns the entry point ruleset of a phase, or an
+// empty ruleset if the phase has none yet.
+func (api *API) getPhaseEntrypoint(ctx context.Context, rc *ResourceContainer, phase RulesetPhase) (Ruleset, error) {
+	if rc.Identifier == "" {
+		return Ruleset{}, ErrMissingIdentifier
+	}
+
+	ruleset, err := api.GetEntrypointRuleset(ctx, rc, string(phase))
+	if err != nil {
+		var notFoundError *NotFoundError
+		if errors.As(err, &notFoundError) {
+			return Ruleset{}, nil
+		}
+		return Ruleset{}, err
+	}
+
+	return ruleset, nil
+}
+
+// managedRulesetExecuteRule returns the index of the rule executing the
+// managed ruleset, or -1.
+func managedRulesetExecuteRule(rules []RulesetRule, rulesetID string) int {
+	for i, r := range rules {
+		if r.Action == string(RulesetRuleActionExecute) && r.ActionParameters != nil && r.ActionParameters.ID == rulesetID {
+			return i
+		}
+	}
+	return -1
+}
+
+// mergeRulesetOverrides performs a three-way merge of overrides. Changes
+// between base and local are applied on top of remote; where both sides
+// changed the same field, category or rule, local wins.
+func mergeRulesetOverrides(base, local, remote RulesetRuleActionParametersOverrides) RulesetRuleActionParametersOverrides {
+	merged := remote
+
+	if !reflect.DeepEqual(base.Enabled, local.Enabled) {
+		merged.Enabled = local.Enabled
+	}
+	if base.Action != local.Action {
+		merged.Action = local.Action
+	}
+	if base.SensitivityLevel != local.SensitivityLevel {
+		merged.SensitivityLevel = local.SensitivityLevel
+	}
+
+	merged.Categories = mergeOverrideEntries(base.Categories, local.Categories, remote.Categories, func(c RulesetRuleActionParametersCategories) string {
+		return c.Category
+	})
+	merged.Rules = mergeOverrideEntries(base.Rules, local.Rules, remote.Rules, func(r RulesetRuleActionParametersRules) string {
+		return r.ID
+	})
+
+	return merged
+}
+
+func mergeOverrideEntries[T any](base, local, remote []T, key func(T) string) []T {
+	baseByKey := make(map[string]T, len(base))
+	for _, e := range base {
+		baseByKey[key(e)] = e
+	}
+
+	localByKey := make(map[string]T, len(local))
+	for _, e := range local {
+		localByKey[key(e)] = e
+	}
+
+	var merged []T
+	seen := make(map[string]bool, len(remote))
+	for _, e := range remote {
+		k := key(e)
+		seen[k] = true
+
+		b, inBase := baseByKey[k]
+		l, inLocal := localByKey[k]
+		switch {
+		case inBase && !inLocal:
+			// Removed locally.
+			continue
+		case inLocal && (!inBase || !reflect.DeepEqual(b, l)):
+			// Added or changed locally.
+			merged = append(merged, l)
+		default:
+			merged = append(merged, e)
+		}
+	}
+
+	for _, l := range local {
+		k := key(l)
+		if seen[k] {
+			continue
+		}
+
+		// Entries removed remotely since base stay removed unless changed
+		// locally.
+		if b, inBase := baseByKey[k]; inBase && reflect.DeepEqual(b, l) {
+			continue
+		}
+
+		merged = append(merged, l)
+	}
+
+	return merged
+}
@@ -0,0 +1,202 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRulesetOverrides(t *testing.T) {
+	base := RulesetRuleActionParametersOverrides{
+		SensitivityLevel: "default",
+		Rules: []RulesetRuleActionParametersRules{
+			{ID: "a", Action: "log"},
+			{ID: "b", Enabled: BoolPtr(false)},
+		},
+		Categories: []RulesetRuleActionParametersCategories{
+			{Category: "wordpress", Enabled: BoolPtr(false)},
+		},
+	}
+
+	// Someone else added rule c, changed rule b and the action since base.
+	remote := RulesetRuleActionParametersOverrides{
+		Action:           "block",
+		SensitivityLevel: "default",
+		Rules: []RulesetRuleActionParametersRules{
+			{ID: "a", Action: "log"},
+			{ID: "b", Enabled: BoolPtr(true)},
+			{ID: "c", Action: "managed_challenge"},
+		},
+		Categories: []RulesetRuleActionParametersCategories{
+			{Category: "wordpress", Enabled: BoolPtr(false)},
+		},
+	}
+
+	// We changed rule a, removed the wordpress category, added rule d and
+	// lowered the sensitivity.
+	local := RulesetRuleActionParametersOverrides{
+		SensitivityLevel: "low",
+		Rules: []RulesetRuleActionParametersRules{
+			{ID: "a", Action: "block"},
+			{ID: "b", Enabled: BoolPtr(false)},
+			{ID: "d", ScoreThreshold: 40},
+		},
+	}
+
+	assert.Equal(t, RulesetRuleActionParametersOverrides{
+		Action:           "block",
+		SensitivityLevel: "low",
+		Rules: []RulesetRuleActionParametersRules{
+			{ID: "a", Action: "block"},
+			{ID: "b", Enabled: BoolPtr(true)},
+			{ID: "c", Action: "managed_challenge"},
+			{ID: "d", ScoreThreshold: 40},
+		},
+	}, mergeRulesetOverrides(base, local, remote))
+}
+
+func TestUpdateManagedRulesetOverrides(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "entrypoint",
+					"phase": "http_request_firewall_managed",
+					"rules": [
+						{
+							"id": "r1",
+							"action": "execute",
+							"expression": "true",
+							"action_parameters": {
+								"id": "efb7b8c949ac4650a09736fc376e9aee",
+								"overrides": {"rules": [{"id": "5de7edfa648c4d6891dc3e7f84534ffa", "action": "log"}]}
+							}
+						},
+						{
+							"id": "r2",
+							"action": "execute",
+							"expression": "true",
+							"action_parameters": {"id": "4814384a9e5d4991b9815dcfc25d2f1f"}
+						}
+					]
+				}
+			}`)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			var body UpdateEntrypointRulesetParams
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 2) {
+				assert.Equal(t, "r2", body.Rules[1].ID)
+				assert.Equal(t, &RulesetRuleActionParametersOverrides{
+					Rules: []RulesetRuleActionParametersRules{
+						{ID: "5de7edfa648c4d6891dc3e7f84534ffa", Action: "log"},
+						{ID: "e3a567afc347477d9702d9047e97d760", Enabled: BoolPtr(false)},
+					},
+					Categories: []RulesetRuleActionParametersCategories{
+						{Category: "language-php", Action: "block"},
+					},
+				}, body.Rules[0].ActionParameters.Overrides)
+			}
+
+			result, _ := json.Marshal(body.Rules)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "entrypoint", "rules": %s}}`, result)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_firewall_managed/entrypoint", handler)
+
+	overrides, err := client.UpdateManagedRulesetOverrides(context.Background(), ZoneIdentifier(testZoneID), UpdateManagedRulesetOverridesParams{
+		RulesetID: "efb7b8c949ac4650a09736fc376e9aee",
+		Overrides: RulesetRuleActionParametersOverrides{
+			Rules: []RulesetRuleActionParametersRules{
+				{ID: "e3a567afc347477d9702d9047e97d760", Enabled: BoolPtr(false)},
+			},
+			Categories: []RulesetRuleActionParametersCategories{
+				{Category: "language-php", Action: "block"},
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, overrides.Rules, 2)
+		assert.Len(t, overrides.Categories, 1)
+	}
+}
+
+func TestUpdateManagedRulesetOverrides_RemoveAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "entrypoint",
+					"phase": "http_request_firewall_managed",
+					"rules": [
+						{
+							"id": "r1",
+							"action": "execute",
+							"expression": "true",
+							"action_parameters": {
+								"id": "efb7b8c949ac4650a09736fc376e9aee",
+								"overrides": {"rules": [{"id": "5de7edfa648c4d6891dc3e7f84534ffa", "action": "log"}]}
+							}
+						}
+					]
+				}
+			}`)
+		case http.MethodPut:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "entrypoint",
+					"phase": "http_request_firewall_managed",
+					"rules": [
+						{
+							"id": "r1",
+							"action": "execute",
+							"expression": "true",
+							"action_parameters": {"id": "efb7b8c949ac4650a09736fc376e9aee"}
+						}
+					]
+				}
+			}`)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_firewall_managed/entrypoint", handler)
+
+	base := RulesetRuleActionParametersOverrides{
+		Rules: []RulesetRuleActionParametersRules{
+			{ID: "5de7edfa648c4d6891dc3e7f84534ffa", Action: "log"},
+		},
+	}
+	overrides, err := client.UpdateManagedRulesetOverrides(context.Background(), ZoneIdentifier(testZoneID), UpdateManagedRulesetOverridesParams{
+		RulesetID: "efb7b8c949ac4650a09736fc376e9aee",
+		Base:      &base,
+	})
+	if assert.NoError(t, err) {
+		assert.Empty(t, overrides.Rules)
+		assert.Empty(t, overrides.Categories)
+	}
+}
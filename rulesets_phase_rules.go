@@ -0,0 +1,158 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Modes of the edge_ttl cache setting.
+const (
+	RulesetEdgeTTLModeRespectOrigin   = "respect_origin"
+	RulesetEdgeTTLModeBypassByDefault = "bypass_by_default"
+	RulesetEdgeTTLModeOverrideOrigin  = "override_origin"
+)
+
+// Modes of the browser_ttl cache setting.
+const (
+	RulesetBrowserTTLModeRespectOrigin  = "respect_origin"
+	RulesetBrowserTTLModeBypass         = "bypass"
+	RulesetBrowserTTLModeOverrideOrigin = "override_origin"
+)
+
+// RulesetEdgeTTLOverride returns an edge TTL setting caching responses on
+// Cloudflare's edge for ttl, regardless of the origin's cache headers.
+func RulesetEdgeTTLOverride(ttl time.Duration) *RulesetRuleActionParametersEdgeTTL {
+	seconds := uint(ttl / time.Second)
+	return &RulesetRuleActionParametersEdgeTTL{
+		Mode:    RulesetEdgeTTLModeOverrideOrigin,
+		Default: &seconds,
+	}
+}
+
+// RulesetBrowserTTLOverride returns a browser TTL setting instructing
+// browsers to cache responses for ttl, regardless of the origin's cache
+// headers.
+func RulesetBrowserTTLOverride(ttl time.Duration) *RulesetRuleActionParametersBrowserTTL {
+	seconds := uint(ttl / time.Second)
+	return &RulesetRuleActionParametersBrowserTTL{
+		Mode:    RulesetBrowserTTLModeOverrideOrigin,
+		Default: &seconds,
+	}
+}
+
+// RulesetCacheKeyQueryString returns a cache key including only the given
+// query string parameters. With no parameters the query string is ignored
+// entirely.
+func RulesetCacheKeyQueryString(include ...string) *RulesetRuleActionParametersCacheKey {
+	query := &RulesetRuleActionParametersCustomKeyQuery{}
+	if len(include) == 0 {
+		query.Exclude = &RulesetRuleActionParametersCustomKeyList{All: true}
+	} else {
+		query.Include = &RulesetRuleActionParametersCustomKeyList{List: include}
+	}
+
+	return &RulesetRuleActionParametersCacheKey{
+		CustomKey: &RulesetRuleActionParametersCustomKey{Query: query},
+	}
+}
+
+// NewRulesetCacheRule returns a rule for the http_request_cache_settings
+// phase applying the cache settings in params, such as Cache, EdgeTTL,
+// BrowserTTL and CacheKey, to requests matching expression.
+func NewRulesetCacheRule(expression string, params RulesetRuleActionParameters) RulesetRule {
+	return RulesetRule{
+		Action:           string(RulesetRuleActionSetCacheSettings),
+		Expression:       expression,
+		ActionParameters: &params,
+	}
+}
+
+// NewRulesetConfigRule returns a rule for the http_config_settings phase
+// applying the settings in params, such as SSL, Polish or RocketLoader, to
+// requests matching expression.
+func NewRulesetConfigRule(expression string, params RulesetRuleActionParameters) RulesetRule {
+	return RulesetRule{
+		Action:           string(RulesetRuleActionSetConfig),
+		Expression:       expression,
+		ActionParameters: &params,
+	}
+}
+
+// RulesetOriginRuleOverrides are the overrides an origin rule can apply.
+type RulesetOriginRuleOverrides struct {
+	// HostHeader overrides the Host header sent to the origin.
+	HostHeader string
+
+	// ResolveOverride resolves the origin using this hostname instead of
+	// the requested one. It must be a proxied DNS record of the zone.
+	ResolveOverride string
+
+	// Port overrides the destination port at the origin.
+	Port uint16
+
+	// SNI overrides the Server Name Indication sent in the TLS handshake
+	// with the origin, for origins expecting a different hostname than the
+	// requested one.
+	SNI string
+}
+
+// NewRulesetOriginRule returns a rule for the http_request_origin phase
+// applying the overrides to requests matching expression.
+func NewRulesetOriginRule(expression string, overrides RulesetOriginRuleOverrides) (RulesetRule, error) {
+	params := RulesetRuleActionParameters{HostHeader: overrides.HostHeader}
+	if overrides.ResolveOverride != "" || overrides.Port != 0 {
+		params.Origin = &RulesetRuleActionParametersOrigin{
+			Host: overrides.ResolveOverride,
+			Port: overrides.Port,
+		}
+	}
+
+	if overrides.SNI != "" {
+		params.SNI = &RulesetRuleActionParametersSni{Value: overrides.SNI}
+	}
+
+	if params.HostHeader == "" && params.Origin == nil && params.SNI == nil {
+		return RulesetRule{}, errors.New("origin rule requires at least one override")
+	}
+
+	return RulesetRule{
+		Action:           string(RulesetRuleActionRoute),
+		Expression:       expression,
+		ActionParameters: &params,
+	}, nil
+}
+
+// AddEntrypointRule appends a rule to the entry point ruleset of a phase,
+// creating the entry point ruleset if it does not exist yet, and returns the
+// created rule.
+func (api *API) AddEntrypointRule(ctx context.Context, rc *ResourceContainer, phase RulesetPhase, rule RulesetRule) (RulesetRule, error) {
+	entrypoint, err := api.getPhaseEntrypoint(ctx, rc, phase)
+	if err != nil {
+		return RulesetRule{}, err
+	}
+
+	existing := make(map[string]bool, len(entrypoint.Rules))
+	for _, r := range entrypoint.Rules {
+		existing[r.ID] = true
+	}
+
+	rule.ID = ""
+	updated, err := api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+		Phase:       string(phase),
+		Description: entrypoint.Description,
+		Rules:       append(entrypoint.Rules, rule),
+	})
+	if err != nil {
+		return RulesetRule{}, err
+	}
+
+	for _, r := range updated.Rules {
+		if !existing[r.ID] {
+			return r, nil
+		}
+	}
+
+	return RulesetRule{}, fmt.Errorf("created rule missing from %s entry point ruleset", phase)
+}
@@ -0,0 +1,93 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRulesetCacheRule(t *testing.T) {
+	rule := NewRulesetCacheRule(`http.request.uri.path matches "^/assets/"`, RulesetRuleActionParameters{
+		Cache:      BoolPtr(true),
+		EdgeTTL:    RulesetEdgeTTLOverride(24 * time.Hour),
+		BrowserTTL: RulesetBrowserTTLOverride(time.Hour),
+		CacheKey:   RulesetCacheKeyQueryString("v"),
+	})
+
+	b, err := json.Marshal(rule)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"action": "set_cache_settings",
+			"expression": "http.request.uri.path matches \"^/assets/\"",
+			"action_parameters": {
+				"cache": true,
+				"edge_ttl": {"mode": "override_origin", "default": 86400},
+				"browser_ttl": {"mode": "override_origin", "default": 3600},
+				"cache_key": {"custom_key": {"query_string": {"include": ["v"]}}}
+			}
+		}`, string(b))
+	}
+
+	ignore := RulesetCacheKeyQueryString()
+	assert.True(t, ignore.CustomKey.Query.Exclude.All)
+}
+
+func TestNewRulesetOriginRule(t *testing.T) {
+	_, err := NewRulesetOriginRule("true", RulesetOriginRuleOverrides{})
+	assert.EqualError(t, err, "origin rule requires at least one override")
+
+	rule, err := NewRulesetOriginRule(`http.host eq "api.example.com"`, RulesetOriginRuleOverrides{
+		HostHeader:      "backend.example.net",
+		ResolveOverride: "backend.example.com",
+		Port:            8443,
+		SNI:             "backend.example.net",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(RulesetRuleActionRoute), rule.Action)
+		assert.Equal(t, "backend.example.net", rule.ActionParameters.HostHeader)
+		assert.Equal(t, &RulesetRuleActionParametersOrigin{Host: "backend.example.com", Port: 8443}, rule.ActionParameters.Origin)
+		assert.Equal(t, &RulesetRuleActionParametersSni{Value: "backend.example.net"}, rule.ActionParameters.SNI)
+	}
+}
+
+func TestAddEntrypointRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the http_config_settings phase"}], "messages": [], "result": null}`)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			var body UpdateEntrypointRulesetParams
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 1) {
+				assert.Equal(t, string(RulesetRuleActionSetConfig), body.Rules[0].Action)
+				body.Rules[0].ID = "c8a5e4ae11974b52a6c5e4a3cc1c4e9a"
+			}
+			result, _ := json.Marshal(body.Rules)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "entrypoint", "rules": %s}}`, result)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_config_settings/entrypoint", handler)
+
+	rule := NewRulesetConfigRule(`http.host eq "legacy.example.com"`, RulesetRuleActionParameters{
+		AutomaticHTTPSRewrites: BoolPtr(false),
+	})
+
+	actual, err := client.AddEntrypointRule(context.Background(), ZoneIdentifier(testZoneID), RulesetPhaseHTTPConfigSettings, rule)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "c8a5e4ae11974b52a6c5e4a3cc1c4e9a", actual.ID)
+		assert.Equal(t, BoolPtr(false), actual.ActionParameters.AutomaticHTTPSRewrites)
+	}
+}
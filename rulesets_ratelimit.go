@@ -0,0 +1,281 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrMissingRateLimitRuleID         = errors.New("missing required rate limit rule ID")
+	ErrMissingRateLimitCharacteristic = errors.New("rate limit rules require at least one characteristic")
+)
+
+// Characteristics rate limit counters can be grouped by. Zone level rules
+// must include RateLimitCharacteristicColoID.
+//
+// API reference: https://developers.cloudflare.com/waf/rate-limiting-rules/parameters/#with-the-same-characteristics
+const (
+	RateLimitCharacteristicColoID  = "cf.colo.id"
+	RateLimitCharacteristicIPSrc   = "ip.src"
+	RateLimitCharacteristicASNum   = "ip.src.asnum"
+	RateLimitCharacteristicCountry = "ip.geoip.country"
+	RateLimitCharacteristicHost    = "http.host"
+	RateLimitCharacteristicPath    = "http.request.uri.path"
+	RateLimitCharacteristicJA3     = "cf.bot_management.ja3_hash"
+	RateLimitCharacteristicJA4     = "cf.bot_management.ja4"
+)
+
+// RateLimitCharacteristicHeader groups rate limit counters by the value of a
+// request header.
+func RateLimitCharacteristicHeader(name string) string {
+	return fmt.Sprintf("http.request.headers[%q]", strings.ToLower(name))
+}
+
+// RateLimitCharacteristicCookie groups rate limit counters by the value of a
+// cookie.
+func RateLimitCharacteristicCookie(name string) string {
+	return fmt.Sprintf("http.request.cookies[%q]", name)
+}
+
+// RateLimitCharacteristicQueryArg groups rate limit counters by the value of
+// a query string argument.
+func RateLimitCharacteristicQueryArg(name string) string {
+	return fmt.Sprintf("http.request.uri.args[%q]", name)
+}
+
+// RulesetRateLimitRule is a rule of the http_ratelimit phase.
+type RulesetRateLimitRule struct {
+	ID          string
+	Ref         string
+	Description string
+	Enabled     *bool
+
+	// Expression selects the requests the rule applies to.
+	Expression string
+
+	// Action is taken once the rate is exceeded, such as "block",
+	// "challenge", "js_challenge", "managed_challenge" or "log".
+	Action string
+
+	// ActionParameters allows customising the response of a block action.
+	ActionParameters *RulesetRuleActionParameters
+
+	// Characteristics group requests into separate counters, see the
+	// RateLimitCharacteristic constants and helpers.
+	Characteristics []string
+
+	// Period is the counting period in seconds, such as 10, 60, 120, 300,
+	// 600 or 3600. The periods available depend on the plan.
+	Period int
+
+	// RequestsPerPeriod is the number of requests allowed per period.
+	// Either it or ScorePerPeriod must be set.
+	RequestsPerPeriod int
+
+	// ScorePerPeriod and ScoreResponseHeaderName configure complexity based
+	// rate limiting, where the origin returns a score for each request.
+	ScorePerPeriod          int
+	ScoreResponseHeaderName string
+
+	// MitigationTimeout is how long, in seconds, the action applies once
+	// triggered. It must be 0 for challenge actions.
+	MitigationTimeout int
+
+	// CountingExpression counts requests matching a different expression
+	// than the one the action applies to.
+	CountingExpression string
+
+	// RequestsToOrigin only counts requests that reach the origin.
+	RequestsToOrigin bool
+}
+
+// Validate checks the rule has the fields the http_ratelimit phase requires.
+// Allowed actions, periods and timeouts are left for the API to check.
+func (r RulesetRateLimitRule) Validate() error {
+	if r.Expression == "" {
+		return errors.New("rate limit rule requires an expression")
+	}
+
+	if r.Action == "" {
+		return errors.New("rate limit rule requires an action")
+	}
+
+	if len(r.Characteristics) == 0 {
+		return ErrMissingRateLimitCharacteristic
+	}
+
+	if r.Period <= 0 {
+		return errors.New("rate limit rule requires a period")
+	}
+
+	if r.MitigationTimeout < 0 {
+		return fmt.Errorf("invalid rate limit mitigation timeout %d", r.MitigationTimeout)
+	}
+
+	if r.RequestsPerPeriod < 0 || r.ScorePerPeriod < 0 {
+		return errors.New("requests and score per period must not be negative")
+	}
+
+	if (r.RequestsPerPeriod > 0) == (r.ScorePerPeriod > 0) {
+		return errors.New("exactly one of requests per period or score per period is required")
+	}
+
+	if r.ScorePerPeriod > 0 && r.ScoreResponseHeaderName == "" {
+		return errors.New("score per period requires a score response header name")
+	}
+
+	return nil
+}
+
+func (r RulesetRateLimitRule) rulesetRule() RulesetRule {
+	return RulesetRule{
+		ID:               r.ID,
+		Ref:              r.Ref,
+		Description:      r.Description,
+		Enabled:          r.Enabled,
+		Expression:       r.Expression,
+		Action:           r.Action,
+		ActionParameters: r.ActionParameters,
+		RateLimit: &RulesetRuleRateLimit{
+			Characteristics:         r.Characteristics,
+			Period:                  r.Period,
+			RequestsPerPeriod:       r.RequestsPerPeriod,
+			ScorePerPeriod:          r.ScorePerPeriod,
+			ScoreResponseHeaderName: r.ScoreResponseHeaderName,
+			MitigationTimeout:       r.MitigationTimeout,
+			CountingExpression:      r.CountingExpression,
+			RequestsToOrigin:        r.RequestsToOrigin,
+		},
+	}
+}
+
+func rulesetRateLimitRuleFromRule(rule RulesetRule) RulesetRateLimitRule {
+	r := RulesetRateLimitRule{
+		ID:               rule.ID,
+		Ref:              rule.Ref,
+		Description:      rule.Description,
+		Enabled:          rule.Enabled,
+		Expression:       rule.Expression,
+		Action:           rule.Action,
+		ActionParameters: rule.ActionParameters,
+	}
+
+	if rl := rule.RateLimit; rl != nil {
+		r.Characteristics = rl.Characteristics
+		r.Period = rl.Period
+		r.RequestsPerPeriod = rl.RequestsPerPeriod
+		r.ScorePerPeriod = rl.ScorePerPeriod
+		r.ScoreResponseHeaderName = rl.ScoreResponseHeaderName
+		r.MitigationTimeout = rl.MitigationTimeout
+		r.CountingExpression = rl.CountingExpression
+		r.RequestsToOrigin = rl.RequestsToOrigin
+	}
+
+	return r
+}
+
+// ListRulesetRateLimitRules returns the rules of the http_ratelimit entry
+// point ruleset. An empty list is returned if the entry point ruleset does
+// not exist yet.
+func (api *API) ListRulesetRateLimitRules(ctx context.Context, rc *ResourceContainer) ([]RulesetRateLimitRule, error) {
+	ruleset, err := api.getPhaseEntrypoint(ctx, rc, RulesetPhaseHTTPRatelimit)
+	if err != nil {
+		return []RulesetRateLimitRule{}, err
+	}
+
+	rules := make([]RulesetRateLimitRule, 0, len(ruleset.Rules))
+	for _, r := range ruleset.Rules {
+		rules = append(rules, rulesetRateLimitRuleFromRule(r))
+	}
+
+	return rules, nil
+}
+
+// CreateRulesetRateLimitRule validates the rule and appends it to the
+// http_ratelimit entry point ruleset, creating the ruleset if needed.
+func (api *API) CreateRulesetRateLimitRule(ctx context.Context, rc *ResourceContainer, rule RulesetRateLimitRule) (RulesetRateLimitRule, error) {
+	if err := rule.Validate(); err != nil {
+		return RulesetRateLimitRule{}, err
+	}
+
+	created, err := api.AddEntrypointRule(ctx, rc, RulesetPhaseHTTPRatelimit, rule.rulesetRule())
+	if err != nil {
+		return RulesetRateLimitRule{}, err
+	}
+
+	return rulesetRateLimitRuleFromRule(created), nil
+}
+
+// UpdateRulesetRateLimitRule validates the rule and replaces the rule with
+// the same ID in the http_ratelimit entry point ruleset.
+func (api *API) UpdateRulesetRateLimitRule(ctx context.Context, rc *ResourceContainer, rule RulesetRateLimitRule) (RulesetRateLimitRule, error) {
+	if rule.ID == "" {
+		return RulesetRateLimitRule{}, ErrMissingRateLimitRuleID
+	}
+
+	if err := rule.Validate(); err != nil {
+		return RulesetRateLimitRule{}, err
+	}
+
+	updated, err := api.modifyRateLimitRule(ctx, rc, rule.ID, func(rules []RulesetRule, i int) []RulesetRule {
+		rules[i] = rule.rulesetRule()
+		return rules
+	})
+	if err != nil {
+		return RulesetRateLimitRule{}, err
+	}
+
+	for _, r := range updated.Rules {
+		if r.ID == rule.ID {
+			return rulesetRateLimitRuleFromRule(r), nil
+		}
+	}
+
+	return RulesetRateLimitRule{}, fmt.Errorf("rate limit rule %s missing from ruleset", rule.ID)
+}
+
+// DeleteRulesetRateLimitRule removes a rule from the http_ratelimit entry
+// point ruleset.
+func (api *API) DeleteRulesetRateLimitRule(ctx context.Context, rc *ResourceContainer, ruleID string) error {
+	if ruleID == "" {
+		return ErrMissingRateLimitRuleID
+	}
+
+	_, err := api.modifyRateLimitRule(ctx, rc, ruleID, func(rules []RulesetRule, i int) []RulesetRule {
+		return append(rules[:i], rules[i+1:]...)
+	})
+
+	return err
+}
+
+func (api *API) modifyRateLimitRule(ctx context.Context, rc *ResourceContainer, ruleID string, modify func([]RulesetRule, int) []RulesetRule) (Ruleset, error) {
+	ruleset, err := api.getPhaseEntrypoint(ctx, rc, RulesetPhaseHTTPRatelimit)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	for i, r := range ruleset.Rules {
+		if r.ID != ruleID {
+			continue
+		}
+
+		return api.UpdateEntrypointRuleset(ctx, rc, UpdateEntrypointRulesetParams{
+			Phase:       string(RulesetPhaseHTTPRatelimit),
+			Description: ruleset.Description,
+			Rules:       modify(ruleset.Rules, i),
+		})
+	}
+
+	return Ruleset{}, fmt.Errorf("rate limit rule %s not found", ruleID)
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulesetRateLimitRule_Validate(t *testing.T) {
+	valid := RulesetRateLimitRule{
+		Expression:        `http.request.uri.path eq "/login"`,
+		Action:            "block",
+		Characteristics:   []string{RateLimitCharacteristicColoID, RateLimitCharacteristicIPSrc},
+		Period:            60,
+		RequestsPerPeriod: 100,
+		MitigationTimeout: 600,
+	}
+	assert.NoError(t, valid.Validate())
+
+	enterprise := valid
+	enterprise.Period = 30
+	enterprise.MitigationTimeout = 7200
+	assert.NoError(t, enterprise.Validate())
+
+	testCases := map[string]struct {
+		modify func(*RulesetRateLimitRule)
+		err    string
+	}{
+		"action": {
+			modify: func(r *RulesetRateLimitRule) { r.Action = "" },
+			err:    "rate limit rule requires an action",
+		},
+		"characteristics": {
+			modify: func(r *RulesetRateLimitRule) { r.Characteristics = nil },
+			err:    ErrMissingRateLimitCharacteristic.Error(),
+		},
+		"period": {
+			modify: func(r *RulesetRateLimitRule) { r.Period = 0 },
+			err:    "rate limit rule requires a period",
+		},
+		"mitigation timeout": {
+			modify: func(r *RulesetRateLimitRule) { r.MitigationTimeout = -1 },
+			err:    "invalid rate limit mitigation timeout -1",
+		},
+		"requests and score": {
+			modify: func(r *RulesetRateLimitRule) { r.ScorePerPeriod = 400 },
+			err:    "exactly one of requests per period or score per period is required",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := valid
+			tc.modify(&r)
+			assert.EqualError(t, r.Validate(), tc.err)
+		})
+	}
+
+	assert.Equal(t, `http.request.headers["x-api-key"]`, RateLimitCharacteristicHeader("X-API-Key"))
+}
+
+func TestCreateRulesetRateLimitRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the http_ratelimit phase"}], "messages": [], "result": null}`)
+		case http.MethodPut:
+			var body struct {
+				Rules []json.RawMessage `json:"rules"`
+			}
+			b, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 1) {
+				assert.JSONEq(t, `{
+					"action": "block",
+					"expression": "http.request.uri.path eq \"/login\"",
+					"description": "login",
+					"ratelimit": {
+						"characteristics": ["cf.colo.id", "http.request.headers[\"x-api-key\"]"],
+						"period": 60,
+						"requests_per_period": 100,
+						"mitigation_timeout": 600,
+						"counting_expression": "http.response.code eq 401"
+					}
+				}`, string(body.Rules[0]))
+			}
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "2f2feab2026849078ba485f918791bdc",
+					"phase": "http_ratelimit",
+					"rules": [{
+						"id": "3a03d665bac047339bb530ecb439a90d",
+						"action": "block",
+						"expression": "http.request.uri.path eq \"/login\"",
+						"description": "login",
+						"ratelimit": {
+							"characteristics": ["cf.colo.id", "http.request.headers[\"x-api-key\"]"],
+							"period": 60,
+							"requests_per_period": 100,
+							"mitigation_timeout": 600,
+							"counting_expression": "http.response.code eq 401"
+						}
+					}]
+				}
+			}`)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_ratelimit/entrypoint", handler)
+
+	want := RulesetRateLimitRule{
+		Description:        "login",
+		Expression:         `http.request.uri.path eq "/login"`,
+		Action:             "block",
+		Characteristics:    []string{RateLimitCharacteristicColoID, RateLimitCharacteristicHeader("X-API-Key")},
+		Period:             60,
+		RequestsPerPeriod:  100,
+		MitigationTimeout:  600,
+		CountingExpression: "http.response.code eq 401",
+	}
+
+	actual, err := client.CreateRulesetRateLimitRule(context.Background(), ZoneIdentifier(testZoneID), want)
+	if assert.NoError(t, err) {
+		want.ID = "3a03d665bac047339bb530ecb439a90d"
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteRulesetRateLimitRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "2f2feab2026849078ba485f918791bdc",
+					"phase": "http_ratelimit",
+					"rules": [
+						{"id": "a", "action": "block", "expression": "true", "ratelimit": {"characteristics": ["cf.colo.id"], "period": 10, "requests_per_period": 5}},
+						{"id": "b", "action": "log", "expression": "true", "ratelimit": {"characteristics": ["cf.colo.id"], "period": 60, "requests_per_period": 50}}
+					]
+				}
+			}`)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			var body UpdateEntrypointRulesetParams
+			assert.NoError(t, json.Unmarshal(b, &body))
+			if assert.Len(t, body.Rules, 1) {
+				assert.Equal(t, "b", body.Rules[0].ID)
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2f2feab2026849078ba485f918791bdc", "rules": []}}`)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_ratelimit/entrypoint", handler)
+
+	rules, err := client.ListRulesetRateLimitRules(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) && assert.Len(t, rules, 2) {
+		assert.Equal(t, 50, rules[1].RequestsPerPeriod)
+	}
+
+	err = client.DeleteRulesetRateLimitRule(context.Background(), ZoneIdentifier(testZoneID), "a")
+	assert.NoError(t, err)
+
+	err = client.DeleteRulesetRateLimitRule(context.Background(), ZoneIdentifier(testZoneID), "missing")
+	assert.EqualError(t, err, "rate limit rule missing not found")
+}
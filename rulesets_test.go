@@ -871,3 +871,55 @@ func TestUpdateRuleset(t *testing.T) {
 		assert.Equal(t, want, accountActual)
 	}
 }
+
+func TestListEntrypointRulesetVersions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "result": [
+        {
+          "id": "2f2feab2026849078ba485f918791bdc",
+          "name": "default",
+          "kind": "zone",
+          "version": "2",
+          "last_updated": "2023-05-01T10:00:00Z",
+          "phase": "http_request_snippets"
+        },
+        {
+          "id": "2f2feab2026849078ba485f918791bdc",
+          "name": "default",
+          "kind": "zone",
+          "version": "1",
+          "last_updated": "2023-04-01T10:00:00Z",
+          "phase": "http_request_snippets"
+        }
+      ],
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_snippets/entrypoint/versions", handler)
+
+	v1, v2 := "1", "2"
+	updated1, _ := time.Parse(time.RFC3339, "2023-04-01T10:00:00Z")
+	updated2, _ := time.Parse(time.RFC3339, "2023-05-01T10:00:00Z")
+
+	want := []Ruleset{
+		{ID: "2f2feab2026849078ba485f918791bdc", Name: "default", Kind: "zone", Version: &v2, LastUpdated: &updated2, Phase: "http_request_snippets"},
+		{ID: "2f2feab2026849078ba485f918791bdc", Name: "default", Kind: "zone", Version: &v1, LastUpdated: &updated1, Phase: "http_request_snippets"},
+	}
+
+	actual, err := client.ListEntrypointRulesetVersions(context.Background(), ZoneIdentifier(testZoneID), string(RulesetPhaseHTTPRequestSnippets))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.ListEntrypointRulesetVersions(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingRulesetPhase)
+}
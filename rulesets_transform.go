@@ -0,0 +1,118 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RulesetHeaderSet returns a header operation setting a header to a static
+// value, replacing any existing value.
+func RulesetHeaderSet(value string) RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation: string(RulesetRuleActionParametersHTTPHeaderOperationSet),
+		Value:     value,
+	}
+}
+
+// RulesetHeaderSetExpression returns a header operation setting a header to
+// the result of an expression evaluated for each request, such as
+// `ip.src` or `cf.bot_management.score`.
+func RulesetHeaderSetExpression(expression string) RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation:  string(RulesetRuleActionParametersHTTPHeaderOperationSet),
+		Expression: expression,
+	}
+}
+
+// RulesetHeaderAdd returns a header operation adding a header with a static
+// value, keeping any existing headers with the same name.
+func RulesetHeaderAdd(value string) RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation: string(RulesetRuleActionParametersHTTPHeaderOperationAdd),
+		Value:     value,
+	}
+}
+
+// RulesetHeaderRemove returns a header operation removing a header.
+func RulesetHeaderRemove() RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation: string(RulesetRuleActionParametersHTTPHeaderOperationRemove),
+	}
+}
+
+// NewRulesetHeaderTransformRule returns a rule modifying the headers of
+// requests matching expression, for the http_request_late_transform phase,
+// or of their responses, for the http_response_headers_transform phase.
+func NewRulesetHeaderTransformRule(expression string, headers map[string]RulesetRuleActionParametersHTTPHeader) (RulesetRule, error) {
+	if len(headers) == 0 {
+		return RulesetRule{}, errors.New("header transform rule requires at least one header")
+	}
+
+	for name, h := range headers {
+		if name == "" {
+			return RulesetRule{}, errors.New("header transform rule has an empty header name")
+		}
+
+		switch RulesetRuleActionParametersHTTPHeaderOperation(h.Operation) {
+		case RulesetRuleActionParametersHTTPHeaderOperationRemove:
+			if h.Value != "" || h.Expression != "" {
+				return RulesetRule{}, fmt.Errorf("header %s: remove takes no value or expression", name)
+			}
+		case RulesetRuleActionParametersHTTPHeaderOperationSet, RulesetRuleActionParametersHTTPHeaderOperationAdd:
+			if (h.Value == "") == (h.Expression == "") {
+				return RulesetRule{}, fmt.Errorf("header %s: exactly one of value or expression is required", name)
+			}
+		default:
+			return RulesetRule{}, fmt.Errorf("header %s: invalid operation %q", name, h.Operation)
+		}
+	}
+
+	return RulesetRule{
+		Action:           string(RulesetRuleActionRewrite),
+		Expression:       expression,
+		ActionParameters: &RulesetRuleActionParameters{Headers: headers},
+	}, nil
+}
+
+// RulesetURLRewrite describes a URL rewrite. Static values and expressions
+// are mutually exclusive for the path and the query string.
+type RulesetURLRewrite struct {
+	Path           string
+	PathExpression string
+
+	// Query replaces the query string. An empty string removes it; nil
+	// leaves it unchanged unless QueryExpression is set.
+	Query           *string
+	QueryExpression string
+}
+
+// NewRulesetURLRewriteRule returns a rule for the http_request_transform
+// phase rewriting the path and/or query string of requests matching
+// expression before they are sent to the origin.
+func NewRulesetURLRewriteRule(expression string, rewrite RulesetURLRewrite) (RulesetRule, error) {
+	if rewrite.Path != "" && rewrite.PathExpression != "" {
+		return RulesetRule{}, errors.New("URL rewrite takes either a static path or a path expression")
+	}
+
+	if rewrite.Query != nil && rewrite.QueryExpression != "" {
+		return RulesetRule{}, errors.New("URL rewrite takes either a static query or a query expression")
+	}
+
+	uri := &RulesetRuleActionParametersURI{}
+	if rewrite.Path != "" || rewrite.PathExpression != "" {
+		uri.Path = &RulesetRuleActionParametersURIPath{Value: rewrite.Path, Expression: rewrite.PathExpression}
+	}
+	if rewrite.Query != nil || rewrite.QueryExpression != "" {
+		uri.Query = &RulesetRuleActionParametersURIQuery{Value: rewrite.Query, Expression: rewrite.QueryExpression}
+	}
+
+	if uri.Path == nil && uri.Query == nil {
+		return RulesetRule{}, errors.New("URL rewrite requires a path or query rewrite")
+	}
+
+	return RulesetRule{
+		Action:           string(RulesetRuleActionRewrite),
+		Expression:       expression,
+		ActionParameters: &RulesetRuleActionParameters{URI: uri},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRulesetHeaderTransformRule(t *testing.T) {
+	rule, err := NewRulesetHeaderTransformRule("true", map[string]RulesetRuleActionParametersHTTPHeader{
+		"X-Client-IP":  RulesetHeaderSetExpression("ip.src"),
+		"X-Source":     RulesetHeaderSet("cloudflare"),
+		"X-Powered-By": RulesetHeaderRemove(),
+	})
+	if assert.NoError(t, err) {
+		b, _ := json.Marshal(rule)
+		assert.JSONEq(t, `{
+			"action": "rewrite",
+			"expression": "true",
+			"action_parameters": {
+				"headers": {
+					"X-Client-IP": {"operation": "set", "expression": "ip.src"},
+					"X-Source": {"operation": "set", "value": "cloudflare"},
+					"X-Powered-By": {"operation": "remove"}
+				}
+			}
+		}`, string(b))
+	}
+
+	_, err = NewRulesetHeaderTransformRule("true", map[string]RulesetRuleActionParametersHTTPHeader{
+		"X-Empty": {Operation: "set"},
+	})
+	assert.EqualError(t, err, "header X-Empty: exactly one of value or expression is required")
+
+	_, err = NewRulesetHeaderTransformRule("true", map[string]RulesetRuleActionParametersHTTPHeader{
+		"X-Bad": {Operation: "append", Value: "a"},
+	})
+	assert.EqualError(t, err, `header X-Bad: invalid operation "append"`)
+}
+
+func TestNewRulesetURLRewriteRule(t *testing.T) {
+	rule, err := NewRulesetURLRewriteRule(`starts_with(http.request.uri.path, "/v1/")`, RulesetURLRewrite{
+		PathExpression: `regex_replace(http.request.uri.path, "^/v1/", "/api/")`,
+		Query:          StringPtr(""),
+	})
+	if assert.NoError(t, err) {
+		b, _ := json.Marshal(rule.ActionParameters)
+		assert.JSONEq(t, `{
+			"uri": {
+				"path": {"expression": "regex_replace(http.request.uri.path, \"^/v1/\", \"/api/\")"},
+				"query": {"value": ""}
+			}
+		}`, string(b))
+	}
+
+	_, err = NewRulesetURLRewriteRule("true", RulesetURLRewrite{})
+	assert.EqualError(t, err, "URL rewrite requires a path or query rewrite")
+
+	_, err = NewRulesetURLRewriteRule("true", RulesetURLRewrite{Path: "/a", PathExpression: "lower(http.request.uri.path)"})
+	assert.EqualError(t, err, "URL rewrite takes either a static path or a path expression")
+}
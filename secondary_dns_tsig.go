@@ -21,6 +21,23 @@ type SecondaryDNSTSIG struct {
 	Algo   string `json:"algo"`
 }
 
+// String implements fmt.Stringer, redacting the TSIG secret.
+func (s SecondaryDNSTSIG) String() string {
+	return redactedString(s.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the TSIG secret.
+func (s SecondaryDNSTSIG) GoString() string {
+	return redactedGoString("SecondaryDNSTSIG", s.redacted())
+}
+
+func (s SecondaryDNSTSIG) redacted() interface{} {
+	type redacted SecondaryDNSTSIG
+	r := redacted(s)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
 // SecondaryDNSTSIGDetailResponse is the API response for a single secondary
 // DNS TSIG.
 type SecondaryDNSTSIGDetailResponse struct {
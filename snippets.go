@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"time"
@@ -160,3 +162,48 @@ func (api *API) UpdateZoneSnippet(ctx context.Context, rc *ResourceContainer, pa
 
 	return result.Result, nil
 }
+
+// GetZoneSnippetContent returns the files making up a snippet.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-snippets-snippet-content
+func (api *API) GetZoneSnippetContent(ctx context.Context, rc *ResourceContainer, snippetName string) ([]SnippetFile, error) {
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/snippets/%s/content", rc.Identifier, snippetName)
+	res, err := api.makeRequestContextWithHeadersComplete(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, params, err := mime.ParseMediaType(res.Headers.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing snippet content type: %w", err)
+	}
+
+	var files []SnippetFile
+	mr := multipart.NewReader(bytes.NewReader(res.Body), params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading snippet content: %w", err)
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("reading snippet content: %w", err)
+		}
+
+		name := part.FileName()
+		if name == "" {
+			name = part.FormName()
+		}
+		files = append(files, SnippetFile{FileName: name, Content: string(content)})
+	}
+
+	return files, nil
+}
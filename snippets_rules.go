@@ -2,12 +2,17 @@ package cloudflare
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/goccy/go-json"
 )
 
+var (
+	ErrMissingSnippetRuleVersion = errors.New("missing required snippet rules version")
+)
+
 type SnippetsRulesResponse struct {
 	Response
 	Result []SnippetRule `json:"result"`
@@ -64,3 +69,93 @@ func (api *API) UpdateZoneSnippetsRules(ctx context.Context, rc *ResourceContain
 
 	return result.Result, nil
 }
+
+// ListZoneSnippetsRulesVersions returns the versions of the snippet rules for
+// a zone. Snippet rules are stored in the zone's http_request_snippets
+// entry point ruleset so each update creates a new ruleset version.
+//
+// API reference: https://developers.cloudflare.com/api/operations/listZoneEntrypointRulesetVersions
+func (api *API) ListZoneSnippetsRulesVersions(ctx context.Context, rc *ResourceContainer) ([]Ruleset, error) {
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	return api.ListEntrypointRulesetVersions(ctx, rc, string(RulesetPhaseHTTPRequestSnippets))
+}
+
+// GetZoneSnippetsRulesVersion returns the snippet rules as they were at the
+// given version.
+//
+// API reference: https://developers.cloudflare.com/api/operations/getZoneEntrypointRulesetVersion
+func (api *API) GetZoneSnippetsRulesVersion(ctx context.Context, rc *ResourceContainer, version string) ([]SnippetRule, error) {
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	if version == "" {
+		return nil, ErrMissingSnippetRuleVersion
+	}
+
+	ruleset, err := api.GetEntrypointRulesetVersion(ctx, rc, string(RulesetPhaseHTTPRequestSnippets), version)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]SnippetRule, 0, len(ruleset.Rules))
+	for _, r := range ruleset.Rules {
+		rule := SnippetRule{
+			ID:          r.ID,
+			Enabled:     r.Enabled,
+			Expression:  r.Expression,
+			Description: r.Description,
+		}
+		if r.ActionParameters != nil {
+			rule.SnippetName = r.ActionParameters.ID
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// RollbackZoneSnippetsRules restores the snippet rules of a zone to those of
+// a previous version. The restore is itself a new version.
+func (api *API) RollbackZoneSnippetsRules(ctx context.Context, rc *ResourceContainer, version string) ([]SnippetRule, error) {
+	rules, err := api.GetZoneSnippetsRulesVersion(ctx, rc, version)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rules {
+		rules[i].ID = ""
+	}
+
+	return api.UpdateZoneSnippetsRules(ctx, rc, rules)
+}
+
+// ReplaceZoneSnippetsRules replaces all snippet rules of a zone in a single
+// request after checking that every rule references an existing snippet. If
+// any rule is invalid nothing is changed.
+func (api *API) ReplaceZoneSnippetsRules(ctx context.Context, rc *ResourceContainer, rules []SnippetRule) ([]SnippetRule, error) {
+	if rc.Identifier == "" {
+		return nil, ErrMissingZoneID
+	}
+
+	snippets, err := api.ListZoneSnippets(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(snippets))
+	for _, s := range snippets {
+		known[s.SnippetName] = true
+	}
+
+	for _, r := range rules {
+		if !known[r.SnippetName] {
+			return nil, fmt.Errorf("snippet rule %q references unknown snippet %q", r.Expression, r.SnippetName)
+		}
+	}
+
+	return api.UpdateZoneSnippetsRules(ctx, rc, rules)
+}
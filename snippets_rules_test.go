@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSnippetsRules(t *testing.T) {
@@ -129,3 +131,105 @@ func TestUpdateSnippetsRules(t *testing.T) {
 		assert.Equal(t, want, zoneActual)
 	}
 }
+
+func TestRollbackZoneSnippetsRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/http_request_snippets/entrypoint/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "result": {
+        "id": "2f2feab2026849078ba485f918791bdc",
+        "phase": "http_request_snippets",
+        "version": "2",
+        "rules": [
+          {
+            "id": "some_id_1",
+            "version": "1",
+            "action": "execute",
+            "action_parameters": { "id": "snippet_1" },
+            "expression": "http.host eq \"example.com\"",
+            "description": "some description",
+            "enabled": true
+          }
+        ]
+      },
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/rules", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		var body []SnippetRule
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []SnippetRule{{
+			Enabled:     BoolPtr(true),
+			Expression:  `http.host eq "example.com"`,
+			SnippetName: "snippet_1",
+			Description: "some description",
+		}}, body)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "result": [
+        {
+          "id": "some_id_3",
+          "expression": "http.host eq \"example.com\"",
+          "enabled": true,
+          "description": "some description",
+          "snippet_name": "snippet_1"
+        }
+      ],
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	})
+
+	want := []SnippetRule{{
+		ID:          "some_id_3",
+		Enabled:     BoolPtr(true),
+		Expression:  `http.host eq "example.com"`,
+		SnippetName: "snippet_1",
+		Description: "some description",
+	}}
+
+	actual, err := client.RollbackZoneSnippetsRules(context.Background(), ZoneIdentifier(testZoneID), "2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.RollbackZoneSnippetsRules(context.Background(), ZoneIdentifier(testZoneID), "")
+	assert.ErrorIs(t, err, ErrMissingSnippetRuleVersion)
+}
+
+func TestReplaceZoneSnippetsRules_UnknownSnippet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "result": [{ "snippet_name": "snippet_1" }],
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/rules", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("rules should not be updated when a snippet is missing")
+	})
+
+	_, err := client.ReplaceZoneSnippetsRules(context.Background(), ZoneIdentifier(testZoneID), []SnippetRule{
+		{Expression: "true", SnippetName: "snippet_1"},
+		{Expression: "true", SnippetName: "snippet_2"},
+	})
+	assert.ErrorContains(t, err, `unknown snippet "snippet_2"`)
+}
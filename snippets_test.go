@@ -166,3 +166,26 @@ func TestUpdateSnippets(t *testing.T) {
 		assert.Equal(t, want, zoneActual)
 	}
 }
+
+func TestGetZoneSnippetContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "multipart/form-data; boundary=snippet")
+		fmt.Fprint(w, "--snippet\r\n"+
+			"Content-Disposition: form-data; name=\"main.js\"; filename=\"main.js\"\r\n\r\n"+
+			"export default { fetch(request) { return fetch(request) } }\r\n"+
+			"--snippet--\r\n")
+	}
+	mux.HandleFunc("/zones/"+testZoneID+"/snippets/redirects/content", handler)
+
+	files, err := client.GetZoneSnippetContent(context.Background(), ZoneIdentifier(testZoneID), "redirects")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []SnippetFile{{
+			FileName: "main.js",
+			Content:  "export default { fetch(request) { return fetch(request) } }",
+		}}, files)
+	}
+}
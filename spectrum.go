@@ -17,6 +17,19 @@ import (
 // value for `proxy_protocol`.
 type ProxyProtocol string
 
+const (
+	// ProxyProtocolOff sends no client information to the origin.
+	ProxyProtocolOff ProxyProtocol = "off"
+	// ProxyProtocolV1 prepends a text PROXY protocol header to TCP connections.
+	ProxyProtocolV1 ProxyProtocol = "v1"
+	// ProxyProtocolV2 prepends a binary PROXY protocol header to TCP and UDP
+	// connections.
+	ProxyProtocolV2 ProxyProtocol = "v2"
+	// ProxyProtocolSimple prepends a Simple Proxy Protocol header to UDP
+	// datagrams.
+	ProxyProtocolSimple ProxyProtocol = "simple"
+)
+
 // UnmarshalJSON handles deserializing of both the deprecated boolean value and the current string value
 // for the `proxy_protocol` field.
 func (p *ProxyProtocol) UnmarshalJSON(data []byte) error {
@@ -40,6 +53,39 @@ func (p *ProxyProtocol) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// SpectrumProtocolNetwork is the transport of a Spectrum application.
+type SpectrumProtocolNetwork string
+
+const (
+	SpectrumProtocolTCP SpectrumProtocolNetwork = "tcp"
+	SpectrumProtocolUDP SpectrumProtocolNetwork = "udp"
+)
+
+// NewSpectrumProtocol returns the protocol of a Spectrum application, such
+// as "tcp/22", listening on port, or on the range port to end when end is
+// greater than port.
+func NewSpectrumProtocol(network SpectrumProtocolNetwork, port, end uint16) string {
+	if end > port {
+		return fmt.Sprintf("%s/%d-%d", network, port, end)
+	}
+	return fmt.Sprintf("%s/%d", network, port)
+}
+
+// Traffic types of Spectrum applications.
+const (
+	SpectrumTrafficTypeDirect = "direct"
+	SpectrumTrafficTypeHTTP   = "http"
+	SpectrumTrafficTypeHTTPS  = "https"
+)
+
+// TLS modes between Spectrum and the origin.
+const (
+	SpectrumTLSOff      = "off"
+	SpectrumTLSFlexible = "flexible"
+	SpectrumTLSFull     = "full"
+	SpectrumTLSStrict   = "strict"
+)
+
 // SpectrumApplicationOriginPort defines a union of a single port or range of ports.
 type SpectrumApplicationOriginPort struct {
 	Port, Start, End uint16
@@ -156,6 +202,15 @@ type SpectrumApplicationDNS struct {
 // Application.
 type SpectrumApplicationOriginDNS struct {
 	Name string `json:"name"`
+
+	// TTL is how long the origin name is cached for, in seconds. Zero uses
+	// the TTL of the DNS record.
+	TTL int `json:"ttl,omitempty"`
+
+	// Type restricts the resolved origin addresses to "A" or "AAAA" records,
+	// or resolves the origin port too with "SRV". Empty resolves both A and
+	// AAAA records.
+	Type string `json:"type,omitempty"`
 }
 
 // SpectrumApplicationDetailResponse is the structure of the detailed response
@@ -0,0 +1,192 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Metrics of Spectrum analytics reports.
+const (
+	SpectrumAnalyticsMetricCount          = "count"
+	SpectrumAnalyticsMetricBytesIngress   = "bytesIngress"
+	SpectrumAnalyticsMetricBytesEgress    = "bytesEgress"
+	SpectrumAnalyticsMetricDurationAvg    = "durationAvg"
+	SpectrumAnalyticsMetricDurationMedian = "durationMedian"
+	SpectrumAnalyticsMetricDuration90th   = "duration90th"
+	SpectrumAnalyticsMetricDuration99th   = "duration99th"
+)
+
+// Dimensions Spectrum analytics reports are grouped by.
+const (
+	SpectrumAnalyticsDimensionEvent     = "event"
+	SpectrumAnalyticsDimensionAppID     = "appID"
+	SpectrumAnalyticsDimensionColoName  = "coloName"
+	SpectrumAnalyticsDimensionIPVersion = "ipVersion"
+)
+
+// SpectrumAggregateAnalytics is the current traffic of a Spectrum
+// application.
+type SpectrumAggregateAnalytics struct {
+	AppID        string  `json:"appID"`
+	BytesIngress int64   `json:"bytesIngress"`
+	BytesEgress  int64   `json:"bytesEgress"`
+	Connections  int64   `json:"connections"`
+	DurationAvg  float64 `json:"durationAvg"`
+}
+
+// SpectrumAggregateAnalyticsResponse is the API response, containing the
+// current traffic of Spectrum applications.
+type SpectrumAggregateAnalyticsResponse struct {
+	Response
+	Result []SpectrumAggregateAnalytics `json:"result"`
+}
+
+// SpectrumAggregateAnalyticsParams filters the applications and data
+// center of GetSpectrumAggregateAnalytics.
+type SpectrumAggregateAnalyticsParams struct {
+	AppIDs   []string `url:"appID,omitempty" del:","`
+	ColoName string   `url:"colo_name,omitempty"`
+}
+
+// SpectrumAnalyticsParams configures a Spectrum analytics report.
+type SpectrumAnalyticsParams struct {
+	// Dimensions groups the report, for example by SpectrumAnalyticsDimensionAppID.
+	Dimensions []string `url:"dimensions,omitempty" del:","`
+
+	// Metrics are the values reported for each group. The API defaults to
+	// SpectrumAnalyticsMetricCount.
+	Metrics []string `url:"metrics,omitempty" del:","`
+
+	Since *time.Time `url:"since,omitempty"`
+	Until *time.Time `url:"until,omitempty"`
+
+	// Filters uses the analytics filter syntax, for example
+	// "event==disconnect;coloName!=SFO".
+	Filters string `url:"filters,omitempty"`
+
+	// Sort orders the rows by metrics, prefixed with "+" for ascending or
+	// "-" for descending.
+	Sort []string `url:"sort,omitempty" del:","`
+
+	// TimeDelta is the width of each interval of a report by time, such as
+	// "minute", "hour" or "day".
+	TimeDelta string `url:"time_delta,omitempty"`
+}
+
+// SpectrumAnalyticsSummaryRow is a group of a summary report. Metrics are
+// in the order they were requested.
+type SpectrumAnalyticsSummaryRow struct {
+	Dimensions []string  `json:"dimensions"`
+	Metrics    []float64 `json:"metrics"`
+}
+
+// SpectrumAnalyticsSummary is a Spectrum analytics report over the whole
+// time range.
+type SpectrumAnalyticsSummary struct {
+	Rows   int                           `json:"rows"`
+	Data   []SpectrumAnalyticsSummaryRow `json:"data"`
+	Totals map[string]float64            `json:"totals"`
+	Min    map[string]float64            `json:"min"`
+	Max    map[string]float64            `json:"max"`
+
+	// DataLag is how many seconds the most recent data is behind.
+	DataLag float64 `json:"data_lag"`
+}
+
+// SpectrumAnalyticsByTimeRow is a group of a report by time. Each metric is
+// a series with one value per interval.
+type SpectrumAnalyticsByTimeRow struct {
+	Dimensions []string    `json:"dimensions"`
+	Metrics    [][]float64 `json:"metrics"`
+}
+
+// SpectrumAnalyticsByTime is a Spectrum analytics report split into
+// intervals. TimeIntervals holds the start and end of each interval.
+type SpectrumAnalyticsByTime struct {
+	Rows          int                          `json:"rows"`
+	Data          []SpectrumAnalyticsByTimeRow `json:"data"`
+	TimeIntervals [][]time.Time                `json:"time_intervals"`
+	Totals        map[string]float64           `json:"totals"`
+	Min           map[string]float64           `json:"min"`
+	Max           map[string]float64           `json:"max"`
+	DataLag       float64                      `json:"data_lag"`
+}
+
+// GetSpectrumAggregateAnalytics returns the traffic of the Spectrum
+// applications of a zone over the last minute.
+//
+// API reference: https://developers.cloudflare.com/api/operations/spectrum-aggregate-analytics-get-current-aggregated-analytics
+func (api *API) GetSpectrumAggregateAnalytics(ctx context.Context, rc *ResourceContainer, params SpectrumAggregateAnalyticsParams) ([]SpectrumAggregateAnalytics, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []SpectrumAggregateAnalytics{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []SpectrumAggregateAnalytics{}, ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/spectrum/analytics/aggregate/current", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []SpectrumAggregateAnalytics{}, err
+	}
+
+	var r SpectrumAggregateAnalyticsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SpectrumAggregateAnalytics{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetSpectrumAnalyticsSummary returns a Spectrum analytics report over the
+// whole time range.
+//
+// API reference: https://developers.cloudflare.com/api/operations/spectrum-analytics-(-by-time)-get-analytics-summary
+func (api *API) GetSpectrumAnalyticsSummary(ctx context.Context, rc *ResourceContainer, params SpectrumAnalyticsParams) (SpectrumAnalyticsSummary, error) {
+	var report SpectrumAnalyticsSummary
+	err := api.spectrumAnalytics(ctx, rc, "summary", params, &report)
+	return report, err
+}
+
+// GetSpectrumAnalyticsByTime returns a Spectrum analytics report split into
+// intervals of params.TimeDelta.
+//
+// API reference: https://developers.cloudflare.com/api/operations/spectrum-analytics-(-by-time)-get-analytics-by-time
+func (api *API) GetSpectrumAnalyticsByTime(ctx context.Context, rc *ResourceContainer, params SpectrumAnalyticsParams) (SpectrumAnalyticsByTime, error) {
+	var report SpectrumAnalyticsByTime
+	err := api.spectrumAnalytics(ctx, rc, "bytime", params, &report)
+	return report, err
+}
+
+func (api *API) spectrumAnalytics(ctx context.Context, rc *ResourceContainer, report string, params SpectrumAnalyticsParams, result interface{}) error {
+	if rc.Level != ZoneRouteLevel {
+		return ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingZoneID
+	}
+
+	uri := buildURI(fmt.Sprintf("/zones/%s/spectrum/analytics/events/%s", rc.Identifier, report), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	r := struct {
+		Response
+		Result interface{} `json:"result"`
+	}{Result: result}
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return nil
+}
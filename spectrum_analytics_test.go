@@ -0,0 +1,93 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSpectrumAggregateAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/spectrum/analytics/aggregate/current", testZoneID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "ea95132c15732412d22c1476fa83f27a,d8fcbd7e7a3d4a6aa4f0d3e94a6e2b8a", r.URL.Query().Get("appID"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"appID": "ea95132c15732412d22c1476fa83f27a",
+				"bytesIngress": 1024,
+				"bytesEgress": 2048,
+				"connections": 3,
+				"durationAvg": 1.5
+			}]
+		}`)
+	})
+
+	actual, err := client.GetSpectrumAggregateAnalytics(context.Background(), ZoneIdentifier(testZoneID), SpectrumAggregateAnalyticsParams{
+		AppIDs: []string{"ea95132c15732412d22c1476fa83f27a", "d8fcbd7e7a3d4a6aa4f0d3e94a6e2b8a"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []SpectrumAggregateAnalytics{{
+			AppID:        "ea95132c15732412d22c1476fa83f27a",
+			BytesIngress: 1024,
+			BytesEgress:  2048,
+			Connections:  3,
+			DurationAvg:  1.5,
+		}}, actual)
+	}
+}
+
+func TestGetSpectrumAnalyticsByTime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(2 * time.Hour)
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/spectrum/analytics/events/bytime", testZoneID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "appID", q.Get("dimensions"))
+		assert.Equal(t, "count,bytesEgress", q.Get("metrics"))
+		assert.Equal(t, "2024-10-01T00:00:00Z", q.Get("since"))
+		assert.Equal(t, "hour", q.Get("time_delta"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"rows": 1,
+				"data": [{"dimensions": ["ea95132c15732412d22c1476fa83f27a"], "metrics": [[10, 20], [1000, 2000]]}],
+				"time_intervals": [["2024-10-01T00:00:00Z", "2024-10-01T01:00:00Z"], ["2024-10-01T01:00:00Z", "2024-10-01T02:00:00Z"]],
+				"totals": {"count": 30, "bytesEgress": 3000},
+				"data_lag": 60
+			}
+		}`)
+	})
+
+	actual, err := client.GetSpectrumAnalyticsByTime(context.Background(), ZoneIdentifier(testZoneID), SpectrumAnalyticsParams{
+		Dimensions: []string{SpectrumAnalyticsDimensionAppID},
+		Metrics:    []string{SpectrumAnalyticsMetricCount, SpectrumAnalyticsMetricBytesEgress},
+		Since:      &since,
+		Until:      &until,
+		TimeDelta:  "hour",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, actual.Rows)
+		assert.Equal(t, [][]float64{{10, 20}, {1000, 2000}}, actual.Data[0].Metrics)
+		assert.Equal(t, since.Add(time.Hour), actual.TimeIntervals[1][0])
+		assert.Equal(t, float64(3000), actual.Totals["bytesEgress"])
+	}
+}
@@ -547,3 +547,8 @@ func TestSpectrumApplicationPortRange(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestNewSpectrumProtocol(t *testing.T) {
+	assert.Equal(t, "tcp/22", NewSpectrumProtocol(SpectrumProtocolTCP, 22, 0))
+	assert.Equal(t, "udp/1000-2000", NewSpectrumProtocol(SpectrumProtocolUDP, 1000, 2000))
+}
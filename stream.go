@@ -183,6 +183,40 @@ type StreamListParameters struct {
 	Status        string     `url:"status,omitempty"`
 }
 
+// StreamUpdateVideoParameters are the parameters used when editing a video.
+// Unset fields are left unchanged.
+type StreamUpdateVideoParameters struct {
+	AccountID             string                 `json:"-"`
+	VideoID               string                 `json:"-"`
+	AllowedOrigins        []string               `json:"allowedOrigins,omitempty"`
+	Creator               string                 `json:"creator,omitempty"`
+	Meta                  map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs     *bool                  `json:"requireSignedURLs,omitempty"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct,omitempty"`
+	MaxDurationSeconds    int                    `json:"maxDurationSeconds,omitempty"`
+	UploadExpiry          *time.Time             `json:"uploadExpiry,omitempty"`
+	ScheduledDeletion     *time.Time             `json:"scheduledDeletion,omitempty"`
+}
+
+// StreamDownload is a downloadable MP4 rendition of a video. Status is
+// "inprogress" until the rendition is ready at URL.
+type StreamDownload struct {
+	Status          string  `json:"status,omitempty"`
+	URL             string  `json:"url,omitempty"`
+	PercentComplete float64 `json:"percentComplete,omitempty"`
+}
+
+// StreamDownloads are the downloads of a video, keyed by type. Only the
+// "default" type exists.
+type StreamDownloads map[string]StreamDownload
+
+// StreamDownloadsResponse represents an API response of the downloads of a
+// video.
+type StreamDownloadsResponse struct {
+	Response
+	Result StreamDownloads `json:"result,omitempty"`
+}
+
 // StreamSignedURLParameters represent parameters used when creating a signed URL.
 type StreamSignedURLParameters struct {
 	AccountID    string
@@ -482,6 +516,80 @@ func (api *API) StreamGetVideo(ctx context.Context, options StreamParameters) (S
 	return streamVideoResponse.Result, nil
 }
 
+// StreamUpdateVideo edits the details of a video.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-videos-update-video-details
+func (api *API) StreamUpdateVideo(ctx context.Context, params StreamUpdateVideoParameters) (StreamVideo, error) {
+	if params.AccountID == "" {
+		return StreamVideo{}, ErrMissingAccountID
+	}
+
+	if params.VideoID == "" {
+		return StreamVideo{}, ErrMissingVideoID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/%s", params.AccountID, params.VideoID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+	var streamVideoResponse StreamVideoResponse
+	if err := json.Unmarshal(res, &streamVideoResponse); err != nil {
+		return StreamVideo{}, err
+	}
+	return streamVideoResponse.Result, nil
+}
+
+// StreamCreateDownloads starts generating an MP4 download of a video.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-m-p-4-downloads-create-downloads
+func (api *API) StreamCreateDownloads(ctx context.Context, options StreamParameters) (StreamDownloads, error) {
+	return api.streamDownloads(ctx, http.MethodPost, options)
+}
+
+// StreamGetDownloads returns the MP4 downloads of a video and their
+// progress.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-m-p-4-downloads-list-downloads
+func (api *API) StreamGetDownloads(ctx context.Context, options StreamParameters) (StreamDownloads, error) {
+	return api.streamDownloads(ctx, http.MethodGet, options)
+}
+
+// StreamDeleteDownloads deletes the MP4 downloads of a video.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-m-p-4-downloads-delete-downloads
+func (api *API) StreamDeleteDownloads(ctx context.Context, options StreamParameters) error {
+	_, err := api.streamDownloads(ctx, http.MethodDelete, options)
+	return err
+}
+
+func (api *API) streamDownloads(ctx context.Context, method string, options StreamParameters) (StreamDownloads, error) {
+	if options.AccountID == "" {
+		return StreamDownloads{}, ErrMissingAccountID
+	}
+
+	if options.VideoID == "" {
+		return StreamDownloads{}, ErrMissingVideoID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/%s/downloads", options.AccountID, options.VideoID)
+
+	res, err := api.makeRequestContext(ctx, method, uri, nil)
+	if err != nil {
+		return StreamDownloads{}, err
+	}
+	if method == http.MethodDelete {
+		return StreamDownloads{}, nil
+	}
+
+	var streamDownloadsResponse StreamDownloadsResponse
+	if err := json.Unmarshal(res, &streamDownloadsResponse); err != nil {
+		return StreamDownloads{}, err
+	}
+	return streamDownloadsResponse.Result, nil
+}
+
 // StreamEmbedHTML gets an HTML fragment to embed on a web page.
 //
 // API Reference: https://api.cloudflare.com/#stream-videos-embed-code-html
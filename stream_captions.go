@@ -0,0 +1,160 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	// ErrMissingCaptionLanguage is for when a caption language is required
+	// but missing.
+	ErrMissingCaptionLanguage = errors.New("required caption language missing")
+	// ErrMissingCaptionFile is for when a captions file is required but
+	// missing.
+	ErrMissingCaptionFile = errors.New("required captions file missing")
+)
+
+// StreamCaption is a captions track of a video.
+type StreamCaption struct {
+	Language string `json:"language"`
+	Label    string `json:"label"`
+}
+
+// StreamCaptionResponse is the API response, containing a single caption.
+type StreamCaptionResponse struct {
+	Response
+	Result StreamCaption `json:"result"`
+}
+
+// StreamCaptionsResponse is the API response, containing the captions of a
+// video.
+type StreamCaptionsResponse struct {
+	Response
+	Result []StreamCaption `json:"result"`
+}
+
+// StreamUploadCaptionParams uploads a WebVTT captions file for a language.
+type StreamUploadCaptionParams struct {
+	VideoID string
+
+	// Language is a BCP 47 language tag, such as "en" or "pt-BR".
+	Language string
+
+	// File is the WebVTT content of the captions.
+	File io.Reader
+}
+
+// StreamListCaptions returns the captions of a video.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-subtitles/captions-list-captions-or-subtitles
+func (api *API) StreamListCaptions(ctx context.Context, rc *ResourceContainer, videoID string) ([]StreamCaption, error) {
+	if rc.Level != AccountRouteLevel {
+		return []StreamCaption{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []StreamCaption{}, ErrMissingAccountID
+	}
+
+	if videoID == "" {
+		return []StreamCaption{}, ErrMissingVideoID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/%s/captions", rc.Identifier, videoID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamCaption{}, err
+	}
+
+	var r StreamCaptionsResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []StreamCaption{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// StreamUploadCaption uploads the captions of a video in a language,
+// replacing any captions already uploaded for it.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-subtitles/captions-upload-captions-or-subtitles
+func (api *API) StreamUploadCaption(ctx context.Context, rc *ResourceContainer, params StreamUploadCaptionParams) (StreamCaption, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamCaption{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamCaption{}, ErrMissingAccountID
+	}
+
+	if params.VideoID == "" {
+		return StreamCaption{}, ErrMissingVideoID
+	}
+
+	if params.Language == "" {
+		return StreamCaption{}, ErrMissingCaptionLanguage
+	}
+
+	if params.File == nil {
+		return StreamCaption{}, ErrMissingCaptionFile
+	}
+
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	part, err := w.CreateFormFile("file", params.Language+".vtt")
+	if err != nil {
+		return StreamCaption{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if _, err := io.Copy(part, params.File); err != nil {
+		return StreamCaption{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return StreamCaption{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/%s/captions/%s", rc.Identifier, params.VideoID, params.Language)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPut, uri, &b, http.Header{
+		"Content-Type": []string{w.FormDataContentType()},
+	})
+	if err != nil {
+		return StreamCaption{}, err
+	}
+
+	var r StreamCaptionResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return StreamCaption{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// StreamDeleteCaption deletes the captions of a video in a language.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-subtitles/captions-delete-captions-or-subtitles
+func (api *API) StreamDeleteCaption(ctx context.Context, rc *ResourceContainer, videoID, language string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if videoID == "" {
+		return ErrMissingVideoID
+	}
+
+	if language == "" {
+		return ErrMissingCaptionLanguage
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/%s/captions/%s", rc.Identifier, videoID, language)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
@@ -0,0 +1,61 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamUploadCaption(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testVideoID+"/captions/pt-BR", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		file, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "WEBVTT\n\n00:00.000 --> 00:01.000\nOlá\n", string(content))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"language": "pt-BR", "label": "Português"}}`)
+	})
+
+	caption, err := client.StreamUploadCaption(context.Background(), AccountIdentifier(testAccountID), StreamUploadCaptionParams{
+		VideoID:  testVideoID,
+		Language: "pt-BR",
+		File:     strings.NewReader("WEBVTT\n\n00:00.000 --> 00:01.000\nOlá\n"),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, StreamCaption{Language: "pt-BR", Label: "Português"}, caption)
+	}
+
+	_, err = client.StreamUploadCaption(context.Background(), AccountIdentifier(testAccountID), StreamUploadCaptionParams{VideoID: testVideoID})
+	assert.Equal(t, ErrMissingCaptionLanguage, err)
+
+	_, err = client.StreamUploadCaption(context.Background(), AccountIdentifier(testAccountID), StreamUploadCaptionParams{VideoID: testVideoID, Language: "en"})
+	assert.Equal(t, ErrMissingCaptionFile, err)
+}
+
+func TestStreamListCaptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testVideoID+"/captions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"language": "en", "label": "English"}, {"language": "fr", "label": "Français"}]}`)
+	})
+
+	captions, err := client.StreamListCaptions(context.Background(), AccountIdentifier(testAccountID), testVideoID)
+	if assert.NoError(t, err) {
+		assert.Len(t, captions, 2)
+		assert.Equal(t, "fr", captions[1].Language)
+	}
+}
@@ -0,0 +1,224 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	// ErrMissingStreamSigningKeyID is for when a signing key ID is required
+	// but missing.
+	ErrMissingStreamSigningKeyID = errors.New("required stream signing key id missing")
+	// ErrInvalidStreamSigningKey is for when a signing key PEM cannot be
+	// parsed as an RSA private key.
+	ErrInvalidStreamSigningKey = errors.New("invalid stream signing key")
+)
+
+// StreamSigningKey is a key for signing Stream playback tokens locally. PEM
+// and JWK are only returned when the key is created.
+type StreamSigningKey struct {
+	ID      string     `json:"id"`
+	PEM     string     `json:"pem,omitempty"`
+	JWK     string     `json:"jwk,omitempty"`
+	Created *time.Time `json:"created,omitempty"`
+}
+
+// String implements fmt.Stringer, redacting the private key.
+func (k StreamSigningKey) String() string {
+	return redactedString(k.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the private key.
+func (k StreamSigningKey) GoString() string {
+	return redactedGoString("StreamSigningKey", k.redacted())
+}
+
+func (k StreamSigningKey) redacted() interface{} {
+	type redacted StreamSigningKey
+	r := redacted(k)
+	r.PEM = redact(r.PEM)
+	r.JWK = redact(r.JWK)
+	return r
+}
+
+// StreamSigningKeyResponse is the API response, containing a single signing
+// key.
+type StreamSigningKeyResponse struct {
+	Response
+	Result StreamSigningKey `json:"result"`
+}
+
+// StreamSigningKeysResponse is the API response, containing signing keys.
+type StreamSigningKeysResponse struct {
+	Response
+	Result []StreamSigningKey `json:"result"`
+}
+
+// CreateStreamSigningKey creates a key for signing playback tokens with
+// StreamSignToken. The private key is only returned by this call.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-signing-keys-create-signing-keys
+func (api *API) CreateStreamSigningKey(ctx context.Context, rc *ResourceContainer) (StreamSigningKey, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamSigningKey{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamSigningKey{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeyResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return StreamSigningKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// ListStreamSigningKeys returns the IDs and creation times of the signing
+// keys of an account.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-signing-keys-list-signing-keys
+func (api *API) ListStreamSigningKeys(ctx context.Context, rc *ResourceContainer) ([]StreamSigningKey, error) {
+	if rc.Level != AccountRouteLevel {
+		return []StreamSigningKey{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []StreamSigningKey{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeysResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []StreamSigningKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// DeleteStreamSigningKey deletes a signing key. Tokens signed with it stop
+// being accepted.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-signing-keys-delete-signing-keys
+func (api *API) DeleteStreamSigningKey(ctx context.Context, rc *ResourceContainer, keyID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if keyID == "" {
+		return ErrMissingStreamSigningKeyID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys/%s", rc.Identifier, keyID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// StreamSignToken signs a playback token for a video locally with a signing
+// key, instead of requesting one with StreamCreateSignedURL. params.ID and
+// params.PEM are the ID and PEM of a key from CreateStreamSigningKey, the PEM
+// either as returned (base64 encoded) or decoded. The token expires after an
+// hour unless params.EXP is set.
+func StreamSignToken(params StreamSignedURLParameters) (string, error) {
+	if params.VideoID == "" {
+		return "", ErrMissingVideoID
+	}
+
+	if params.ID == "" {
+		return "", ErrMissingStreamSigningKeyID
+	}
+
+	key, err := parseStreamSigningKey(params.PEM)
+	if err != nil {
+		return "", err
+	}
+
+	exp := params.EXP
+	if exp == 0 {
+		exp = int(time.Now().Add(time.Hour).Unix())
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": params.ID})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(struct {
+		Sub          string             `json:"sub"`
+		Kid          string             `json:"kid"`
+		Exp          int                `json:"exp"`
+		Nbf          int                `json:"nbf,omitempty"`
+		Downloadable bool               `json:"downloadable,omitempty"`
+		AccessRules  []StreamAccessRule `json:"accessRules,omitempty"`
+	}{params.VideoID, params.ID, exp, params.NBF, params.Downloadable, params.AccessRules})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing stream token: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func parseStreamSigningKey(key string) (*rsa.PrivateKey, error) {
+	key = strings.TrimSpace(key)
+	if !strings.HasPrefix(key, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, ErrInvalidStreamSigningKey
+		}
+		key = string(decoded)
+	}
+
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, ErrInvalidStreamSigningKey
+	}
+
+	if k, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return k, nil
+	}
+
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidStreamSigningKey
+	}
+
+	rsaKey, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidStreamSigningKey
+	}
+	return rsaKey, nil
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -657,3 +658,54 @@ func TestStream_StreamInitiateTUSVideoUpload(t *testing.T) {
 		assert.Equal(t, "1.0.0", out.ResponseHeaders.Get("Tus-Resumable"))
 	}
 }
+
+func TestStream_UpdateVideo(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testVideoID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"meta": {"name": "My First Stream Video"}, "requireSignedURLs": false}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, singleStreamResponse)
+	})
+
+	_, err := client.StreamUpdateVideo(context.Background(), StreamUpdateVideoParameters{AccountID: testAccountID})
+	assert.Equal(t, ErrMissingVideoID, err)
+
+	out, err := client.StreamUpdateVideo(context.Background(), StreamUpdateVideoParameters{
+		AccountID:         testAccountID,
+		VideoID:           testVideoID,
+		Meta:              map[string]interface{}{"name": "My First Stream Video"},
+		RequireSignedURLs: BoolPtr(false),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, TestVideoStruct, out)
+	}
+}
+
+func TestStream_Downloads(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testVideoID+"/downloads", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"default": {"status": "inprogress", "url": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/downloads/default.mp4", "percentComplete": 75.5}}}`)
+		case http.MethodDelete:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	downloads, err := client.StreamCreateDownloads(context.Background(), StreamParameters{AccountID: testAccountID, VideoID: testVideoID})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "inprogress", downloads["default"].Status)
+		assert.Equal(t, 75.5, downloads["default"].PercentComplete)
+	}
+
+	err = client.StreamDeleteDownloads(context.Background(), StreamParameters{AccountID: testAccountID, VideoID: testVideoID})
+	assert.NoError(t, err)
+}
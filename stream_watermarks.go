@@ -0,0 +1,207 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrMissingWatermarkID is for when a watermark ID is required but missing.
+var ErrMissingWatermarkID = errors.New("required watermark id missing")
+
+// Positions of a watermark on a video.
+const (
+	StreamWatermarkPositionUpperRight = "upperRight"
+	StreamWatermarkPositionUpperLeft  = "upperLeft"
+	StreamWatermarkPositionLowerLeft  = "lowerLeft"
+	StreamWatermarkPositionLowerRight = "lowerRight"
+	StreamWatermarkPositionCenter     = "center"
+)
+
+// StreamWatermarkResponse is the API response, containing a single watermark
+// profile.
+type StreamWatermarkResponse struct {
+	Response
+	Result StreamVideoWatermark `json:"result"`
+}
+
+// StreamWatermarksResponse is the API response, containing watermark
+// profiles.
+type StreamWatermarksResponse struct {
+	Response
+	Result []StreamVideoWatermark `json:"result"`
+}
+
+// StreamCreateWatermarkParams creates a watermark profile from an image.
+// Unset fields use the API defaults.
+type StreamCreateWatermarkParams struct {
+	Name string
+
+	// Filename and File are the PNG or JPEG image of the watermark.
+	Filename string
+	File     io.Reader
+
+	// Opacity is between 0 (transparent) and 1 (opaque).
+	Opacity *float64
+
+	// Padding is the whitespace between the image and the edges of the
+	// video, as a ratio of the video's size.
+	Padding *float64
+
+	// Scale is the size of the image relative to the video.
+	Scale *float64
+
+	// Position is one of the StreamWatermarkPosition constants.
+	Position string
+}
+
+// StreamCreateWatermark creates a watermark profile, which can be applied to
+// videos as they are uploaded.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-watermark-profile-create-watermark-profiles-via-basic-upload
+func (api *API) StreamCreateWatermark(ctx context.Context, rc *ResourceContainer, params StreamCreateWatermarkParams) (StreamVideoWatermark, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamVideoWatermark{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamVideoWatermark{}, ErrMissingAccountID
+	}
+
+	if params.File == nil {
+		return StreamVideoWatermark{}, errors.New("required watermark image missing")
+	}
+
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	fields := [][2]string{{"name", params.Name}, {"position", params.Position}}
+	for _, f := range []struct {
+		name  string
+		value *float64
+	}{{"opacity", params.Opacity}, {"padding", params.Padding}, {"scale", params.Scale}} {
+		if f.value != nil {
+			fields = append(fields, [2]string{f.name, strconv.FormatFloat(*f.value, 'f', -1, 64)})
+		}
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return StreamVideoWatermark{}, fmt.Errorf("error during multi-part form construction: %w", err)
+		}
+	}
+
+	filename := params.Filename
+	if filename == "" {
+		filename = "watermark"
+	}
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return StreamVideoWatermark{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if _, err := io.Copy(part, params.File); err != nil {
+		return StreamVideoWatermark{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return StreamVideoWatermark{}, fmt.Errorf("error during multi-part form construction: %w", err)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks", rc.Identifier)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, &b, http.Header{
+		"Content-Type": []string{w.FormDataContentType()},
+	})
+	if err != nil {
+		return StreamVideoWatermark{}, err
+	}
+
+	var r StreamWatermarkResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return StreamVideoWatermark{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// StreamListWatermarks returns the watermark profiles of an account.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-watermark-profile-list-watermark-profiles
+func (api *API) StreamListWatermarks(ctx context.Context, rc *ResourceContainer) ([]StreamVideoWatermark, error) {
+	if rc.Level != AccountRouteLevel {
+		return []StreamVideoWatermark{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []StreamVideoWatermark{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamVideoWatermark{}, err
+	}
+
+	var r StreamWatermarksResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []StreamVideoWatermark{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// StreamGetWatermark returns a watermark profile.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-watermark-profile-watermark-profile-details
+func (api *API) StreamGetWatermark(ctx context.Context, rc *ResourceContainer, watermarkID string) (StreamVideoWatermark, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamVideoWatermark{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamVideoWatermark{}, ErrMissingAccountID
+	}
+
+	if watermarkID == "" {
+		return StreamVideoWatermark{}, ErrMissingWatermarkID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks/%s", rc.Identifier, watermarkID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return StreamVideoWatermark{}, err
+	}
+
+	var r StreamWatermarkResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return StreamVideoWatermark{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
+
+// StreamDeleteWatermark deletes a watermark profile. Videos already
+// watermarked with it keep their watermark.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-watermark-profile-delete-watermark-profiles
+func (api *API) StreamDeleteWatermark(ctx context.Context, rc *ResourceContainer, watermarkID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if watermarkID == "" {
+		return ErrMissingWatermarkID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks/%s", rc.Identifier, watermarkID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
@@ -0,0 +1,66 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCreateWatermark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "Marketing Videos", r.FormValue("name"))
+		assert.Equal(t, "0.75", r.FormValue("opacity"))
+		assert.Equal(t, StreamWatermarkPositionCenter, r.FormValue("position"))
+		assert.Empty(t, r.FormValue("scale"))
+		_, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		assert.Equal(t, "logo.png", header.Filename)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"uid": "ea95132c15732412d22c1476fa83f27a", "name": "Marketing Videos", "opacity": 0.75, "padding": 0.1, "scale": 0.1, "position": "center", "size": 29472, "height": 600, "width": 400}
+		}`)
+	})
+
+	watermark, err := client.StreamCreateWatermark(context.Background(), AccountIdentifier(testAccountID), StreamCreateWatermarkParams{
+		Name:     "Marketing Videos",
+		Filename: "logo.png",
+		File:     strings.NewReader("\x89PNG"),
+		Opacity:  Float64Ptr(0.75),
+		Position: StreamWatermarkPositionCenter,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ea95132c15732412d22c1476fa83f27a", watermark.UID)
+		assert.Equal(t, 0.75, watermark.Opacity)
+	}
+}
+
+func TestStreamDeleteWatermark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks/ea95132c15732412d22c1476fa83f27a", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+	})
+
+	err := client.StreamDeleteWatermark(context.Background(), AccountIdentifier(testAccountID), "ea95132c15732412d22c1476fa83f27a")
+	assert.NoError(t, err)
+
+	err = client.StreamDeleteWatermark(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.Equal(t, ErrMissingWatermarkID, err)
+}
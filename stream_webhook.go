@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrMissingWebhookNotificationURL is for when a webhook notification URL is
+// required but missing.
+var ErrMissingWebhookNotificationURL = errors.New("required webhook notification url missing")
+
+// StreamWebhook is the URL notified when videos of an account finish
+// processing. Secret verifies the Webhook-Signature header of
+// notifications.
+type StreamWebhook struct {
+	NotificationURL string     `json:"notificationUrl"`
+	Modified        *time.Time `json:"modified,omitempty"`
+	Secret          string     `json:"secret,omitempty"`
+}
+
+// String implements fmt.Stringer, redacting the webhook secret.
+func (s StreamWebhook) String() string {
+	return redactedString(s.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the webhook secret.
+func (s StreamWebhook) GoString() string {
+	return redactedGoString("StreamWebhook", s.redacted())
+}
+
+func (s StreamWebhook) redacted() interface{} {
+	type redacted StreamWebhook
+	r := redacted(s)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
+// StreamWebhookResponse is the API response, containing the Stream webhook.
+type StreamWebhookResponse struct {
+	Response
+	Result StreamWebhook `json:"result"`
+}
+
+// StreamSetWebhook subscribes notificationURL to notifications about the
+// videos of an account, replacing any existing subscription.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-webhook-create-webhooks
+func (api *API) StreamSetWebhook(ctx context.Context, rc *ResourceContainer, notificationURL string) (StreamWebhook, error) {
+	if notificationURL == "" {
+		return StreamWebhook{}, ErrMissingWebhookNotificationURL
+	}
+
+	return api.streamWebhook(ctx, rc, http.MethodPut, StreamWebhook{NotificationURL: notificationURL})
+}
+
+// StreamGetWebhook returns the Stream webhook of an account.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-webhook-view-webhooks
+func (api *API) StreamGetWebhook(ctx context.Context, rc *ResourceContainer) (StreamWebhook, error) {
+	return api.streamWebhook(ctx, rc, http.MethodGet, nil)
+}
+
+// StreamDeleteWebhook removes the Stream webhook of an account.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/stream-webhook-delete-webhooks
+func (api *API) StreamDeleteWebhook(ctx context.Context, rc *ResourceContainer) error {
+	_, err := api.streamWebhook(ctx, rc, http.MethodDelete, nil)
+	return err
+}
+
+func (api *API) streamWebhook(ctx context.Context, rc *ResourceContainer, method string, body interface{}) (StreamWebhook, error) {
+	if rc.Level != AccountRouteLevel {
+		return StreamWebhook{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return StreamWebhook{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/webhook", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, method, uri, body)
+	if err != nil {
+		return StreamWebhook{}, err
+	}
+	if method == http.MethodDelete {
+		return StreamWebhook{}, nil
+	}
+
+	var r StreamWebhookResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return StreamWebhook{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,39 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamSetWebhook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/webhook", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"notificationUrl": "https://example.com/stream"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"notificationUrl": "https://example.com/stream", "modified": "2024-10-01T00:00:00Z", "secret": "85011ed3a913c6ad5f9cf6c5573cc0a7"}
+		}`)
+	})
+
+	webhook, err := client.StreamSetWebhook(context.Background(), AccountIdentifier(testAccountID), "https://example.com/stream")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "85011ed3a913c6ad5f9cf6c5573cc0a7", webhook.Secret)
+		assert.NotContains(t, fmt.Sprint(webhook), webhook.Secret)
+	}
+
+	_, err = client.StreamSetWebhook(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.Equal(t, ErrMissingWebhookNotificationURL, err)
+}
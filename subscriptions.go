@@ -0,0 +1,249 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingSubscriptionID = errors.New("required subscription ID missing")
+
+// Billing frequencies of a subscription.
+const (
+	SubscriptionFrequencyWeekly    = "weekly"
+	SubscriptionFrequencyMonthly   = "monthly"
+	SubscriptionFrequencyQuarterly = "quarterly"
+	SubscriptionFrequencyYearly    = "yearly"
+)
+
+// Subscription is a paid plan or add-on of an account or zone.
+type Subscription struct {
+	ID                 string                       `json:"id,omitempty"`
+	State              string                       `json:"state,omitempty"`
+	Price              float64                      `json:"price,omitempty"`
+	Currency           string                       `json:"currency,omitempty"`
+	Frequency          string                       `json:"frequency,omitempty"`
+	RatePlan           SubscriptionRatePlan         `json:"rate_plan"`
+	ComponentValues    []SubscriptionComponentValue `json:"component_values,omitempty"`
+	Zone               *SubscriptionZone            `json:"zone,omitempty"`
+	CurrentPeriodStart *time.Time                   `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *time.Time                   `json:"current_period_end,omitempty"`
+}
+
+// SubscriptionRatePlan is the plan a subscription is for. Only the ID is
+// needed to subscribe.
+type SubscriptionRatePlan struct {
+	ID                string   `json:"id"`
+	PublicName        string   `json:"public_name,omitempty"`
+	Currency          string   `json:"currency,omitempty"`
+	Scope             string   `json:"scope,omitempty"`
+	ExternallyManaged bool     `json:"externally_managed,omitempty"`
+	IsContract        bool     `json:"is_contract,omitempty"`
+	Sets              []string `json:"sets,omitempty"`
+}
+
+// SubscriptionComponentValue is the quantity of a usage component, such as
+// the number of load balancing origins, included in a subscription.
+type SubscriptionComponentValue struct {
+	Name    string  `json:"name"`
+	Value   float64 `json:"value"`
+	Default float64 `json:"default,omitempty"`
+	Price   float64 `json:"price,omitempty"`
+}
+
+// SubscriptionZone is the zone of a zone subscription.
+type SubscriptionZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// SubscriptionParams subscribes to a rate plan. Only the ID of RatePlan is
+// required and Frequency defaults to the plan's billing frequency.
+type SubscriptionParams struct {
+	RatePlan        SubscriptionRatePlan         `json:"rate_plan"`
+	Frequency       string                       `json:"frequency,omitempty"`
+	ComponentValues []SubscriptionComponentValue `json:"component_values,omitempty"`
+}
+
+// SubscriptionResponse is the API response, containing a single
+// subscription.
+type SubscriptionResponse struct {
+	Response
+	Result Subscription `json:"result"`
+}
+
+// SubscriptionsResponse is the API response, containing subscriptions.
+type SubscriptionsResponse struct {
+	Response
+	Result []Subscription `json:"result"`
+}
+
+// ListAccountSubscriptions returns the subscriptions of an account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-list-subscriptions
+func (api *API) ListAccountSubscriptions(ctx context.Context, rc *ResourceContainer) ([]Subscription, error) {
+	if rc.Level != AccountRouteLevel {
+		return []Subscription{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []Subscription{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Subscription{}, err
+	}
+
+	var r SubscriptionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateAccountSubscription subscribes an account to a rate plan.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-create-subscription
+func (api *API) CreateAccountSubscription(ctx context.Context, rc *ResourceContainer, params SubscriptionParams) (Subscription, error) {
+	if rc.Level != AccountRouteLevel {
+		return Subscription{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Subscription{}, ErrMissingAccountID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", rc.Identifier)
+	return api.saveSubscription(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateAccountSubscription changes the rate plan, frequency or components
+// of an account subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-update-subscription
+func (api *API) UpdateAccountSubscription(ctx context.Context, rc *ResourceContainer, subscriptionID string, params SubscriptionParams) (Subscription, error) {
+	if rc.Level != AccountRouteLevel {
+		return Subscription{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Subscription{}, ErrMissingAccountID
+	}
+
+	if subscriptionID == "" {
+		return Subscription{}, ErrMissingSubscriptionID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", rc.Identifier, subscriptionID)
+	return api.saveSubscription(ctx, http.MethodPut, uri, params)
+}
+
+// DeleteAccountSubscription cancels an account subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/account-subscriptions-delete-subscription
+func (api *API) DeleteAccountSubscription(ctx context.Context, rc *ResourceContainer, subscriptionID string) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if subscriptionID == "" {
+		return ErrMissingSubscriptionID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", rc.Identifier, subscriptionID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// GetZoneSubscription returns the rate plan subscription of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-subscription-zone-subscription-details
+func (api *API) GetZoneSubscription(ctx context.Context, rc *ResourceContainer) (Subscription, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Subscription{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Subscription{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/subscription", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateZoneSubscription subscribes a zone to a rate plan, such as one
+// returned by AvailableZoneRatePlans. Unlike ZoneSetPlan, the billing
+// frequency can be chosen and the subscription is returned.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-subscription-create-zone-subscription
+func (api *API) CreateZoneSubscription(ctx context.Context, rc *ResourceContainer, params SubscriptionParams) (Subscription, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Subscription{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Subscription{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/subscription", rc.Identifier)
+	return api.saveSubscription(ctx, http.MethodPost, uri, params)
+}
+
+// UpdateZoneSubscription changes the rate plan or billing frequency of a
+// zone's subscription.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-subscription-update-zone-subscription
+func (api *API) UpdateZoneSubscription(ctx context.Context, rc *ResourceContainer, params SubscriptionParams) (Subscription, error) {
+	if rc.Level != ZoneRouteLevel {
+		return Subscription{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return Subscription{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/subscription", rc.Identifier)
+	return api.saveSubscription(ctx, http.MethodPut, uri, params)
+}
+
+func (api *API) saveSubscription(ctx context.Context, method, uri string, params SubscriptionParams) (Subscription, error) {
+	if params.RatePlan.ID == "" {
+		return Subscription{}, errors.New("required rate plan ID missing")
+	}
+
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
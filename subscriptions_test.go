@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSubscriptionID = "506e3185e9c882d175a2d0cb0093d9f2"
+
+func TestListAccountSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "%s",
+					"state": "Paid",
+					"price": 20,
+					"currency": "USD",
+					"frequency": "monthly",
+					"rate_plan": {"id": "business", "public_name": "Business Plan", "scope": "zone"},
+					"zone": {"id": "%s", "name": "example.com"},
+					"current_period_start": "2024-10-01T00:00:00Z",
+					"current_period_end": "2024-11-01T00:00:00Z"
+				}
+			]
+		}`, testSubscriptionID, testZoneID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions", handler)
+
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	want := []Subscription{{
+		ID:                 testSubscriptionID,
+		State:              "Paid",
+		Price:              20,
+		Currency:           "USD",
+		Frequency:          SubscriptionFrequencyMonthly,
+		RatePlan:           SubscriptionRatePlan{ID: "business", PublicName: "Business Plan", Scope: "zone"},
+		Zone:               &SubscriptionZone{ID: testZoneID, Name: "example.com"},
+		CurrentPeriodStart: &start,
+		CurrentPeriodEnd:   &end,
+	}}
+
+	actual, err := client.ListAccountSubscriptions(context.Background(), AccountIdentifier(testAccountID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rate_plan": {"id": "teams_standard"}, "frequency": "yearly", "component_values": [{"name": "users", "value": 50}]}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "%s", "state": "Provisioned", "frequency": "yearly", "rate_plan": {"id": "teams_standard"}, "component_values": [{"name": "users", "value": 50}]}
+		}`, testSubscriptionID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions/"+testSubscriptionID, handler)
+
+	actual, err := client.UpdateAccountSubscription(context.Background(), AccountIdentifier(testAccountID), testSubscriptionID, SubscriptionParams{
+		RatePlan:        SubscriptionRatePlan{ID: "teams_standard"},
+		Frequency:       SubscriptionFrequencyYearly,
+		ComponentValues: []SubscriptionComponentValue{{Name: "users", Value: 50}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Provisioned", actual.State)
+		assert.Equal(t, float64(50), actual.ComponentValues[0].Value)
+	}
+
+	_, err = client.UpdateAccountSubscription(context.Background(), AccountIdentifier(testAccountID), "", SubscriptionParams{})
+	assert.Equal(t, ErrMissingSubscriptionID, err)
+}
+
+func TestCreateZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"rate_plan": {"id": "CF_PRO"}, "frequency": "monthly"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "%s", "state": "Paid", "frequency": "monthly", "rate_plan": {"id": "CF_PRO"}, "zone": {"id": "%s"}}
+		}`, testSubscriptionID, testZoneID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", handler)
+
+	actual, err := client.CreateZoneSubscription(context.Background(), ZoneIdentifier(testZoneID), SubscriptionParams{
+		RatePlan:  SubscriptionRatePlan{ID: "CF_PRO"},
+		Frequency: SubscriptionFrequencyMonthly,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testSubscriptionID, actual.ID)
+		assert.Equal(t, testZoneID, actual.Zone.ID)
+	}
+
+	_, err = client.CreateZoneSubscription(context.Background(), AccountIdentifier(testAccountID), SubscriptionParams{})
+	assert.Equal(t, ErrRequiredZoneLevelResourceContainer, err)
+}
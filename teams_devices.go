@@ -86,6 +86,26 @@ func (api *API) RevokeTeamsDevices(ctx context.Context, accountID string, device
 	return result, err
 }
 
+// UnrevokeTeamsDevices restores previously revoked devices with given
+// identifiers.
+//
+// API reference : https://developers.cloudflare.com/api/operations/devices-unrevoke-devices
+func (api *API) UnrevokeTeamsDevices(ctx context.Context, accountID string, deviceIds []string) (Response, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/unrevoke", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, deviceIds)
+	if err != nil {
+		return Response{}, err
+	}
+
+	result := Response{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result, err
+}
+
 // GetTeamsDeviceDetails gets device details.
 //
 // API reference : https://api.cloudflare.com/#devices-device-details
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -116,6 +117,37 @@ func TestRevokeTeamsDevices(t *testing.T) {
 	assert.Equal(t, want, actual)
 }
 
+func TestUnrevokeTeamsDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deviceIds := []string{"f174e90a-fafe-4643-bbbc-4a0ed4fc8415"}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		var body []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, deviceIds, body)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "result": null,
+      "success": true,
+      "errors": [],
+      "messages": []
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/unrevoke", handler)
+
+	want := Response{Success: true, Errors: []ResponseInfo{}, Messages: []ResponseInfo{}}
+
+	actual, err := client.UnrevokeTeamsDevices(context.Background(), testAccountID, deviceIds)
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+}
+
 func TestGetTeamsDeviceDetails(t *testing.T) {
 	setup()
 	defer teardown()
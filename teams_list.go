@@ -12,6 +12,15 @@ import (
 
 var ErrMissingListID = errors.New("required missing list ID")
 
+// Types of Teams List.
+const (
+	TeamsListTypeSerial = "SERIAL"
+	TeamsListTypeURL    = "URL"
+	TeamsListTypeDomain = "DOMAIN"
+	TeamsListTypeEmail  = "EMAIL"
+	TeamsListTypeIP     = "IP"
+)
+
 // TeamsList represents a Teams List.
 type TeamsList struct {
 	ID          string          `json:"id,omitempty"`
@@ -303,6 +312,38 @@ func (api *API) PatchTeamsList(ctx context.Context, rc *ResourceContainer, listP
 	return teamsListDetailResponse.Result, nil
 }
 
+// AppendTeamsListItems adds items to an existing teams list without
+// replacing the current items.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-patch-teams-list
+func (api *API) AppendTeamsListItems(ctx context.Context, rc *ResourceContainer, listID string, items []TeamsListItem) (TeamsList, error) {
+	if listID == "" {
+		return TeamsList{}, ErrMissingListID
+	}
+
+	return api.PatchTeamsList(ctx, rc, PatchTeamsListParams{
+		ID:     listID,
+		Append: items,
+		Remove: []string{},
+	})
+}
+
+// RemoveTeamsListItems removes items, identified by their value, from an
+// existing teams list.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-patch-teams-list
+func (api *API) RemoveTeamsListItems(ctx context.Context, rc *ResourceContainer, listID string, values []string) (TeamsList, error) {
+	if listID == "" {
+		return TeamsList{}, ErrMissingListID
+	}
+
+	return api.PatchTeamsList(ctx, rc, PatchTeamsListParams{
+		ID:     listID,
+		Append: []TeamsListItem{},
+		Remove: values,
+	})
+}
+
 // DeleteTeamsList deletes a teams list.
 //
 // API reference: https://api.cloudflare.com/#teams-lists-delete-teams-list
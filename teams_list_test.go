@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -331,6 +332,58 @@ func TestPatchTeamsList(t *testing.T) {
 	}
 }
 
+func TestAppendAndRemoveTeamsListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var want PatchTeamsListParams
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+
+		var got PatchTeamsListParams
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		assert.Equal(t, want, got)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+				"name": "My Domain List",
+				"type": "DOMAIN",
+				"count": 2
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/lists/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", handler)
+
+	want = PatchTeamsListParams{
+		ID:     "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Append: []TeamsListItem{{Value: "example.com"}, {Value: "example.net"}},
+		Remove: []string{},
+	}
+	actual, err := client.AppendTeamsListItems(context.Background(), AccountIdentifier(testAccountID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", want.Append)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TeamsListTypeDomain, actual.Type)
+		assert.Equal(t, uint64(2), actual.Count)
+	}
+
+	want = PatchTeamsListParams{
+		ID:     "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Append: []TeamsListItem{},
+		Remove: []string{"example.org"},
+	}
+	_, err = client.RemoveTeamsListItems(context.Background(), AccountIdentifier(testAccountID), "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", want.Remove)
+	assert.NoError(t, err)
+
+	_, err = client.AppendTeamsListItems(context.Background(), AccountIdentifier(testAccountID), "", nil)
+	assert.ErrorIs(t, err, ErrMissingListID)
+}
+
 func TestDeleteTeamsList(t *testing.T) {
 	setup()
 	defer teardown()
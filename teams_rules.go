@@ -104,6 +104,9 @@ type TeamsL4OverrideSettings struct {
 	Port int    `json:"port,omitempty"`
 }
 
+// TeamsBISOAdminControlSettings controls what users can do in an isolated
+// browser session. The Disable* fields are the original (v1) controls; set
+// Version to TeamsBISOAdminControlsV2 to use the per-direction controls.
 type TeamsBISOAdminControlSettings struct {
 	DisablePrinting             bool `json:"dp"`
 	DisableCopyPaste            bool `json:"dcp"`
@@ -111,8 +114,33 @@ type TeamsBISOAdminControlSettings struct {
 	DisableUpload               bool `json:"du"`
 	DisableKeyboard             bool `json:"dk"`
 	DisableClipboardRedirection bool `json:"dcr"`
+
+	Version  TeamsBISOAdminControlsVersion `json:"version,omitempty"`
+	Copy     TeamsBISOAdminControlSetting  `json:"copy,omitempty"`
+	Paste    TeamsBISOAdminControlSetting  `json:"paste,omitempty"`
+	Download TeamsBISOAdminControlSetting  `json:"download,omitempty"`
+	Upload   TeamsBISOAdminControlSetting  `json:"upload,omitempty"`
+	Keyboard TeamsBISOAdminControlSetting  `json:"keyboard,omitempty"`
+	Printing TeamsBISOAdminControlSetting  `json:"printing,omitempty"`
 }
 
+type TeamsBISOAdminControlsVersion string
+
+const (
+	TeamsBISOAdminControlsV1 TeamsBISOAdminControlsVersion = "v1"
+	TeamsBISOAdminControlsV2 TeamsBISOAdminControlsVersion = "v2"
+)
+
+// TeamsBISOAdminControlSetting is the value of a v2 isolation control.
+// TeamsBISOAdminControlRemoteOnly is only valid for Copy and Paste.
+type TeamsBISOAdminControlSetting string
+
+const (
+	TeamsBISOAdminControlEnabled    TeamsBISOAdminControlSetting = "enabled"
+	TeamsBISOAdminControlDisabled   TeamsBISOAdminControlSetting = "disabled"
+	TeamsBISOAdminControlRemoteOnly TeamsBISOAdminControlSetting = "remote_only"
+)
+
 type TeamsCheckSessionSettings struct {
 	Enforce  bool     `json:"enforce"`
 	Duration Duration `json:"duration"`
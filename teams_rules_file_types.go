@@ -0,0 +1,74 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrMissingTeamsFileTypes = errors.New("at least one file type is required")
+
+// TeamsFileTransferDirection is the direction of a file transfer matched by a
+// Gateway HTTP policy.
+type TeamsFileTransferDirection string
+
+const (
+	TeamsFileUpload   TeamsFileTransferDirection = "upload"
+	TeamsFileDownload TeamsFileTransferDirection = "download"
+)
+
+// TeamsFileTypeTraffic returns a Gateway HTTP traffic expression matching
+// uploads or downloads of any of the given file types, such as "pdf" or
+// "docx".
+func TeamsFileTypeTraffic(direction TeamsFileTransferDirection, fileTypes ...string) (string, error) {
+	if direction != TeamsFileUpload && direction != TeamsFileDownload {
+		return "", fmt.Errorf("invalid file transfer direction %q", direction)
+	}
+
+	if len(fileTypes) == 0 {
+		return "", ErrMissingTeamsFileTypes
+	}
+
+	quoted := make([]string, 0, len(fileTypes))
+	for _, t := range fileTypes {
+		quoted = append(quoted, strconv.Quote(strings.ToLower(strings.TrimPrefix(t, "."))))
+	}
+
+	return fmt.Sprintf("any(http.%s.file_types[*] in {%s})", direction, strings.Join(quoted, " ")), nil
+}
+
+// NewTeamsFileTypeBlockRule returns a Gateway HTTP policy blocking uploads or
+// downloads of the given file types, ready to be passed to TeamsCreateRule.
+func NewTeamsFileTypeBlockRule(name string, direction TeamsFileTransferDirection, fileTypes ...string) (TeamsRule, error) {
+	traffic, err := TeamsFileTypeTraffic(direction, fileTypes...)
+	if err != nil {
+		return TeamsRule{}, err
+	}
+
+	return TeamsRule{
+		Name:    name,
+		Enabled: true,
+		Action:  Block,
+		Filters: []TeamsFilterType{HttpFilter},
+		Traffic: traffic,
+		RuleSettings: TeamsRuleSettings{
+			BlockPageEnabled: true,
+		},
+	}, nil
+}
+
+// NewTeamsIsolationRule returns a Gateway HTTP policy isolating traffic
+// matching the expression in a remote browser with the given controls.
+func NewTeamsIsolationRule(name, traffic string, controls TeamsBISOAdminControlSettings) TeamsRule {
+	return TeamsRule{
+		Name:    name,
+		Enabled: true,
+		Action:  Isolate,
+		Filters: []TeamsFilterType{HttpFilter},
+		Traffic: traffic,
+		RuleSettings: TeamsRuleSettings{
+			BISOAdminControls: &controls,
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsFileTypeTraffic(t *testing.T) {
+	traffic, err := TeamsFileTypeTraffic(TeamsFileUpload, "pdf", ".DOCX")
+	if assert.NoError(t, err) {
+		assert.Equal(t, `any(http.upload.file_types[*] in {"pdf" "docx"})`, traffic)
+	}
+
+	_, err = TeamsFileTypeTraffic(TeamsFileDownload)
+	assert.ErrorIs(t, err, ErrMissingTeamsFileTypes)
+
+	_, err = TeamsFileTypeTraffic("sideways", "pdf")
+	assert.EqualError(t, err, `invalid file transfer direction "sideways"`)
+
+	rule, err := NewTeamsFileTypeBlockRule("block exe downloads", TeamsFileDownload, "exe")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Block, rule.Action)
+		assert.Equal(t, []TeamsFilterType{HttpFilter}, rule.Filters)
+		assert.Equal(t, `any(http.download.file_types[*] in {"exe"})`, rule.Traffic)
+	}
+}
+
+func TestTeamsBISOAdminControlSettingsV2(t *testing.T) {
+	rule := NewTeamsIsolationRule("isolate", `http.request.host == "example.com"`, TeamsBISOAdminControlSettings{
+		Version:  TeamsBISOAdminControlsV2,
+		Copy:     TeamsBISOAdminControlRemoteOnly,
+		Paste:    TeamsBISOAdminControlDisabled,
+		Printing: TeamsBISOAdminControlDisabled,
+	})
+
+	b, err := json.Marshal(rule.RuleSettings.BISOAdminControls)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"dp": false, "dcp": false, "dd": false, "du": false, "dk": false, "dcr": false,
+			"version": "v2", "copy": "remote_only", "paste": "disabled", "printing": "disabled"
+		}`, string(b))
+	}
+}
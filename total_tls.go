@@ -9,7 +9,11 @@ import (
 )
 
 type TotalTLS struct {
-	Enabled              *bool  `json:"enabled,omitempty"`
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CertificateAuthority issues the certificates, such as
+	// CertificateAuthorityLetsEncrypt, CertificateAuthorityGoogle or
+	// CertificateAuthoritySSLCom. The zone's default is used if empty.
 	CertificateAuthority string `json:"certificate_authority,omitempty"`
 	ValidityDays         int    `json:"validity_days,omitempty"`
 }
@@ -0,0 +1,68 @@
+package cloudflare
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// TraceRequest describes an API call a span is started for.
+type TraceRequest struct {
+	Method string
+
+	// PathTemplate is the request path with IDs replaced by "{id}", such as
+	// "/zones/{id}/dns_records/{id}", so spans of the same endpoint can be
+	// grouped. Names such as Worker script names are kept as is.
+	PathTemplate string
+}
+
+// TraceResult describes how an API call ended.
+type TraceResult struct {
+	// StatusCode is the status of the last response, or 0 if none was
+	// received.
+	StatusCode int
+
+	// Retries is the number of attempts made after the first one.
+	Retries int
+
+	// RayID is the cf-ray header of the last response.
+	RayID string
+
+	Err error
+}
+
+// TraceSpan is a span started by a Tracer.
+type TraceSpan interface {
+	End(result TraceResult)
+}
+
+// Tracer starts a span around each API call, including its retries. The
+// returned context is used for the HTTP requests so that instrumented
+// transports create child spans. It lets tracing libraries be plugged in
+// without this package depending on them; an adapter starts a span from its
+// tracer in Start, sets the attributes and status in End and ends it. For
+// OpenTelemetry, use WithTracerProvider of the
+// github.com/cloudflare/cloudflare-go/otel module.
+//
+// Spans of streaming calls, such as GetLogpullReceived, end once the
+// response is received, before its body is read.
+type Tracer interface {
+	Start(ctx context.Context, request TraceRequest) (context.Context, TraceSpan)
+}
+
+// pathTemplateIDRegex matches path segments that are resource IDs: tags,
+// UUIDs and numeric IDs.
+var pathTemplateIDRegex = regexp.MustCompile(`^([0-9a-fA-F]{32}|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]+)$`)
+
+// pathTemplate returns the path of uri without its query string and with
+// resource IDs replaced by "{id}".
+func pathTemplate(uri string) string {
+	path, _, _ := strings.Cut(uri, "?")
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if pathTemplateIDRegex.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
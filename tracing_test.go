@@ -0,0 +1,77 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTraceSpan struct {
+	request TraceRequest
+	result  *TraceResult
+}
+
+func (s *testTraceSpan) End(result TraceResult) {
+	s.result = &result
+}
+
+type testTracer struct {
+	spans []*testTraceSpan
+}
+
+type testTraceContextKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, request TraceRequest) (context.Context, TraceSpan) {
+	span := &testTraceSpan{request: request}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testTraceContextKey{}, span), span
+}
+
+func TestTracer(t *testing.T) {
+	tracer := &testTracer{}
+	setup(UsingTracer(tracer), UsingRetryPolicy(1, 0, 0))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records/372e67954025e0ba6aaa6d586b9e0b59", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("cf-ray", "8cbd2f1a4f1e5a2b-SJC")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 81044, "message": "Record not found"}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.GetDNSRecord(context.Background(), ZoneIdentifier(testZoneID), "372e67954025e0ba6aaa6d586b9e0b59")
+	assert.Error(t, err)
+
+	if assert.Len(t, tracer.spans, 1) {
+		span := tracer.spans[0]
+		assert.Equal(t, TraceRequest{Method: http.MethodGet, PathTemplate: "/zones/{id}/dns_records/{id}"}, span.request)
+		if assert.NotNil(t, span.result) {
+			assert.Equal(t, http.StatusNotFound, span.result.StatusCode)
+			assert.Equal(t, 1, span.result.Retries)
+			assert.Equal(t, "8cbd2f1a4f1e5a2b-SJC", span.result.RayID)
+			assert.Equal(t, err, span.result.Err)
+		}
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/ssl":                                    "/zones/{id}/settings/ssl",
+		"/accounts/023e105f4ecef8ad9ca31a8372d0c353/workers/scripts/my-worker":                    "/accounts/{id}/workers/scripts/my-worker",
+		"/accounts/023e105f4ecef8ad9ca31a8372d0c353/tunnels/f70ff985-a4ef-4643-bbbc-4a0ed4fc8415": "/accounts/{id}/tunnels/{id}",
+		"/user/tokens?page=2": "/user/tokens",
+		"/zones/023e105f4ecef8ad9ca31a8372d0c353/pagerules/12345": "/zones/{id}/pagerules/{id}",
+	}
+	for uri, want := range tests {
+		assert.Equal(t, want, pathTemplate(uri), uri)
+	}
+}
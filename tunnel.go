@@ -54,6 +54,23 @@ type Tunnel struct {
 	RemoteConfig   bool               `json:"remote_config,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the tunnel secret.
+func (t Tunnel) String() string {
+	return redactedString(t.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the tunnel secret.
+func (t Tunnel) GoString() string {
+	return redactedGoString("Tunnel", t.redacted())
+}
+
+func (t Tunnel) redacted() interface{} {
+	type redacted Tunnel
+	r := redacted(t)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
 // Connection is the struct definition of a connection.
 type Connection struct {
 	ID            string             `json:"id,omitempty"`
@@ -126,11 +143,45 @@ type TunnelCreateParams struct {
 	ConfigSrc string `json:"config_src,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the tunnel secret.
+func (t TunnelCreateParams) String() string {
+	return redactedString(t.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the tunnel secret.
+func (t TunnelCreateParams) GoString() string {
+	return redactedGoString("TunnelCreateParams", t.redacted())
+}
+
+func (t TunnelCreateParams) redacted() interface{} {
+	type redacted TunnelCreateParams
+	r := redacted(t)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
 type TunnelUpdateParams struct {
 	Name   string `json:"name,omitempty"`
 	Secret string `json:"tunnel_secret,omitempty"`
 }
 
+// String implements fmt.Stringer, redacting the tunnel secret.
+func (t TunnelUpdateParams) String() string {
+	return redactedString(t.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the tunnel secret.
+func (t TunnelUpdateParams) GoString() string {
+	return redactedGoString("TunnelUpdateParams", t.redacted())
+}
+
+func (t TunnelUpdateParams) redacted() interface{} {
+	type redacted TunnelUpdateParams
+	r := redacted(t)
+	r.Secret = redact(r.Secret)
+	return r
+}
+
 type UnvalidatedIngressRule struct {
 	Hostname      string               `json:"hostname,omitempty"`
 	Path          string               `json:"path,omitempty"`
@@ -5,43 +5,89 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/goccy/go-json"
 )
 
-var ErrMissingSiteKey = errors.New("required site key missing")
+var (
+	ErrMissingSiteKey         = errors.New("required site key missing")
+	ErrMissingTurnstileSecret = errors.New("required Turnstile secret missing")
+	ErrMissingTurnstileToken  = errors.New("required Turnstile token missing")
+)
+
+// Clearance levels a Turnstile widget can issue. A widget with a clearance
+// level other than TurnstileClearanceLevelNone issues a cf_clearance cookie
+// on solve, pre-clearing the visitor for challenges of up to that level on
+// the widget's domains.
+//
+// Documentation: https://developers.cloudflare.com/turnstile/concepts/pre-clearance-support/
+const (
+	TurnstileClearanceLevelNone        = "no_clearance"
+	TurnstileClearanceLevelJSChallenge = "jschallenge"
+	TurnstileClearanceLevelManaged     = "managed"
+	TurnstileClearanceLevelInteractive = "interactive"
+)
+
+// Modes of a Turnstile widget.
+const (
+	TurnstileModeManaged        = "managed"
+	TurnstileModeNonInteractive = "non-interactive"
+	TurnstileModeInvisible      = "invisible"
+)
 
 type TurnstileWidget struct {
-	SiteKey      string     `json:"sitekey,omitempty"`
-	Secret       string     `json:"secret,omitempty"`
-	CreatedOn    *time.Time `json:"created_on,omitempty"`
-	ModifiedOn   *time.Time `json:"modified_on,omitempty"`
-	Name         string     `json:"name,omitempty"`
-	Domains      []string   `json:"domains,omitempty"`
-	Mode         string     `json:"mode,omitempty"`
-	BotFightMode bool       `json:"bot_fight_mode,omitempty"`
-	Region       string     `json:"region,omitempty"`
-	OffLabel     bool       `json:"offlabel,omitempty"`
+	SiteKey        string     `json:"sitekey,omitempty"`
+	Secret         string     `json:"secret,omitempty"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	ModifiedOn     *time.Time `json:"modified_on,omitempty"`
+	Name           string     `json:"name,omitempty"`
+	Domains        []string   `json:"domains,omitempty"`
+	Mode           string     `json:"mode,omitempty"`
+	BotFightMode   bool       `json:"bot_fight_mode,omitempty"`
+	Region         string     `json:"region,omitempty"`
+	OffLabel       bool       `json:"offlabel,omitempty"`
+	ClearanceLevel string     `json:"clearance_level,omitempty"`
+}
+
+// String implements fmt.Stringer, redacting the widget secret.
+func (t TurnstileWidget) String() string {
+	return redactedString(t.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the widget secret.
+func (t TurnstileWidget) GoString() string {
+	return redactedGoString("TurnstileWidget", t.redacted())
+}
+
+func (t TurnstileWidget) redacted() interface{} {
+	type redacted TurnstileWidget
+	r := redacted(t)
+	r.Secret = redact(r.Secret)
+	return r
 }
 
 type CreateTurnstileWidgetParams struct {
-	Name         string   `json:"name,omitempty"`
-	Domains      []string `json:"domains,omitempty"`
-	Mode         string   `json:"mode,omitempty"`
-	BotFightMode bool     `json:"bot_fight_mode,omitempty"`
-	Region       string   `json:"region,omitempty"`
-	OffLabel     bool     `json:"offlabel,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Domains        []string `json:"domains,omitempty"`
+	Mode           string   `json:"mode,omitempty"`
+	BotFightMode   bool     `json:"bot_fight_mode,omitempty"`
+	Region         string   `json:"region,omitempty"`
+	OffLabel       bool     `json:"offlabel,omitempty"`
+	ClearanceLevel string   `json:"clearance_level,omitempty"`
 }
 
 type UpdateTurnstileWidgetParams struct {
-	SiteKey      string   `json:"-"`
-	Name         string   `json:"name,omitempty"`
-	Domains      []string `json:"domains,omitempty"`
-	Mode         string   `json:"mode,omitempty"`
-	BotFightMode bool     `json:"bot_fight_mode,omitempty"`
-	Region       string   `json:"region,omitempty"`
-	OffLabel     bool     `json:"offlabel,omitempty"`
+	SiteKey        string   `json:"-"`
+	Name           string   `json:"name,omitempty"`
+	Domains        []string `json:"domains,omitempty"`
+	Mode           string   `json:"mode,omitempty"`
+	BotFightMode   bool     `json:"bot_fight_mode,omitempty"`
+	Region         string   `json:"region,omitempty"`
+	OffLabel       bool     `json:"offlabel,omitempty"`
+	ClearanceLevel string   `json:"clearance_level,omitempty"`
 }
 
 type TurnstileWidgetResponse struct {
@@ -243,3 +289,87 @@ func (api *API) DeleteTurnstileWidget(ctx context.Context, rc *ResourceContainer
 
 	return nil
 }
+
+// turnstileSiteverifyURL is the endpoint Turnstile tokens are validated
+// against. It is not part of the v4 API.
+var turnstileSiteverifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+// VerifyTurnstileTokenParams is a token submitted by a visitor, to be
+// validated with the secret of the widget that issued it.
+type VerifyTurnstileTokenParams struct {
+	Secret string
+	Token  string
+
+	// RemoteIP is the visitor's IP address, checked against the one the
+	// token was issued to if given.
+	RemoteIP string
+
+	// IdempotencyKey allows a token to be validated more than once, for
+	// example when retrying, with the same key.
+	IdempotencyKey string
+}
+
+// TurnstileVerification is the result of validating a Turnstile token.
+type TurnstileVerification struct {
+	Success     bool      `json:"success"`
+	ChallengeTS time.Time `json:"challenge_ts"`
+	Hostname    string    `json:"hostname"`
+	ErrorCodes  []string  `json:"error-codes"`
+	Action      string    `json:"action"`
+	CData       string    `json:"cdata"`
+	Metadata    struct {
+		EphemeralID string `json:"ephemeral_id,omitempty"`
+	} `json:"metadata"`
+}
+
+// VerifyTurnstileToken validates a Turnstile token server-side. A token that
+// is invalid, expired or already used is not an error; Success is false
+// and ErrorCodes gives the reason. Tokens can be validated only once unless
+// an IdempotencyKey is given.
+//
+// API reference: https://developers.cloudflare.com/turnstile/get-started/server-side-validation/
+func (api *API) VerifyTurnstileToken(ctx context.Context, params VerifyTurnstileTokenParams) (TurnstileVerification, error) {
+	if params.Secret == "" {
+		return TurnstileVerification{}, ErrMissingTurnstileSecret
+	}
+
+	if params.Token == "" {
+		return TurnstileVerification{}, ErrMissingTurnstileToken
+	}
+
+	form := url.Values{}
+	form.Set("secret", params.Secret)
+	form.Set("response", params.Token)
+	if params.RemoteIP != "" {
+		form.Set("remoteip", params.RemoteIP)
+	}
+	if params.IdempotencyKey != "" {
+		form.Set("idempotency_key", params.IdempotencyKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, turnstileSiteverifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return TurnstileVerification{}, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if api.UserAgent != "" {
+		req.Header.Set("User-Agent", api.UserAgent)
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return TurnstileVerification{}, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return TurnstileVerification{}, fmt.Errorf("%s: HTTP status %d", errRequestNotSuccessful, resp.StatusCode)
+	}
+
+	var r TurnstileVerification
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return TurnstileVerification{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r, nil
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -239,6 +240,42 @@ func TestTurnstileWidgets_Update(t *testing.T) {
 	}
 }
 
+func TestTurnstileWidgets_UpdateClearanceLevel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/challenges/widgets/"+testTurnstileWidgetSiteKey, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "login", "domains": ["example.com"], "mode": "managed", "clearance_level": "interactive"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `
+{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "sitekey": "0x4AAF00AAAABn0R22HWm-YUc",
+    "name": "login",
+    "domains": ["example.com"],
+    "mode": "managed",
+    "clearance_level": "interactive"
+  }
+}`)
+	})
+
+	out, err := client.UpdateTurnstileWidget(context.Background(), AccountIdentifier(testAccountID), UpdateTurnstileWidgetParams{
+		SiteKey:        testTurnstileWidgetSiteKey,
+		Name:           "login",
+		Domains:        []string{"example.com"},
+		Mode:           "managed",
+		ClearanceLevel: TurnstileClearanceLevelInteractive,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, TurnstileClearanceLevelInteractive, out.ClearanceLevel)
+	}
+}
+
 func TestTurnstileWidgets_RotateSecret(t *testing.T) {
 	setup()
 	defer teardown()
@@ -332,3 +369,48 @@ func TestTurnstileWidgets_Delete(t *testing.T) {
 	err = client.DeleteTurnstileWidget(context.Background(), AccountIdentifier(testAccountID), testTurnstileWidgetSiteKey)
 	assert.NoError(t, err)
 }
+
+func TestVerifyTurnstileToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	defaultURL := turnstileSiteverifyURL
+	turnstileSiteverifyURL = server.URL + "/turnstile/v0/siteverify"
+	defer func() { turnstileSiteverifyURL = defaultURL }()
+
+	mux.HandleFunc("/turnstile/v0/siteverify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc", r.PostForm.Get("secret"))
+		assert.Equal(t, "XXXX.DUMMY.TOKEN", r.PostForm.Get("response"))
+		assert.Equal(t, "203.0.113.1", r.PostForm.Get("remoteip"))
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"challenge_ts": "2022-02-28T15:14:30.096Z",
+			"hostname": "example.com",
+			"error-codes": [],
+			"action": "login",
+			"cdata": "sessionid-123456789",
+			"metadata": {"ephemeral_id": "x:9f78e0ed210960d7693b167e"}
+		}`)
+	})
+
+	actual, err := client.VerifyTurnstileToken(context.Background(), VerifyTurnstileTokenParams{
+		Secret:   "0x4AAF00AAAABn0R22HWm098HVBjhdsYUc",
+		Token:    "XXXX.DUMMY.TOKEN",
+		RemoteIP: "203.0.113.1",
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Success)
+		assert.Equal(t, "example.com", actual.Hostname)
+		assert.Equal(t, "login", actual.Action)
+		assert.Equal(t, "x:9f78e0ed210960d7693b167e", actual.Metadata.EphemeralID)
+		assert.Equal(t, time.Date(2022, 2, 28, 15, 14, 30, 96000000, time.UTC), actual.ChallengeTS)
+	}
+
+	_, err = client.VerifyTurnstileToken(context.Background(), VerifyTurnstileTokenParams{Secret: "secret"})
+	assert.Equal(t, ErrMissingTurnstileToken, err)
+}
@@ -0,0 +1,363 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingURLScanURL = errors.New("required URL to scan missing")
+	ErrMissingURLScanID  = errors.New("required URL scan ID missing")
+)
+
+// URL scan visibilities. Unlisted scans are only returned by searches of
+// the account that submitted them.
+const (
+	URLScanVisibilityPublic   = "Public"
+	URLScanVisibilityUnlisted = "Unlisted"
+)
+
+// URL scan screenshot resolutions.
+const (
+	URLScanResolutionDesktop = "desktop"
+	URLScanResolutionMobile  = "mobile"
+	URLScanResolutionTablet  = "tablet"
+)
+
+// URLScanTaskStatusFinished is the status of a scan that is no longer queued
+// or running. Whether it succeeded is reported by URLScanTask.Success.
+const URLScanTaskStatusFinished = "Finished"
+
+// SubmitURLScanParams is a URL to scan.
+type SubmitURLScanParams struct {
+	URL                    string            `json:"url"`
+	Visibility             string            `json:"visibility,omitempty"`
+	ScreenshotsResolutions []string          `json:"screenshotsResolutions,omitempty"`
+	CustomHeaders          map[string]string `json:"customHeaders,omitempty"`
+}
+
+// URLScanSubmission is a submitted, not yet finished, scan.
+type URLScanSubmission struct {
+	UUID       string     `json:"uuid"`
+	URL        string     `json:"url"`
+	Visibility string     `json:"visibility"`
+	Time       *time.Time `json:"time"`
+}
+
+// URLScanSubmissionResponse is the API response, containing a URL scan
+// submission.
+type URLScanSubmissionResponse struct {
+	Response
+	Result URLScanSubmission `json:"result"`
+}
+
+// URLScanTask is the progress of a scan.
+type URLScanTask struct {
+	UUID           string             `json:"uuid"`
+	URL            string             `json:"url"`
+	EffectiveURL   string             `json:"effectiveUrl"`
+	Status         string             `json:"status"`
+	Success        bool               `json:"success"`
+	Visibility     string             `json:"visibility"`
+	ClientLocation string             `json:"clientLocation"`
+	ClientType     string             `json:"clientType"`
+	Time           *time.Time         `json:"time"`
+	TimeEnd        *time.Time         `json:"timeEnd"`
+	Errors         []URLScanTaskError `json:"errors"`
+}
+
+// URLScanTaskError is the reason a scan failed.
+type URLScanTaskError struct {
+	Message string `json:"message"`
+}
+
+// URLScanPage is the page a scanned URL resolved to.
+type URLScanPage struct {
+	URL     string `json:"url"`
+	Domain  string `json:"domain"`
+	Country string `json:"country"`
+	IP      string `json:"ip"`
+	ASN     string `json:"asn"`
+	ASNName string `json:"asnname"`
+	Status  string `json:"status"`
+	Title   string `json:"title"`
+}
+
+// URLScanVerdicts is the verdict of a scan on whether the page is malicious.
+type URLScanVerdicts struct {
+	Overall struct {
+		Malicious  bool     `json:"malicious"`
+		Categories []string `json:"categories"`
+		Phishing   []string `json:"phishing"`
+	} `json:"overall"`
+}
+
+// URLScan is the report of a scan. Page and Verdicts are only populated
+// once the scan has finished.
+type URLScan struct {
+	Task     URLScanTask     `json:"task"`
+	Page     URLScanPage     `json:"page"`
+	Verdicts URLScanVerdicts `json:"verdicts"`
+}
+
+// URLScanResponse is the API response, containing a URL scan report.
+type URLScanResponse struct {
+	Response
+	Result struct {
+		Scan URLScan `json:"scan"`
+	} `json:"result"`
+}
+
+// SearchURLScansParams filters URL scans. All filters are optional.
+type SearchURLScansParams struct {
+	URL          string     `url:"url,omitempty"`
+	Hostname     string     `url:"hostname,omitempty"`
+	Path         string     `url:"path,omitempty"`
+	IP           string     `url:"ip,omitempty"`
+	Hash         string     `url:"hash,omitempty"`
+	DateStart    *time.Time `url:"date_start,omitempty"`
+	DateEnd      *time.Time `url:"date_end,omitempty"`
+	AccountScans *bool      `url:"account_scans,omitempty"`
+	Limit        int        `url:"limit,omitempty"`
+}
+
+// URLScanSearchResult is a scan matching a search.
+type URLScanSearchResult struct {
+	UUID       string     `json:"uuid"`
+	URL        string     `json:"url"`
+	Country    string     `json:"country"`
+	Success    bool       `json:"success"`
+	Visibility string     `json:"visibility"`
+	Time       *time.Time `json:"time"`
+}
+
+// URLScanSearchResponse is the API response, containing URL scans.
+type URLScanSearchResponse struct {
+	Response
+	Result struct {
+		Tasks []URLScanSearchResult `json:"tasks"`
+	} `json:"result"`
+}
+
+// URLScanHARResponse is the API response, containing the HAR of a scan.
+type URLScanHARResponse struct {
+	Response
+	Result struct {
+		HAR json.RawMessage `json:"har"`
+	} `json:"result"`
+}
+
+// SubmitURLScan submits a URL to be scanned. Use WaitForURLScan or
+// GetURLScan with the returned UUID for the report.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-create-scan
+func (api *API) SubmitURLScan(ctx context.Context, rc *ResourceContainer, params SubmitURLScanParams) (URLScanSubmission, error) {
+	if rc.Level != AccountRouteLevel {
+		return URLScanSubmission{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return URLScanSubmission{}, ErrMissingAccountID
+	}
+
+	if params.URL == "" {
+		return URLScanSubmission{}, ErrMissingURLScanURL
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/urlscanner/scan", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return URLScanSubmission{}, err
+	}
+
+	var r URLScanSubmissionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return URLScanSubmission{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// GetURLScan returns the report of a scan, which is incomplete until its
+// task has finished.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-get-scan
+func (api *API) GetURLScan(ctx context.Context, rc *ResourceContainer, scanID string) (URLScan, error) {
+	if rc.Level != AccountRouteLevel {
+		return URLScan{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return URLScan{}, ErrMissingAccountID
+	}
+
+	if scanID == "" {
+		return URLScan{}, ErrMissingURLScanID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/urlscanner/scan/%s", rc.Identifier, scanID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return URLScan{}, err
+	}
+
+	var r URLScanResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return URLScan{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.Scan, nil
+}
+
+func urlScanStatus(scan URLScan) Operation {
+	status := Operation{ID: scan.Task.UUID, State: OperationStateRunning}
+	if scan.Task.Status == URLScanTaskStatusFinished {
+		if scan.Task.Success {
+			status.State = OperationStateSucceeded
+		} else {
+			status.State = OperationStateFailed
+			if len(scan.Task.Errors) > 0 {
+				status.Error = scan.Task.Errors[0].Message
+			}
+		}
+	}
+	return status
+}
+
+// WaitForURLScan polls a scan with exponential backoff until it finishes
+// and returns its report. An error is returned if the scan failed, is still
+// running after 16 polls, or ctx is done.
+func (api *API) WaitForURLScan(ctx context.Context, rc *ResourceContainer, scanID string) (URLScan, error) {
+	var scan URLScan
+	fetch := func(ctx context.Context, id string) (Operation, error) {
+		var err error
+		scan, err = api.GetURLScan(ctx, rc, id)
+		if err != nil {
+			return Operation{}, err
+		}
+		return urlScanStatus(scan), nil
+	}
+
+	_, err := api.WaitForOperation(ctx, scanID, fetch, WaitForOperationParams{
+		Interval:    2 * time.Second,
+		MaxInterval: 16 * time.Second,
+		MaxAttempts: 16,
+	})
+	return scan, err
+}
+
+// GetURLScanScreenshot returns the PNG screenshot of a finished scan at a
+// resolution, which defaults to desktop.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-get-scan-screenshot
+func (api *API) GetURLScanScreenshot(ctx context.Context, rc *ResourceContainer, scanID, resolution string) ([]byte, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	if scanID == "" {
+		return nil, ErrMissingURLScanID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/urlscanner/scan/%s/screenshot", rc.Identifier, scanID)
+	if resolution != "" {
+		uri += "?resolution=" + resolution
+	}
+
+	return api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+}
+
+// GetURLScanHAR returns the HTTP Archive of the requests made while
+// scanning.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-get-scan-har
+func (api *API) GetURLScanHAR(ctx context.Context, rc *ResourceContainer, scanID string) (json.RawMessage, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	if scanID == "" {
+		return nil, ErrMissingURLScanID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/urlscanner/scan/%s/har", rc.Identifier, scanID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r URLScanHARResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.HAR, nil
+}
+
+// GetURLScanDOM returns the rendered HTML of the scanned page.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-get-scan-dom
+func (api *API) GetURLScanDOM(ctx context.Context, rc *ResourceContainer, scanID string) (string, error) {
+	if rc.Level != AccountRouteLevel {
+		return "", ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return "", ErrMissingAccountID
+	}
+
+	if scanID == "" {
+		return "", ErrMissingURLScanID
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/urlscanner/v2/dom/%s", rc.Identifier, scanID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(res), nil
+}
+
+// SearchURLScans returns the scans matching the filters, most recent first.
+//
+// API reference: https://developers.cloudflare.com/api/operations/urlscanner-search-scans
+func (api *API) SearchURLScans(ctx context.Context, rc *ResourceContainer, params SearchURLScansParams) ([]URLScanSearchResult, error) {
+	if rc.Level != AccountRouteLevel {
+		return []URLScanSearchResult{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []URLScanSearchResult{}, ErrMissingAccountID
+	}
+
+	uri := buildURI(fmt.Sprintf("/accounts/%s/urlscanner/scan", rc.Identifier), params)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []URLScanSearchResult{}, err
+	}
+
+	var r URLScanSearchResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []URLScanSearchResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result.Tasks, nil
+}
@@ -0,0 +1,157 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testURLScanID = "f7ba6ee2-4dc0-4d7c-ba33-4a3d8d0b6a17"
+
+func TestSubmitURLScan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"uuid": "%s",
+				"url": "https://example.com/",
+				"visibility": "Unlisted",
+				"time": "2024-10-01T00:00:00Z"
+			}
+		}`, testURLScanID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/urlscanner/scan", handler)
+
+	actual, err := client.SubmitURLScan(context.Background(), AccountIdentifier(testAccountID), SubmitURLScanParams{
+		URL:        "https://example.com",
+		Visibility: URLScanVisibilityUnlisted,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testURLScanID, actual.UUID)
+		assert.Equal(t, URLScanVisibilityUnlisted, actual.Visibility)
+	}
+
+	_, err = client.SubmitURLScan(context.Background(), AccountIdentifier(testAccountID), SubmitURLScanParams{})
+	assert.Equal(t, ErrMissingURLScanURL, err)
+}
+
+func TestWaitForURLScan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"scan": {"task": {"uuid": "%s", "status": "InProgress", "success": true}}}}`, testURLScanID)
+			return
+		}
+
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"scan": {
+					"task": {"uuid": "%s", "url": "https://example.com/", "status": "Finished", "success": true},
+					"page": {"url": "https://example.com/", "domain": "example.com", "ip": "192.0.2.1", "status": "200"},
+					"verdicts": {"overall": {"malicious": false, "categories": [], "phishing": []}}
+				}
+			}
+		}`, testURLScanID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/urlscanner/scan/"+testURLScanID, handler)
+
+	// The first poll reports the scan as running; consume it here so that
+	// WaitForURLScan doesn't sleep.
+	ctx := context.Background()
+	scan, err := client.GetURLScan(ctx, AccountIdentifier(testAccountID), testURLScanID)
+	require.NoError(t, err)
+	assert.Equal(t, OperationStateRunning, urlScanStatus(scan).State)
+
+	actual, err := client.WaitForURLScan(ctx, AccountIdentifier(testAccountID), testURLScanID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "example.com", actual.Page.Domain)
+		assert.False(t, actual.Verdicts.Overall.Malicious)
+	}
+}
+
+func TestURLScanStatusFailed(t *testing.T) {
+	status := urlScanStatus(URLScan{Task: URLScanTask{
+		UUID:   testURLScanID,
+		Status: URLScanTaskStatusFinished,
+		Errors: []URLScanTaskError{{Message: "DNS resolution failed"}},
+	}})
+
+	assert.Equal(t, OperationStateFailed, status.State)
+	assert.Equal(t, "DNS resolution failed", status.Error)
+}
+
+func TestGetURLScanScreenshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "mobile", r.URL.Query().Get("resolution"))
+		w.Header().Set("content-type", "image/png")
+		_, _ = w.Write([]byte("\x89PNG"))
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/urlscanner/scan/"+testURLScanID+"/screenshot", handler)
+
+	actual, err := client.GetURLScanScreenshot(context.Background(), AccountIdentifier(testAccountID), testURLScanID, URLScanResolutionMobile)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("\x89PNG"), actual)
+	}
+}
+
+func TestSearchURLScans(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "example.com", r.URL.Query().Get("hostname"))
+		assert.Equal(t, "true", r.URL.Query().Get("account_scans"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"tasks": [
+					{"uuid": "%s", "url": "https://example.com/", "country": "US", "success": true, "visibility": "Public", "time": "2024-10-01T00:00:00Z"}
+				]
+			}
+		}`, testURLScanID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/urlscanner/scan", handler)
+
+	actual, err := client.SearchURLScans(context.Background(), AccountIdentifier(testAccountID), SearchURLScansParams{
+		Hostname:     "example.com",
+		AccountScans: BoolPtr(true),
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, actual, 1)
+		assert.Equal(t, testURLScanID, actual[0].UUID)
+	}
+}
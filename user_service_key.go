@@ -0,0 +1,107 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrUserServiceKeyAuth is returned when the Origin CA key is retrieved or
+// rotated by a client authenticated only by the Origin CA key itself.
+var ErrUserServiceKeyAuth = errors.New("the Origin CA key can only be managed using an API token or API key")
+
+// UserServiceKey is the Origin CA key of a user.
+type UserServiceKey struct {
+	Key string `json:"service_key"`
+}
+
+// String implements fmt.Stringer, redacting the key.
+func (k UserServiceKey) String() string {
+	return redactedString(k.redacted())
+}
+
+// GoString implements fmt.GoStringer, redacting the key.
+func (k UserServiceKey) GoString() string {
+	return redactedGoString("UserServiceKey", k.redacted())
+}
+
+func (k UserServiceKey) redacted() interface{} {
+	type redacted UserServiceKey
+	r := redacted(k)
+	r.Key = redact(r.Key)
+	return r
+}
+
+// UserServiceKeyResponse is the API response containing the Origin CA key.
+type UserServiceKeyResponse struct {
+	Response
+	Result UserServiceKey `json:"result"`
+}
+
+// originCAAuthType returns the authentication used for Origin CA
+// certificate requests: the Origin CA key if the client has one, otherwise
+// the client's own credentials.
+func (api *API) originCAAuthType() int {
+	if api.APIUserServiceKey != "" {
+		return AuthUserService
+	}
+	return api.authType
+}
+
+// userAuthType returns the client's credentials other than the Origin CA
+// key, which cannot be used to manage itself.
+func (api *API) userAuthType() (int, error) {
+	authType := api.authType &^ AuthUserService
+	if authType == 0 {
+		return 0, ErrUserServiceKeyAuth
+	}
+	return authType, nil
+}
+
+// GetUserServiceKey returns the Origin CA key of the authenticated user.
+func (api *API) GetUserServiceKey(ctx context.Context) (UserServiceKey, error) {
+	authType, err := api.userAuthType()
+	if err != nil {
+		return UserServiceKey{}, err
+	}
+
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodGet, "/user/service_keys/origincakey", nil, authType)
+	if err != nil {
+		return UserServiceKey{}, err
+	}
+
+	var r UserServiceKeyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserServiceKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// RotateUserServiceKey replaces the Origin CA key of the authenticated user,
+// invalidating the old key, and returns the new key. A client holding the
+// old Origin CA key is not updated; create a new client with
+// UsingUserServiceKey to use the new key.
+func (api *API) RotateUserServiceKey(ctx context.Context) (UserServiceKey, error) {
+	authType, err := api.userAuthType()
+	if err != nil {
+		return UserServiceKey{}, err
+	}
+
+	res, err := api.makeRequestWithAuthType(ctx, http.MethodPut, "/user/service_keys/origincakey", nil, authType)
+	if err != nil {
+		return UserServiceKey{}, err
+	}
+
+	var r UserServiceKeyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserServiceKey{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
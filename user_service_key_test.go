@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginCARoutesToUserServiceKey(t *testing.T) {
+	setup(UsingUserServiceKey("v1.0-origin-ca-key"))
+	defer teardown()
+
+	mux.HandleFunc("/certificates/328578533902268680", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "v1.0-origin-ca-key", r.Header.Get("X-Auth-User-Service-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-Email"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "328578533902268680", "expires_on": "2032-01-29 22:36:00 +0000 UTC"}}`)
+	})
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		assert.Empty(t, r.Header.Get("X-Auth-User-Service-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [], "result_info": {"page": 1, "per_page": 20, "count": 0, "total_count": 0, "total_pages": 1}}`)
+	})
+
+	_, err := client.GetOriginCACertificate(context.Background(), "328578533902268680")
+	assert.NoError(t, err)
+
+	_, err = client.ListZones(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestGetUserServiceKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/service_keys/origincakey", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "deadbeef", r.Header.Get("X-Auth-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"service_key": "v1.0-origin-ca-key"}}`)
+	})
+
+	actual, err := client.GetUserServiceKey(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, UserServiceKey{Key: "v1.0-origin-ca-key"}, actual)
+		assert.NotContains(t, actual.String(), "v1.0-origin-ca-key")
+	}
+}
+
+func TestRotateUserServiceKey(t *testing.T) {
+	setup(UsingUserServiceKey("v1.0-old-key"))
+	defer teardown()
+
+	mux.HandleFunc("/user/service_keys/origincakey", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		assert.Empty(t, r.Header.Get("X-Auth-User-Service-Key"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"service_key": "v1.0-new-key"}}`)
+	})
+
+	actual, err := client.RotateUserServiceKey(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "v1.0-new-key", actual.Key)
+		assert.Equal(t, "v1.0-old-key", client.APIUserServiceKey)
+	}
+
+	serviceKeyOnly, _ := NewWithUserServiceKey("v1.0-new-key")
+	_, err = serviceKeyOnly.RotateUserServiceKey(context.Background())
+	assert.ErrorIs(t, err, ErrUserServiceKeyAuth)
+}
@@ -43,6 +43,17 @@ type WaitingRoom struct {
 	CookieAttributes           *WaitingRoomCookieAttributes `json:"cookie_attributes,omitempty"`
 }
 
+// Statuses of a waiting room.
+const (
+	WaitingRoomStatusQueueing         = "queueing"
+	WaitingRoomStatusEventPrequeueing = "event_prequeueing"
+	WaitingRoomStatusNotQueueing      = "not_queueing"
+)
+
+// WaitingRoomRuleActionBypass lets requests matching a waiting room rule
+// skip the queue.
+const WaitingRoomRuleActionBypass = "bypass_waiting_room"
+
 // WaitingRoomStatus describes the status of a waiting room.
 type WaitingRoomStatus struct {
 	Status                    string `json:"status"`
@@ -52,6 +63,12 @@ type WaitingRoomStatus struct {
 	MaxEstimatedTimeMinutes   int    `json:"max_estimated_time_minutes"`
 }
 
+// MaxEstimatedWait returns the longest time a user is estimated to wait
+// before entering the origin.
+func (s WaitingRoomStatus) MaxEstimatedWait() time.Duration {
+	return time.Duration(s.MaxEstimatedTimeMinutes) * time.Minute
+}
+
 // WaitingRoomEvent describes a WaitingRoomEvent object.
 type WaitingRoomEvent struct {
 	EventEndTime          time.Time  `json:"event_end_time"`
@@ -72,6 +89,24 @@ type WaitingRoomEvent struct {
 	ShuffleAtEventStart   bool       `json:"shuffle_at_event_start"`
 }
 
+// Validate checks that the event has a start and end time in order, and
+// that prequeueing, if enabled, starts before the event.
+func (e WaitingRoomEvent) Validate() error {
+	if e.EventStartTime.IsZero() || e.EventEndTime.IsZero() {
+		return errors.New("waiting room event start and end times are required")
+	}
+
+	if !e.EventStartTime.Before(e.EventEndTime) {
+		return errors.New("waiting room event must start before it ends")
+	}
+
+	if e.PrequeueStartTime != nil && !e.PrequeueStartTime.Before(e.EventStartTime) {
+		return errors.New("waiting room event prequeueing must start before the event")
+	}
+
+	return nil
+}
+
 type WaitingRoomRule struct {
 	ID          string     `json:"id,omitempty"`
 	Version     string     `json:"version,omitempty"`
@@ -302,6 +337,10 @@ func (api *API) WaitingRoomPagePreview(ctx context.Context, zoneID, customHTML s
 //
 // API reference: https://api.cloudflare.com/#waiting-room-create-event
 func (api *API) CreateWaitingRoomEvent(ctx context.Context, zoneID string, waitingRoomID string, waitingRoomEvent WaitingRoomEvent) (*WaitingRoomEvent, error) {
+	if err := waitingRoomEvent.Validate(); err != nil {
+		return nil, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/waiting_rooms/%s/events", zoneID, waitingRoomID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, waitingRoomEvent)
 	if err != nil {
@@ -390,6 +429,10 @@ func (api *API) ChangeWaitingRoomEvent(ctx context.Context, zoneID, waitingRoomI
 //
 // API reference: https://api.cloudflare.com/#waiting-room-update-event
 func (api *API) UpdateWaitingRoomEvent(ctx context.Context, zoneID string, waitingRoomID string, waitingRoomEvent WaitingRoomEvent) (WaitingRoomEvent, error) {
+	if err := waitingRoomEvent.Validate(); err != nil {
+		return WaitingRoomEvent{}, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/waiting_rooms/%s/events/%s", zoneID, waitingRoomID, waitingRoomEvent.ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, waitingRoomEvent)
 	if err != nil {
@@ -438,9 +438,29 @@ func TestWaitingRoomStatus(t *testing.T) {
 	actual, err := client.WaitingRoomStatus(context.Background(), testZoneID, "699d98642c564d2e855e9661899b7252")
 	if assert.NoError(t, err) {
 		assert.Equal(t, want, actual)
+		assert.Equal(t, WaitingRoomStatusQueueing, actual.Status)
+		assert.Equal(t, 5*time.Minute, actual.MaxEstimatedWait())
 	}
 }
 
+func TestWaitingRoomEventValidate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert.NoError(t, waitingRoomEvent.Validate())
+
+	ended := waitingRoomEvent
+	ended.EventEndTime = ended.EventStartTime
+	assert.Error(t, ended.Validate())
+
+	late := waitingRoomEvent
+	late.PrequeueStartTime = &late.EventEndTime
+	assert.Error(t, late.Validate())
+
+	_, err := client.CreateWaitingRoomEvent(context.Background(), testZoneID, waitingRoomID, WaitingRoomEvent{Name: "no_times"})
+	assert.Error(t, err)
+}
+
 func TestWaitingRoomPagePreview(t *testing.T) {
 	setup()
 	defer teardown()
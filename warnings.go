@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// Warning is a non-fatal message returned alongside a successful API
+// response, such as a partial success or a note about ignored fields.
+type Warning struct {
+	// Method and Path identify the request that returned the warning.
+	Method string
+	Path   string
+
+	Code    int
+	Message string
+}
+
+// WarningHandler is called once for every message returned in the
+// `messages` field of a successful response.
+type WarningHandler func(warning Warning)
+
+// Warnings returns the messages of the response as typed warnings. Method
+// and Path are left empty as a Response does not know which request it was
+// returned for.
+func (r Response) Warnings() []Warning {
+	warnings := make([]Warning, 0, len(r.Messages))
+	for _, m := range r.Messages {
+		warnings = append(warnings, Warning{Code: m.Code, Message: m.Message})
+	}
+
+	return warnings
+}
+
+// parseWarnings extracts the `messages` of a JSON response body. Bodies that
+// are not JSON objects, such as file downloads, yield no warnings.
+func parseWarnings(method, path string, body []byte) []Warning {
+	trimmed := strings.TrimSpace(string(body))
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil
+	}
+
+	var r Response
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil
+	}
+
+	warnings := r.Warnings()
+	for i := range warnings {
+		warnings[i].Method = method
+		warnings[i].Path = path
+	}
+
+	return warnings
+}
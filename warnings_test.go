@@ -0,0 +1,51 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseWarnings(t *testing.T) {
+	r := Response{Messages: []ResponseInfo{{Code: 10000, Message: "some records were skipped"}}}
+	assert.Equal(t, []Warning{{Code: 10000, Message: "some records were skipped"}}, r.Warnings())
+	assert.Empty(t, Response{}.Warnings())
+}
+
+func TestUsingWarningHandler(t *testing.T) {
+	var warnings []Warning
+	setup(UsingWarningHandler(func(w Warning) {
+		warnings = append(warnings, w)
+	}))
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/partial", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [{ "code": 1001, "message": "2 of 3 records imported" }],
+			"result": {}
+		}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "text/plain")
+		fmt.Fprint(w, "example.com. 300 IN A 192.0.2.1")
+	})
+
+	_, err := client.Raw(context.Background(), http.MethodGet, "/zones/"+testZoneID+"/download", nil, nil)
+	assert.Error(t, err)
+	assert.Empty(t, warnings)
+
+	_, err = client.Raw(context.Background(), http.MethodPost, "/zones/"+testZoneID+"/partial", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Warning{{
+		Method:  http.MethodPost,
+		Path:    "/zones/" + testZoneID + "/partial",
+		Code:    1001,
+		Message: "2 of 3 records imported",
+	}}, warnings)
+}
@@ -17,6 +17,12 @@ var (
 	ErrMissingName = errors.New("name required but missing")
 	// ErrMissingTarget is for when target is required but missing.
 	ErrMissingTarget = errors.New("target required but missing")
+	// ErrMissingWeb3ContentListEntryID is for when a content list entry
+	// identifier is required but missing.
+	ErrMissingWeb3ContentListEntryID = errors.New("content list entry identifier required but missing")
+	// ErrMissingWeb3ContentListEntryContent is for when a content list entry
+	// CID or content path is required but missing.
+	ErrMissingWeb3ContentListEntryContent = errors.New("content list entry content required but missing")
 )
 
 // Web3Hostname represents a web3 hostname.
@@ -44,7 +50,7 @@ type Web3HostnameListResponse struct {
 
 // Web3HostnameCreateParameters represents the parameters for creating a web3 hostname.
 type Web3HostnameCreateParameters struct {
-	ZoneID      string
+	ZoneID      string `json:"-"`
 	Name        string `json:"name,omitempty"`
 	Target      string `json:"target,omitempty"`
 	Description string `json:"description,omitempty"`
@@ -65,8 +71,8 @@ type Web3HostnameDetailsParameters struct {
 
 // Web3HostnameUpdateParameters represents the parameters for editing a web3 hostname.
 type Web3HostnameUpdateParameters struct {
-	ZoneID      string
-	Identifier  string
+	ZoneID      string `json:"-"`
+	Identifier  string `json:"-"`
 	Description string `json:"description,omitempty"`
 	DNSLink     string `json:"dnslink,omitempty"`
 }
@@ -146,7 +152,7 @@ func (api *API) GetWeb3Hostname(ctx context.Context, params Web3HostnameDetailsP
 	}
 	var web3Response Web3HostnameResponse
 	if err := json.Unmarshal(res, &web3Response); err != nil {
-		return Web3Hostname{}, err
+		return Web3Hostname{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return web3Response.Result, nil
 }
@@ -169,7 +175,7 @@ func (api *API) UpdateWeb3Hostname(ctx context.Context, params Web3HostnameUpdat
 	}
 	var web3Response Web3HostnameResponse
 	if err := json.Unmarshal(res, &web3Response); err != nil {
-		return Web3Hostname{}, err
+		return Web3Hostname{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return web3Response.Result, nil
 }
@@ -192,7 +198,266 @@ func (api *API) DeleteWeb3Hostname(ctx context.Context, params Web3HostnameDetai
 	}
 	var web3Response Web3HostnameDeleteResponse
 	if err := json.Unmarshal(res, &web3Response); err != nil {
-		return Web3HostnameDeleteResult{}, err
+		return Web3HostnameDeleteResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
 	}
 	return web3Response.Result, nil
 }
+
+// Web3ContentListActionBlock blocks the content of a content list. It is the
+// only action supported by IPFS universal path gateways.
+const Web3ContentListActionBlock = "block"
+
+// Types of web3 content list entries.
+const (
+	Web3ContentListEntryTypeCID         = "cid"
+	Web3ContentListEntryTypeContentPath = "content_path"
+)
+
+// Web3ContentList represents the content list of an IPFS universal path
+// gateway.
+type Web3ContentList struct {
+	Action  string                 `json:"action,omitempty"`
+	Entries []Web3ContentListEntry `json:"entries,omitempty"`
+}
+
+// Web3ContentListEntry represents a CID or content path of a content list.
+type Web3ContentListEntry struct {
+	ID          string     `json:"id,omitempty"`
+	Content     string     `json:"content,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time `json:"modified_on,omitempty"`
+}
+
+// Web3ContentListResponse represents the API response body for a content list.
+type Web3ContentListResponse struct {
+	Response
+	Result Web3ContentList `json:"result,omitempty"`
+}
+
+// Web3ContentListEntryResponse represents the API response body for a content list entry.
+type Web3ContentListEntryResponse struct {
+	Response
+	Result Web3ContentListEntry `json:"result,omitempty"`
+}
+
+// Web3ContentListUpdateParameters represents the parameters for replacing a
+// content list.
+type Web3ContentListUpdateParameters struct {
+	ZoneID     string                 `json:"-"`
+	Identifier string                 `json:"-"`
+	Action     string                 `json:"action"`
+	Entries    []Web3ContentListEntry `json:"entries"`
+}
+
+// Web3ContentListEntryCreateParameters represents the parameters for adding
+// an entry to a content list.
+type Web3ContentListEntryCreateParameters struct {
+	ZoneID      string `json:"-"`
+	Identifier  string `json:"-"`
+	Content     string `json:"content"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+// Web3ContentListEntryDetailsParameters represents the parameters for
+// getting or deleting a single content list entry.
+type Web3ContentListEntryDetailsParameters struct {
+	ZoneID     string `json:"-"`
+	Identifier string `json:"-"`
+	EntryID    string `json:"-"`
+}
+
+// Web3ContentListEntryUpdateParameters represents the parameters for
+// editing a content list entry.
+type Web3ContentListEntryUpdateParameters struct {
+	ZoneID      string `json:"-"`
+	Identifier  string `json:"-"`
+	EntryID     string `json:"-"`
+	Content     string `json:"content"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+func web3ContentListURI(zoneID, identifier string) string {
+	return fmt.Sprintf("/zones/%s/web3/hostnames/%s/ipfs_universal_path/content_list", zoneID, identifier)
+}
+
+// GetWeb3HostnameContentList gets the action of the content list of an IPFS
+// universal path gateway. Use ListWeb3HostnameContentListEntries for its
+// entries.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-ipfs-universal-path-gateway-content-list-details
+func (api *API) GetWeb3HostnameContentList(ctx context.Context, params Web3HostnameDetailsParameters) (Web3ContentList, error) {
+	if params.ZoneID == "" {
+		return Web3ContentList{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return Web3ContentList{}, ErrMissingIdentifier
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, web3ContentListURI(params.ZoneID, params.Identifier), nil)
+	if err != nil {
+		return Web3ContentList{}, err
+	}
+	var web3Response Web3ContentListResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return Web3ContentList{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result, nil
+}
+
+// UpdateWeb3HostnameContentList replaces the action and all entries of the
+// content list of an IPFS universal path gateway.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-update-ipfs-universal-path-gateway-content-list
+func (api *API) UpdateWeb3HostnameContentList(ctx context.Context, params Web3ContentListUpdateParameters) (Web3ContentList, error) {
+	if params.ZoneID == "" {
+		return Web3ContentList{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return Web3ContentList{}, ErrMissingIdentifier
+	}
+	if params.Action == "" {
+		params.Action = Web3ContentListActionBlock
+	}
+	if params.Entries == nil {
+		params.Entries = []Web3ContentListEntry{}
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, web3ContentListURI(params.ZoneID, params.Identifier), params)
+	if err != nil {
+		return Web3ContentList{}, err
+	}
+	var web3Response Web3ContentListResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return Web3ContentList{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result, nil
+}
+
+// ListWeb3HostnameContentListEntries lists the entries of the content list
+// of an IPFS universal path gateway.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-list-ipfs-universal-path-gateway-content-list-entries
+func (api *API) ListWeb3HostnameContentListEntries(ctx context.Context, params Web3HostnameDetailsParameters) ([]Web3ContentListEntry, error) {
+	if params.ZoneID == "" {
+		return []Web3ContentListEntry{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return []Web3ContentListEntry{}, ErrMissingIdentifier
+	}
+
+	uri := web3ContentListURI(params.ZoneID, params.Identifier) + "/entries"
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Web3ContentListEntry{}, err
+	}
+	var web3Response Web3ContentListResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return []Web3ContentListEntry{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result.Entries, nil
+}
+
+// CreateWeb3HostnameContentListEntry adds a CID or content path to the
+// content list of an IPFS universal path gateway.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-create-ipfs-universal-path-gateway-content-list-entry
+func (api *API) CreateWeb3HostnameContentListEntry(ctx context.Context, params Web3ContentListEntryCreateParameters) (Web3ContentListEntry, error) {
+	if params.ZoneID == "" {
+		return Web3ContentListEntry{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return Web3ContentListEntry{}, ErrMissingIdentifier
+	}
+	if params.Content == "" {
+		return Web3ContentListEntry{}, ErrMissingWeb3ContentListEntryContent
+	}
+
+	uri := web3ContentListURI(params.ZoneID, params.Identifier) + "/entries"
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+	var web3Response Web3ContentListEntryResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result, nil
+}
+
+// GetWeb3HostnameContentListEntry gets a single content list entry.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-ipfs-universal-path-gateway-content-list-entry-details
+func (api *API) GetWeb3HostnameContentListEntry(ctx context.Context, params Web3ContentListEntryDetailsParameters) (Web3ContentListEntry, error) {
+	if params.ZoneID == "" {
+		return Web3ContentListEntry{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return Web3ContentListEntry{}, ErrMissingIdentifier
+	}
+	if params.EntryID == "" {
+		return Web3ContentListEntry{}, ErrMissingWeb3ContentListEntryID
+	}
+
+	uri := fmt.Sprintf("%s/entries/%s", web3ContentListURI(params.ZoneID, params.Identifier), params.EntryID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+	var web3Response Web3ContentListEntryResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result, nil
+}
+
+// UpdateWeb3HostnameContentListEntry edits a content list entry.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-edit-ipfs-universal-path-gateway-content-list-entry
+func (api *API) UpdateWeb3HostnameContentListEntry(ctx context.Context, params Web3ContentListEntryUpdateParameters) (Web3ContentListEntry, error) {
+	if params.ZoneID == "" {
+		return Web3ContentListEntry{}, ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return Web3ContentListEntry{}, ErrMissingIdentifier
+	}
+	if params.EntryID == "" {
+		return Web3ContentListEntry{}, ErrMissingWeb3ContentListEntryID
+	}
+	if params.Content == "" {
+		return Web3ContentListEntry{}, ErrMissingWeb3ContentListEntryContent
+	}
+
+	uri := fmt.Sprintf("%s/entries/%s", web3ContentListURI(params.ZoneID, params.Identifier), params.EntryID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+	var web3Response Web3ContentListEntryResponse
+	if err := json.Unmarshal(res, &web3Response); err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return web3Response.Result, nil
+}
+
+// DeleteWeb3HostnameContentListEntry removes an entry from a content list.
+//
+// API Reference: https://developers.cloudflare.com/api/operations/web3-hostname-delete-ipfs-universal-path-gateway-content-list-entry
+func (api *API) DeleteWeb3HostnameContentListEntry(ctx context.Context, params Web3ContentListEntryDetailsParameters) error {
+	if params.ZoneID == "" {
+		return ErrMissingZoneID
+	}
+	if params.Identifier == "" {
+		return ErrMissingIdentifier
+	}
+	if params.EntryID == "" {
+		return ErrMissingWeb3ContentListEntryID
+	}
+
+	uri := fmt.Sprintf("%s/entries/%s", web3ContentListURI(params.ZoneID, params.Identifier), params.EntryID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
@@ -3,11 +3,13 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const testWeb3HostnameID = "9a7806061c88ada191ed06f989cc3dac"
@@ -226,3 +228,104 @@ func TestDeleteWeb3Hostname(t *testing.T) {
 		assert.Equal(t, testWeb3HostnameID, out.ID, "delete web3 response incorrect")
 	}
 }
+
+func TestUpdateWeb3HostnameContentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/web3/hostnames/%s/ipfs_universal_path/content_list", testZoneID, testWeb3HostnameID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"action": "block", "entries": [{"content": "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB", "type": "cid"}]}`, string(body))
+
+		fmt.Fprint(w, `{
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result": {"action": "block"}
+		}`)
+	})
+
+	out, err := client.UpdateWeb3HostnameContentList(context.Background(), Web3ContentListUpdateParameters{
+		ZoneID:     testZoneID,
+		Identifier: testWeb3HostnameID,
+		Entries: []Web3ContentListEntry{
+			{Content: "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB", Type: Web3ContentListEntryTypeCID},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Web3ContentListActionBlock, out.Action)
+	}
+}
+
+func TestListWeb3HostnameContentListEntries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/web3/hostnames/%s/ipfs_universal_path/content_list/entries", testZoneID, testWeb3HostnameID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		fmt.Fprint(w, `{
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result": {
+			"entries": [
+			  {
+				"id": "9a7806061c88ada191ed06f989cc3dac",
+				"content": "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB",
+				"type": "cid",
+				"description": "this is my content list entry",
+				"created_on": "2014-01-01T05:20:00.12345Z",
+				"modified_on": "2014-01-01T05:20:00.12345Z"
+			  }
+			]
+		  }
+		}`)
+	})
+
+	out, err := client.ListWeb3HostnameContentListEntries(context.Background(), Web3HostnameDetailsParameters{ZoneID: testZoneID, Identifier: testWeb3HostnameID})
+	if assert.NoError(t, err) {
+		require.Len(t, out, 1)
+		assert.Equal(t, "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB", out[0].Content)
+		assert.Equal(t, Web3ContentListEntryTypeCID, out[0].Type)
+	}
+}
+
+func TestCreateWeb3HostnameContentListEntry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/web3/hostnames/%s/ipfs_universal_path/content_list/entries", testZoneID, testWeb3HostnameID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"content": "/ipfs/QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB/index.html", "type": "content_path"}`, string(body))
+
+		fmt.Fprint(w, `{
+		  "success": true,
+		  "errors": [],
+		  "messages": [],
+		  "result": {
+			"id": "9a7806061c88ada191ed06f989cc3dac",
+			"content": "/ipfs/QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB/index.html",
+			"type": "content_path"
+		  }
+		}`)
+	})
+
+	_, err := client.CreateWeb3HostnameContentListEntry(context.Background(), Web3ContentListEntryCreateParameters{ZoneID: testZoneID, Identifier: testWeb3HostnameID})
+	assert.Equal(t, ErrMissingWeb3ContentListEntryContent, err)
+
+	out, err := client.CreateWeb3HostnameContentListEntry(context.Background(), Web3ContentListEntryCreateParameters{
+		ZoneID:     testZoneID,
+		Identifier: testWeb3HostnameID,
+		Content:    "/ipfs/QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB/index.html",
+		Type:       Web3ContentListEntryTypeContentPath,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "9a7806061c88ada191ed06f989cc3dac", out.ID)
+	}
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
@@ -15,6 +16,8 @@ import (
 	"github.com/goccy/go-json"
 )
 
+var ErrMissingTailConsumerService = errors.New("tail consumer requires a service name")
+
 // WorkerRequestParams provides parameters for worker requests for both enterprise and standard requests.
 type WorkerRequestParams struct {
 	ZoneID     string
@@ -100,18 +103,19 @@ type UpdateWorkersScriptContentParams struct {
 }
 
 type UpdateWorkersScriptSettingsParams struct {
-	ScriptName string
+	ScriptName string `json:"-"`
 
 	// Logpush opts the worker into Workers Logpush logging. A nil value leaves
 	// the current setting unchanged.
 	//
 	// Documentation: https://developers.cloudflare.com/workers/platform/logpush/
-	Logpush *bool
+	Logpush *bool `json:"logpush,omitempty"`
 
 	// TailConsumers specifies a list of Workers that will consume the logs of
-	// the attached Worker.
+	// the attached Worker. A nil value leaves the current consumers
+	// unchanged, an empty slice removes them.
 	// Documentation: https://developers.cloudflare.com/workers/platform/tail-workers/
-	TailConsumers *[]WorkersTailConsumer
+	TailConsumers *[]WorkersTailConsumer `json:"tail_consumers,omitempty"`
 
 	// Bindings should be a map where the keys are the binding name, and the
 	// values are the binding content
@@ -120,14 +124,14 @@ type UpdateWorkersScriptSettingsParams struct {
 	// CompatibilityDate is a date in the form yyyy-mm-dd,
 	// which will be used to determine which version of the Workers runtime is used.
 	//  https://developers.cloudflare.com/workers/platform/compatibility-dates/
-	CompatibilityDate string
+	CompatibilityDate string `json:"compatibility_date,omitempty"`
 
 	// CompatibilityFlags are the names of features of the Workers runtime to be enabled or disabled,
 	// usually used together with CompatibilityDate.
 	//  https://developers.cloudflare.com/workers/platform/compatibility-dates/#compatibility-flags
-	CompatibilityFlags []string
+	CompatibilityFlags []string `json:"compatibility_flags,omitempty"`
 
-	Placement *Placement
+	Placement *Placement `json:"placement,omitempty"`
 }
 
 // WorkerScriptParams provides a worker script and the associated bindings.
@@ -478,6 +482,10 @@ func (api *API) GetWorkersScriptSettings(ctx context.Context, rc *ResourceContai
 		return WorkerScriptSettingsResponse{}, ErrMissingAccountID
 	}
 
+	if scriptName == "" {
+		return WorkerScriptSettingsResponse{}, ErrMissingScriptName
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/workers/scripts/%s/settings", rc.Identifier, scriptName)
 	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodGet, uri, nil, nil)
 	var r WorkerScriptSettingsResponse
@@ -485,9 +493,9 @@ func (api *API) GetWorkersScriptSettings(ctx context.Context, rc *ResourceContai
 		return r, err
 	}
 
-	err = json.Unmarshal(res, &r)
+	r, err = unmarshalWorkerScriptSettings(res)
 	if err != nil {
-		return r, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		return r, err
 	}
 
 	r.Success = true
@@ -495,7 +503,31 @@ func (api *API) GetWorkersScriptSettings(ctx context.Context, rc *ResourceContai
 	return r, nil
 }
 
-// UpdateWorkersScriptSettings pushes only script metadata.
+// unmarshalWorkerScriptSettings reads the script settings from the result
+// of the response, or from the top level of older responses.
+func unmarshalWorkerScriptSettings(res []byte) (WorkerScriptSettingsResponse, error) {
+	var r WorkerScriptSettingsResponse
+	err := json.Unmarshal(res, &r)
+	if err != nil {
+		return r, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	var wrapped struct {
+		Result *WorkerMetaData `json:"result"`
+	}
+	err = json.Unmarshal(res, &wrapped)
+	if err != nil {
+		return r, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	if wrapped.Result != nil {
+		r.WorkerMetaData = *wrapped.Result
+	}
+
+	return r, nil
+}
+
+// UpdateWorkersScriptSettings pushes only script metadata, such as the
+// Logpush flag and tail consumers, without re-uploading the script.
 //
 // API reference: https://developers.cloudflare.com/api/operations/worker-script-patch-settings
 func (api *API) UpdateWorkersScriptSettings(ctx context.Context, rc *ResourceContainer, params UpdateWorkersScriptSettingsParams) (WorkerScriptSettingsResponse, error) {
@@ -507,6 +539,16 @@ func (api *API) UpdateWorkersScriptSettings(ctx context.Context, rc *ResourceCon
 		return WorkerScriptSettingsResponse{}, ErrMissingAccountID
 	}
 
+	if params.ScriptName == "" {
+		return WorkerScriptSettingsResponse{}, ErrMissingScriptName
+	}
+
+	if params.TailConsumers != nil {
+		if err := validateTailConsumers(params.ScriptName, *params.TailConsumers); err != nil {
+			return WorkerScriptSettingsResponse{}, err
+		}
+	}
+
 	body, err := json.Marshal(params)
 	if err != nil {
 		return WorkerScriptSettingsResponse{}, err
@@ -521,9 +563,9 @@ func (api *API) UpdateWorkersScriptSettings(ctx context.Context, rc *ResourceCon
 		return r, err
 	}
 
-	err = json.Unmarshal(res, &r)
+	r, err = unmarshalWorkerScriptSettings(res)
 	if err != nil {
-		return r, fmt.Errorf("%s: %w", errUnmarshalError, err)
+		return r, err
 	}
 
 	r.Success = true
@@ -531,6 +573,37 @@ func (api *API) UpdateWorkersScriptSettings(ctx context.Context, rc *ResourceCon
 	return r, nil
 }
 
+// validateTailConsumers rejects tail consumers without a service, duplicate
+// consumers and a script consuming its own events.
+func validateTailConsumers(scriptName string, consumers []WorkersTailConsumer) error {
+	seen := make(map[string]bool, len(consumers))
+	for _, c := range consumers {
+		if c.Service == "" {
+			return ErrMissingTailConsumerService
+		}
+
+		var environment, namespace string
+		if c.Environment != nil {
+			environment = *c.Environment
+		}
+		if c.Namespace != nil {
+			namespace = *c.Namespace
+		}
+
+		if c.Service == scriptName && namespace == "" {
+			return fmt.Errorf("worker %s cannot be its own tail consumer", scriptName)
+		}
+
+		key := namespace + "/" + c.Service + "/" + environment
+		if seen[key] {
+			return fmt.Errorf("duplicate tail consumer %s", c.Service)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
 // Returns content-type, body, error.
 func formatMultipartBody(params CreateWorkerParams) (string, []byte, error) {
 	var buf = &bytes.Buffer{}
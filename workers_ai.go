@@ -0,0 +1,229 @@
+package cloudflare
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goccy/go-json"
+)
+
+var ErrMissingAIModel = errors.New("required Workers AI model missing")
+
+// AIMessage is a message of a chat conversation with a text generation model.
+type AIMessage struct {
+	// Role is "system", "user" or "assistant".
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// AITextGenerationParams are the input of a text generation model. Either
+// Prompt or Messages is required.
+type AITextGenerationParams struct {
+	Prompt            string      `json:"prompt,omitempty"`
+	Messages          []AIMessage `json:"messages,omitempty"`
+	MaxTokens         int         `json:"max_tokens,omitempty"`
+	Temperature       *float64    `json:"temperature,omitempty"`
+	TopP              *float64    `json:"top_p,omitempty"`
+	TopK              int         `json:"top_k,omitempty"`
+	Seed              int         `json:"seed,omitempty"`
+	RepetitionPenalty *float64    `json:"repetition_penalty,omitempty"`
+	Raw               bool        `json:"raw,omitempty"`
+}
+
+// AITextGenerationResult is the output of a text generation model.
+type AITextGenerationResult struct {
+	Response string `json:"response"`
+}
+
+// AIEmbeddingsResult is the output of a text embeddings model, one vector in
+// Data for each input text.
+type AIEmbeddingsResult struct {
+	Shape []int       `json:"shape"`
+	Data  [][]float64 `json:"data"`
+}
+
+// AIImageClassification is a label predicted by an image classification
+// model and its confidence.
+type AIImageClassification struct {
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
+// AIRunResponse is the API response of running a model.
+type AIRunResponse struct {
+	Response
+	Result json.RawMessage `json:"result"`
+}
+
+// RunAIModel runs a Workers AI model with input and unmarshals its result
+// into result. input is marshalled as JSON unless it is a []byte or an
+// io.Reader, which are sent as is for models taking binary input. Use it for
+// tasks without a typed helper.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-ai-post-run-model
+func (api *API) RunAIModel(ctx context.Context, rc *ResourceContainer, model string, input interface{}, result interface{}) error {
+	if rc.Level != AccountRouteLevel {
+		return ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingAccountID
+	}
+
+	if model == "" {
+		return ErrMissingAIModel
+	}
+
+	var headers http.Header
+	switch input.(type) {
+	case []byte, io.Reader:
+		headers = http.Header{"Content-Type": []string{"application/octet-stream"}}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/ai/run/%s", rc.Identifier, model)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, input, headers)
+	if err != nil {
+		return err
+	}
+
+	var r AIRunResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+	return nil
+}
+
+// RunAITextGeneration generates text with a model such as
+// "@cf/meta/llama-3.1-8b-instruct".
+//
+// API reference: https://developers.cloudflare.com/workers-ai/models/#text-generation
+func (api *API) RunAITextGeneration(ctx context.Context, rc *ResourceContainer, model string, params AITextGenerationParams) (AITextGenerationResult, error) {
+	var result AITextGenerationResult
+	err := api.RunAIModel(ctx, rc, model, params, &result)
+	return result, err
+}
+
+// RunAIEmbeddings computes the embeddings of texts with a model such as
+// "@cf/baai/bge-base-en-v1.5".
+//
+// API reference: https://developers.cloudflare.com/workers-ai/models/#text-embeddings
+func (api *API) RunAIEmbeddings(ctx context.Context, rc *ResourceContainer, model string, texts []string) (AIEmbeddingsResult, error) {
+	var result AIEmbeddingsResult
+	err := api.RunAIModel(ctx, rc, model, struct {
+		Text []string `json:"text"`
+	}{texts}, &result)
+	return result, err
+}
+
+// RunAIImageClassification classifies an image with a model such as
+// "@cf/microsoft/resnet-50". Labels are ordered by decreasing score.
+//
+// API reference: https://developers.cloudflare.com/workers-ai/models/#image-classification
+func (api *API) RunAIImageClassification(ctx context.Context, rc *ResourceContainer, model string, image []byte) ([]AIImageClassification, error) {
+	var result []AIImageClassification
+	err := api.RunAIModel(ctx, rc, model, image, &result)
+	if err != nil {
+		return []AIImageClassification{}, err
+	}
+	return result, nil
+}
+
+// AITextGenerationStream iterates over the tokens of a streamed text
+// generation as they are generated.
+type AITextGenerationStream struct {
+	body     io.ReadCloser
+	scanner  *bufio.Scanner
+	response string
+	err      error
+}
+
+// Next advances to the next token, returning false when the generation is
+// complete or on error.
+func (s *AITextGenerationStream) Next() bool {
+	for s.err == nil && s.scanner.Scan() {
+		line := bytes.TrimSpace(s.scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("data:")) {
+			continue
+		}
+
+		data := bytes.TrimSpace(line[len("data:"):])
+		if string(data) == "[DONE]" {
+			return false
+		}
+
+		var chunk AITextGenerationResult
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			s.err = fmt.Errorf("%s: %w", errUnmarshalError, err)
+			return false
+		}
+		s.response = chunk.Response
+		return true
+	}
+
+	if err := s.scanner.Err(); err != nil && s.err == nil {
+		s.err = fmt.Errorf("reading Workers AI stream: %w", err)
+	}
+	return false
+}
+
+// Response returns the text of the current token.
+func (s *AITextGenerationStream) Response() string {
+	return s.response
+}
+
+// Err returns the error that stopped Next, if any.
+func (s *AITextGenerationStream) Err() error {
+	return s.err
+}
+
+// Close closes the response.
+func (s *AITextGenerationStream) Close() error {
+	return s.body.Close()
+}
+
+// StreamAITextGeneration generates text like RunAITextGeneration but returns
+// the tokens as they are generated, read from the server-sent events of the
+// response.
+//
+// API reference: https://developers.cloudflare.com/workers-ai/models/#text-generation
+func (api *API) StreamAITextGeneration(ctx context.Context, rc *ResourceContainer, model string, params AITextGenerationParams) (*AITextGenerationStream, error) {
+	if rc.Level != AccountRouteLevel {
+		return nil, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return nil, ErrMissingAccountID
+	}
+
+	if model == "" {
+		return nil, ErrMissingAIModel
+	}
+
+	body := struct {
+		AITextGenerationParams
+		Stream bool `json:"stream"`
+	}{params, true}
+
+	// The response is read incrementally by the stream.
+	uri := fmt.Sprintf("/accounts/%s/ai/run/%s", rc.Identifier, model)
+	resp, err := api.makeStreamingRequest(ctx, http.MethodPost, uri, body, http.Header{
+		"Accept": []string{"text/event-stream"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AITextGenerationStream{body: resp.Body, scanner: bufio.NewScanner(resp.Body)}, nil
+}
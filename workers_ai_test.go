@@ -0,0 +1,137 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAITextGenerationModel = "@cf/meta/llama-3.1-8b-instruct"
+
+func TestRunAITextGeneration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"messages": [{"role": "user", "content": "Hello"}], "max_tokens": 64}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"response": "Hi there!"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/"+testAITextGenerationModel, handler)
+
+	actual, err := client.RunAITextGeneration(context.Background(), AccountIdentifier(testAccountID), testAITextGenerationModel, AITextGenerationParams{
+		Messages:  []AIMessage{{Role: "user", Content: "Hello"}},
+		MaxTokens: 64,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, AITextGenerationResult{Response: "Hi there!"}, actual)
+	}
+
+	_, err = client.RunAITextGeneration(context.Background(), AccountIdentifier(testAccountID), "", AITextGenerationParams{})
+	assert.Equal(t, ErrMissingAIModel, err)
+}
+
+func TestStreamAITextGeneration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"prompt": "Hello", "stream": true}`, string(body))
+
+		w.Header().Set("content-type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"response\":\"Hi\"}\n\ndata: {\"response\":\" there\"}\n\ndata: [DONE]\n\n")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/"+testAITextGenerationModel, handler)
+
+	stream, err := client.StreamAITextGeneration(context.Background(), AccountIdentifier(testAccountID), testAITextGenerationModel, AITextGenerationParams{
+		Prompt: "Hello",
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var tokens []string
+	for stream.Next() {
+		tokens = append(tokens, stream.Response())
+	}
+	assert.NoError(t, stream.Err())
+	assert.Equal(t, []string{"Hi", " there"}, tokens)
+}
+
+func TestRunAIEmbeddings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"text": ["a", "b"]}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"shape": [2, 2], "data": [[0.1, 0.2], [0.3, 0.4]]}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/baai/bge-base-en-v1.5", handler)
+
+	actual, err := client.RunAIEmbeddings(context.Background(), AccountIdentifier(testAccountID), "@cf/baai/bge-base-en-v1.5", []string{"a", "b"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, AIEmbeddingsResult{Shape: []int{2, 2}, Data: [][]float64{{0.1, 0.2}, {0.3, 0.4}}}, actual)
+	}
+}
+
+func TestRunAIImageClassification(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x89, 'P', 'N', 'G'}, body)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"label": "TABBY CAT", "score": 0.9}, {"label": "TIGER CAT", "score": 0.05}]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/ai/run/@cf/microsoft/resnet-50", handler)
+
+	actual, err := client.RunAIImageClassification(context.Background(), AccountIdentifier(testAccountID), "@cf/microsoft/resnet-50", []byte{0x89, 'P', 'N', 'G'})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AIImageClassification{{Label: "TABBY CAT", Score: 0.9}, {Label: "TIGER CAT", Score: 0.05}}, actual)
+	}
+}
@@ -0,0 +1,317 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DeployWorkerParams describes everything that makes up a Worker deployment.
+type DeployWorkerParams struct {
+	// Script is the Worker to upload. ScriptName is required.
+	Script CreateWorkerParams
+
+	// Secrets are set on the Worker once the script has been uploaded.
+	Secrets []WorkersPutSecretRequest
+
+	// Crons replaces the Worker's cron triggers. A nil value leaves the
+	// existing schedules unchanged.
+	Crons *[]WorkerCronTrigger
+
+	// Routes are created, or updated to point at the Worker if a route with
+	// the same pattern already exists on the zone.
+	Routes []DeployWorkerRoute
+
+	// Domains are attached to the Worker. The Service of each domain defaults
+	// to the script name and the Environment to "production".
+	Domains []AttachWorkersDomainParams
+}
+
+// DeployWorkerRoute is a route pattern on a zone.
+type DeployWorkerRoute struct {
+	ZoneID  string
+	Pattern string
+}
+
+// WorkerDeployment is the result of a successful DeployWorker.
+type WorkerDeployment struct {
+	Script  WorkerScript
+	Crons   []WorkerCronTrigger
+	Routes  []WorkerRoute
+	Domains []WorkersDomain
+}
+
+// WorkerDeployError is returned by DeployWorker when a step of the
+// deployment fails. Err is the error of the failing step and RollbackErrors
+// holds any errors encountered while undoing the steps that had completed.
+type WorkerDeployError struct {
+	Step           string
+	Err            error
+	RollbackErrors []error
+}
+
+func (e *WorkerDeployError) Error() string {
+	msg := fmt.Sprintf("worker deployment failed at %s: %s", e.Step, e.Err)
+	if len(e.RollbackErrors) == 0 {
+		return msg + " (rolled back)"
+	}
+
+	rollbackMsgs := make([]string, 0, len(e.RollbackErrors))
+	for _, err := range e.RollbackErrors {
+		rollbackMsgs = append(rollbackMsgs, err.Error())
+	}
+
+	return fmt.Sprintf("%s (rollback incomplete: %s)", msg, strings.Join(rollbackMsgs, "; "))
+}
+
+func (e *WorkerDeployError) Unwrap() error {
+	return e.Err
+}
+
+type workerDeployStep struct {
+	name string
+	undo func(ctx context.Context) error
+}
+
+// DeployWorker uploads a Worker and then applies its secrets, cron triggers,
+// routes and domains. If any step fails, the steps already applied are undone
+// in reverse order and a *WorkerDeployError is returned.
+//
+// Rolling back restores the previous script content and bindings, or deletes
+// the Worker if it did not exist before. Secret values cannot be read back
+// from the API, so secrets that existed before the deployment keep the value
+// set by it; secrets added by the deployment are removed along with the
+// script bindings. Rollback uses ctx, so a cancelled context also prevents
+// the rollback from completing.
+func (api *API) DeployWorker(ctx context.Context, rc *ResourceContainer, params DeployWorkerParams) (WorkerDeployment, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkerDeployment{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkerDeployment{}, ErrMissingAccountID
+	}
+
+	scriptName := params.Script.ScriptName
+	if scriptName == "" {
+		return WorkerDeployment{}, ErrMissingScriptName
+	}
+
+	var (
+		deployment WorkerDeployment
+		steps      []workerDeployStep
+	)
+
+	fail := func(step string, err error) (WorkerDeployment, error) {
+		deployErr := &WorkerDeployError{Step: step, Err: err}
+		for i := len(steps) - 1; i >= 0; i-- {
+			if err := steps[i].undo(ctx); err != nil {
+				deployErr.RollbackErrors = append(deployErr.RollbackErrors, fmt.Errorf("undo %s: %w", steps[i].name, err))
+			}
+		}
+		return WorkerDeployment{}, deployErr
+	}
+
+	restoreScript, err := api.snapshotWorkerScript(ctx, rc, scriptName)
+	if err != nil {
+		return WorkerDeployment{}, &WorkerDeployError{Step: "snapshot script", Err: err}
+	}
+
+	script, err := api.UploadWorker(ctx, rc, params.Script)
+	if err != nil {
+		return fail("upload script", err)
+	}
+	deployment.Script = script.WorkerScript
+	steps = append(steps, workerDeployStep{name: "upload script", undo: restoreScript})
+
+	for _, secret := range params.Secrets {
+		secret := secret
+		if secret.Type == "" {
+			secret.Type = WorkerSecretTextBindingType
+		}
+
+		if _, err := api.SetWorkersSecret(ctx, rc, SetWorkersSecretParams{ScriptName: scriptName, Secret: &secret}); err != nil {
+			return fail(fmt.Sprintf("set secret %q", secret.Name), err)
+		}
+	}
+
+	if params.Crons != nil {
+		previous, err := api.ListWorkerCronTriggers(ctx, rc, ListWorkerCronTriggersParams{ScriptName: scriptName})
+		if err != nil {
+			return fail("list cron triggers", err)
+		}
+
+		crons, err := api.UpdateWorkerCronTriggers(ctx, rc, UpdateWorkerCronTriggersParams{ScriptName: scriptName, Crons: *params.Crons})
+		if err != nil {
+			return fail("update cron triggers", err)
+		}
+		deployment.Crons = crons
+		steps = append(steps, workerDeployStep{name: "update cron triggers", undo: func(ctx context.Context) error {
+			_, err := api.UpdateWorkerCronTriggers(ctx, rc, UpdateWorkerCronTriggersParams{ScriptName: scriptName, Crons: previous})
+			return err
+		}})
+	}
+
+	for _, route := range params.Routes {
+		step := fmt.Sprintf("route %q", route.Pattern)
+		result, undo, err := api.deployWorkerRoute(ctx, route, scriptName)
+		if err != nil {
+			return fail(step, err)
+		}
+		deployment.Routes = append(deployment.Routes, result)
+		if undo != nil {
+			steps = append(steps, workerDeployStep{name: step, undo: undo})
+		}
+	}
+
+	for _, domain := range params.Domains {
+		step := fmt.Sprintf("domain %q", domain.Hostname)
+		result, undo, err := api.deployWorkersDomain(ctx, rc, domain, scriptName)
+		if err != nil {
+			return fail(step, err)
+		}
+		deployment.Domains = append(deployment.Domains, result)
+		if undo != nil {
+			steps = append(steps, workerDeployStep{name: step, undo: undo})
+		}
+	}
+
+	return deployment, nil
+}
+
+// snapshotWorkerScript captures the current script and bindings of a Worker
+// and returns a function restoring them, or deleting the Worker if it does
+// not exist yet.
+func (api *API) snapshotWorkerScript(ctx context.Context, rc *ResourceContainer, scriptName string) (func(ctx context.Context) error, error) {
+	current, err := api.GetWorker(ctx, rc, scriptName)
+	if err != nil {
+		var notFoundError *NotFoundError
+		if errors.As(err, &notFoundError) {
+			return func(ctx context.Context) error {
+				return api.DeleteWorker(ctx, rc, DeleteWorkerParams{ScriptName: scriptName})
+			}, nil
+		}
+		return nil, err
+	}
+
+	bindings, err := api.ListWorkerBindings(ctx, rc, ListWorkerBindingsParams{ScriptName: scriptName})
+	if err != nil {
+		return nil, err
+	}
+
+	previous := CreateWorkerParams{
+		ScriptName:    scriptName,
+		Script:        current.Script,
+		Module:        current.Module,
+		Logpush:       current.Logpush,
+		TailConsumers: current.TailConsumers,
+		Bindings:      make(map[string]WorkerBinding, len(bindings.BindingList)),
+	}
+	for _, b := range bindings.BindingList {
+		switch b.Binding.(type) {
+		case WorkerSecretTextBinding, WorkerWebAssemblyBinding:
+			// Secret values and module contents cannot be read back, so keep
+			// whatever the Worker currently has.
+			previous.Bindings[b.Name] = WorkerInheritBinding{}
+		default:
+			previous.Bindings[b.Name] = b.Binding
+		}
+	}
+
+	return func(ctx context.Context) error {
+		_, err := api.UploadWorker(ctx, rc, previous)
+		return err
+	}, nil
+}
+
+// deployWorkerRoute points a route pattern at scriptName and returns a
+// function restoring the previous state, or nil if nothing changed.
+func (api *API) deployWorkerRoute(ctx context.Context, route DeployWorkerRoute, scriptName string) (WorkerRoute, func(ctx context.Context) error, error) {
+	zone := ZoneIdentifier(route.ZoneID)
+
+	routes, err := api.ListWorkerRoutes(ctx, zone, ListWorkerRoutesParams{})
+	if err != nil {
+		return WorkerRoute{}, nil, err
+	}
+
+	for _, existing := range routes.Routes {
+		if existing.Pattern != route.Pattern {
+			continue
+		}
+
+		if existing.ScriptName == scriptName {
+			return existing, nil, nil
+		}
+
+		updated, err := api.UpdateWorkerRoute(ctx, zone, UpdateWorkerRouteParams{ID: existing.ID, Pattern: route.Pattern, Script: scriptName})
+		if err != nil {
+			return WorkerRoute{}, nil, err
+		}
+
+		return updated.WorkerRoute, func(ctx context.Context) error {
+			_, err := api.UpdateWorkerRoute(ctx, zone, UpdateWorkerRouteParams{ID: existing.ID, Pattern: existing.Pattern, Script: existing.ScriptName})
+			return err
+		}, nil
+	}
+
+	created, err := api.CreateWorkerRoute(ctx, zone, CreateWorkerRouteParams{Pattern: route.Pattern, Script: scriptName})
+	if err != nil {
+		return WorkerRoute{}, nil, err
+	}
+
+	return created.WorkerRoute, func(ctx context.Context) error {
+		_, err := api.DeleteWorkerRoute(ctx, zone, created.ID)
+		return err
+	}, nil
+}
+
+// deployWorkersDomain attaches a domain to scriptName and returns a function
+// restoring the previous attachment, or nil if nothing changed.
+func (api *API) deployWorkersDomain(ctx context.Context, rc *ResourceContainer, domain AttachWorkersDomainParams, scriptName string) (WorkersDomain, func(ctx context.Context) error, error) {
+	if domain.Service == "" {
+		domain.Service = scriptName
+	}
+
+	if domain.Environment == "" {
+		domain.Environment = "production"
+	}
+
+	existing, err := api.ListWorkersDomains(ctx, rc, ListWorkersDomainParams{Hostname: domain.Hostname})
+	if err != nil {
+		return WorkersDomain{}, nil, err
+	}
+
+	var previous *WorkersDomain
+	for i := range existing {
+		if existing[i].Hostname != domain.Hostname {
+			continue
+		}
+
+		if existing[i].Service == domain.Service && existing[i].Environment == domain.Environment {
+			return existing[i], nil, nil
+		}
+		previous = &existing[i]
+	}
+
+	attached, err := api.AttachWorkersDomain(ctx, rc, domain)
+	if err != nil {
+		return WorkersDomain{}, nil, err
+	}
+
+	if previous != nil {
+		return attached, func(ctx context.Context) error {
+			_, err := api.AttachWorkersDomain(ctx, rc, AttachWorkersDomainParams{
+				ZoneID:      previous.ZoneID,
+				Hostname:    previous.Hostname,
+				Service:     previous.Service,
+				Environment: previous.Environment,
+			})
+			return err
+		}, nil
+	}
+
+	return attached, func(ctx context.Context) error {
+		return api.DetachWorkersDomain(ctx, rc, attached.ID)
+	}, nil
+}
@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeployWorker(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	record := func(r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10007, "message": "workers.api.error.script_not_found"}], "messages": [], "result": null}`)
+		case http.MethodPut:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "foo", "etag": "abc"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo/secrets", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "API_KEY", "text": "s3cr3t", "type": "secret_text"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"name": "API_KEY", "type": "secret_text"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo/schedules", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"schedules": []}}`)
+		case http.MethodPut:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"schedules": [{"cron": "*/30 * * * *"}]}}`)
+		}
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/workers/routes", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "pattern": "example.com/foo/*", "script": "foo"}]}`)
+		case http.MethodPost:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r2", "pattern": "example.com/bar/*", "script": "foo"}}`)
+		}
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/domains", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "foo.example.com", r.URL.Query().Get("hostname"))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"zone_id": "`+testZoneID+`", "hostname": "foo.example.com", "service": "foo", "environment": "production"}`, string(body))
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "d1", "zone_id": "`+testZoneID+`", "hostname": "foo.example.com", "service": "foo", "environment": "production"}}`)
+		}
+	})
+
+	crons := []WorkerCronTrigger{{Cron: "*/30 * * * *"}}
+	deployment, err := client.DeployWorker(context.Background(), AccountIdentifier(testAccountID), DeployWorkerParams{
+		Script:  CreateWorkerParams{ScriptName: "foo", Script: workerScript},
+		Secrets: []WorkersPutSecretRequest{{Name: "API_KEY", Text: "s3cr3t"}},
+		Crons:   &crons,
+		Routes: []DeployWorkerRoute{
+			{ZoneID: testZoneID, Pattern: "example.com/foo/*"},
+			{ZoneID: testZoneID, Pattern: "example.com/bar/*"},
+		},
+		Domains: []AttachWorkersDomainParams{{ZoneID: testZoneID, Hostname: "foo.example.com"}},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "abc", deployment.Script.ETAG)
+		assert.Equal(t, crons, deployment.Crons)
+		assert.Equal(t, []WorkerRoute{
+			{ID: "r1", Pattern: "example.com/foo/*", ScriptName: "foo"},
+			{ID: "r2", Pattern: "example.com/bar/*", ScriptName: "foo"},
+		}, deployment.Routes)
+		if assert.Len(t, deployment.Domains, 1) {
+			assert.Equal(t, "d1", deployment.Domains[0].ID)
+		}
+	}
+
+	assert.NotContains(t, calls, "DELETE /accounts/"+testAccountID+"/workers/scripts/foo")
+}
+
+func TestDeployWorker_RollsBack(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var (
+		uploads       []string
+		restoredCrons string
+		routeUpdates  []string
+	)
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("content-type", "application/javascript")
+			fmt.Fprint(w, "previous script")
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			uploads = append(uploads, string(body))
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "foo"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo/bindings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/foo/schedules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"schedules": [{"cron": "0 0 * * *"}]}}`)
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			restoredCrons = string(body)
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"schedules": []}}`)
+		}
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/workers/routes", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "r1", "pattern": "example.com/*", "script": "old"}]}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/workers/routes/r1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, _ := io.ReadAll(r.Body)
+		routeUpdates = append(routeUpdates, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "r1", "pattern": "example.com/*", "script": "foo"}}`)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/workers/routes/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/domains", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+			return
+		}
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"success": false, "errors": [{"code": 100117, "message": "hostname already has externally managed DNS records"}], "messages": [], "result": null}`)
+	})
+
+	_, err := client.DeployWorker(context.Background(), AccountIdentifier(testAccountID), DeployWorkerParams{
+		Script:  CreateWorkerParams{ScriptName: "foo", Script: workerScript},
+		Crons:   &[]WorkerCronTrigger{{Cron: "*/5 * * * *"}},
+		Routes:  []DeployWorkerRoute{{ZoneID: testZoneID, Pattern: "example.com/*"}},
+		Domains: []AttachWorkersDomainParams{{ZoneID: testZoneID, Hostname: "foo.example.com"}},
+	})
+
+	var deployErr *WorkerDeployError
+	if assert.True(t, errors.As(err, &deployErr)) {
+		assert.Equal(t, `domain "foo.example.com"`, deployErr.Step)
+		assert.Empty(t, deployErr.RollbackErrors)
+	}
+
+	if assert.Len(t, uploads, 2) {
+		assert.Equal(t, workerScript, uploads[0])
+		assert.Contains(t, uploads[1], "previous script")
+	}
+	assert.JSONEq(t, `[{"cron": "0 0 * * *"}]`, restoredCrons)
+	if assert.Len(t, routeUpdates, 2) {
+		assert.JSONEq(t, `{"id": "r1", "pattern": "example.com/*", "script": "foo"}`, routeUpdates[0])
+		assert.JSONEq(t, `{"id": "r1", "pattern": "example.com/*", "script": "old"}`, routeUpdates[1])
+	}
+}
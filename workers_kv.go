@@ -101,6 +101,25 @@ type ListWorkersKVsParams struct {
 	Prefix      string `url:"prefix,omitempty"`
 }
 
+// WorkersKVClient is the set of Workers KV operations implemented by *API.
+// Application code can depend on this interface and substitute the in-memory
+// implementation from the cloudflaretest package in unit tests.
+type WorkersKVClient interface {
+	CreateWorkersKVNamespace(ctx context.Context, rc *ResourceContainer, params CreateWorkersKVNamespaceParams) (WorkersKVNamespaceResponse, error)
+	ListWorkersKVNamespaces(ctx context.Context, rc *ResourceContainer, params ListWorkersKVNamespacesParams) ([]WorkersKVNamespace, *ResultInfo, error)
+	GetWorkersKVNamespace(ctx context.Context, rc *ResourceContainer, namespaceID string) (WorkersKVNamespace, error)
+	DeleteWorkersKVNamespace(ctx context.Context, rc *ResourceContainer, namespaceID string) (Response, error)
+	UpdateWorkersKVNamespace(ctx context.Context, rc *ResourceContainer, params UpdateWorkersKVNamespaceParams) (Response, error)
+	WriteWorkersKVEntry(ctx context.Context, rc *ResourceContainer, params WriteWorkersKVEntryParams) (Response, error)
+	WriteWorkersKVEntries(ctx context.Context, rc *ResourceContainer, params WriteWorkersKVEntriesParams) (Response, error)
+	GetWorkersKV(ctx context.Context, rc *ResourceContainer, params GetWorkersKVParams) ([]byte, error)
+	DeleteWorkersKVEntry(ctx context.Context, rc *ResourceContainer, params DeleteWorkersKVEntryParams) (Response, error)
+	DeleteWorkersKVEntries(ctx context.Context, rc *ResourceContainer, params DeleteWorkersKVEntriesParams) (Response, error)
+	ListWorkersKVKeys(ctx context.Context, rc *ResourceContainer, params ListWorkersKVsParams) (ListStorageKeysResponse, error)
+}
+
+var _ WorkersKVClient = (*API)(nil)
+
 // CreateWorkersKVNamespace creates a namespace under the given title.
 // A 400 is returned if the account already owns a namespace with this title.
 // A namespace must be explicitly deleted to be replaced.
@@ -178,6 +197,32 @@ func (api *API) ListWorkersKVNamespaces(ctx context.Context, rc *ResourceContain
 	return namespaces, &nsResponse.ResultInfo, nil
 }
 
+// GetWorkersKVNamespace returns the namespace corresponding to the given ID.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-kv-namespace-get-a-namespace
+func (api *API) GetWorkersKVNamespace(ctx context.Context, rc *ResourceContainer, namespaceID string) (WorkersKVNamespace, error) {
+	if rc.Level != AccountRouteLevel {
+		return WorkersKVNamespace{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return WorkersKVNamespace{}, ErrMissingIdentifier
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/storage/kv/namespaces/%s", rc.Identifier, namespaceID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return WorkersKVNamespace{}, err
+	}
+
+	result := WorkersKVNamespaceResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return WorkersKVNamespace{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return result.Result, nil
+}
+
 // DeleteWorkersKVNamespace deletes the namespace corresponding to the given ID.
 //
 // API reference: https://developers.cloudflare.com/api/operations/workers-kv-namespace-remove-a-namespace
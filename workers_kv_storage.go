@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"time"
+)
+
+// workersKVBulkDeleteLimit is the maximum number of keys deleted by a single
+// bulk delete request.
+const workersKVBulkDeleteLimit = 10000
+
+const kvStorageAdaptiveGroupsQuery = `query KVStorageAdaptiveGroups($accountTag: string, $filter: AccountKvStorageAdaptiveGroupsFilter_InputObject, $limit: uint64!) {
+  viewer {
+    accounts(filter: {accountTag: $accountTag}) {
+      kvStorageAdaptiveGroups(filter: $filter, limit: $limit, orderBy: [date_DESC]) {
+        max {
+          keyCount
+          byteCount
+        }
+        dimensions {
+          namespaceId
+          date
+        }
+      }
+    }
+  }
+}`
+
+// WorkersKVNamespaceStorage is the number of keys and bytes stored in a
+// namespace, as last reported by the GraphQL Analytics API.
+type WorkersKVNamespaceStorage struct {
+	NamespaceID string
+	Date        string
+	KeyCount    int64
+	ByteCount   int64
+}
+
+type kvStorageAdaptiveGroupsResponse struct {
+	Viewer struct {
+		Accounts []struct {
+			KVStorageAdaptiveGroups []struct {
+				Max struct {
+					KeyCount  int64 `json:"keyCount"`
+					ByteCount int64 `json:"byteCount"`
+				} `json:"max"`
+				Dimensions struct {
+					NamespaceID string `json:"namespaceId"`
+					Date        string `json:"date"`
+				} `json:"dimensions"`
+			} `json:"kvStorageAdaptiveGroups"`
+		} `json:"accounts"`
+	} `json:"viewer"`
+}
+
+// GetWorkersKVNamespaceStorage returns the latest key count and storage size
+// of the given namespaces, or of all namespaces of the account if none are
+// given, without listing their keys. Storage is reported daily, so
+// namespaces without data in the last week are omitted.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GetWorkersKVNamespaceStorage(ctx context.Context, rc *ResourceContainer, namespaceIDs ...string) ([]WorkersKVNamespaceStorage, error) {
+	if rc.Level != AccountRouteLevel {
+		return []WorkersKVNamespaceStorage{}, ErrRequiredAccountLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []WorkersKVNamespaceStorage{}, ErrMissingIdentifier
+	}
+
+	filter := map[string]interface{}{
+		"date_geq": time.Now().UTC().AddDate(0, 0, -7).Format("2006-01-02"),
+	}
+	if len(namespaceIDs) > 0 {
+		filter["namespaceId_in"] = namespaceIDs
+	}
+
+	var r kvStorageAdaptiveGroupsResponse
+	err := api.GraphQL(ctx, GraphQLQuery{
+		Query: kvStorageAdaptiveGroupsQuery,
+		Variables: map[string]interface{}{
+			"accountTag": rc.Identifier,
+			"filter":     filter,
+			"limit":      10000,
+		},
+	}, &r)
+	if err != nil {
+		return []WorkersKVNamespaceStorage{}, err
+	}
+
+	storage := []WorkersKVNamespaceStorage{}
+	if len(r.Viewer.Accounts) == 0 {
+		return storage, nil
+	}
+
+	// Groups are ordered newest first, so the first group of a namespace is
+	// its latest.
+	seen := make(map[string]bool)
+	for _, g := range r.Viewer.Accounts[0].KVStorageAdaptiveGroups {
+		if seen[g.Dimensions.NamespaceID] {
+			continue
+		}
+		seen[g.Dimensions.NamespaceID] = true
+		storage = append(storage, WorkersKVNamespaceStorage{
+			NamespaceID: g.Dimensions.NamespaceID,
+			Date:        g.Dimensions.Date,
+			KeyCount:    g.Max.KeyCount,
+			ByteCount:   g.Max.ByteCount,
+		})
+	}
+
+	return storage, nil
+}
+
+// DeleteAllWorkersKVEntries deletes every key of a namespace, keeping the
+// namespace itself, and returns the number of keys deleted. Keys are listed
+// first and then deleted in batches of up to 10,000, so keys written
+// concurrently may survive.
+//
+// API reference: https://developers.cloudflare.com/api/operations/workers-kv-namespace-delete-multiple-key-value-pairs
+func (api *API) DeleteAllWorkersKVEntries(ctx context.Context, rc *ResourceContainer, namespaceID string) (int, error) {
+	var keys []string
+	params := ListWorkersKVsParams{NamespaceID: namespaceID, Limit: 1000}
+	for {
+		res, err := api.ListWorkersKVKeys(ctx, rc, params)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, k := range res.Result {
+			keys = append(keys, k.Name)
+		}
+
+		if res.Cursor == "" || len(res.Result) == 0 {
+			break
+		}
+		params.Cursor = res.Cursor
+	}
+
+	deleted := 0
+	for _, batch := range chunkStrings(keys, workersKVBulkDeleteLimit) {
+		_, err := api.DeleteWorkersKVEntries(ctx, rc, DeleteWorkersKVEntriesParams{NamespaceID: namespaceID, Keys: batch})
+		if err != nil {
+			return deleted, err
+		}
+		deleted += len(batch)
+	}
+
+	return deleted, nil
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -361,6 +362,111 @@ func TestWorkersKV_DeleteWorkersKVBulk(t *testing.T) {
 	assert.Equal(t, want, res)
 }
 
+func TestWorkersKV_GetWorkersKVNamespace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	namespace := "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+	mux.HandleFunc(fmt.Sprintf("/accounts/"+testAccountID+"/storage/kv/namespaces/%s", namespace), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {"id": "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx", "title": "Namespace"},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	_, err := client.GetWorkersKVNamespace(context.Background(), ZoneIdentifier(testZoneID), namespace)
+	assert.ErrorIs(t, err, ErrRequiredAccountLevelResourceContainer)
+
+	res, err := client.GetWorkersKVNamespace(context.Background(), AccountIdentifier(testAccountID), namespace)
+	require.NoError(t, err)
+	assert.Equal(t, WorkersKVNamespace{ID: namespace, Title: "Namespace"}, res)
+}
+
+func TestWorkersKV_DeleteAllWorkersKVEntries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	namespace := "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+	mux.HandleFunc(fmt.Sprintf("/accounts/"+testAccountID+"/storage/kv/namespaces/%s/keys", namespace), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{
+				"result": [{"name": "key1"}, {"name": "key2"}],
+				"result_info": {"count": 2, "cursor": "page2"},
+				"success": true,
+				"errors": [],
+				"messages": []
+			}`)
+			return
+		}
+		assert.Equal(t, "page2", r.URL.Query().Get("cursor"))
+		fmt.Fprint(w, `{
+			"result": [{"name": "key3"}],
+			"result_info": {"count": 1, "cursor": ""},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	})
+
+	var deleted []string
+	mux.HandleFunc(fmt.Sprintf("/accounts/"+testAccountID+"/storage/kv/namespaces/%s/bulk", namespace), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		var keys []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&keys))
+		deleted = append(deleted, keys...)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"result": null, "success": true, "errors": [], "messages": []}`)
+	})
+
+	n, err := client.DeleteAllWorkersKVEntries(context.Background(), AccountIdentifier(testAccountID), namespace)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"key1", "key2", "key3"}, deleted)
+}
+
+func TestWorkersKV_GetWorkersKVNamespaceStorage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		var query GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+		assert.Equal(t, testAccountID, query.Variables["accountTag"])
+		filter := query.Variables["filter"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"ns1", "ns2"}, filter["namespaceId_in"])
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {
+				"viewer": {
+					"accounts": [{
+						"kvStorageAdaptiveGroups": [
+							{"max": {"keyCount": 120, "byteCount": 4096}, "dimensions": {"namespaceId": "ns1", "date": "2023-06-02"}},
+							{"max": {"keyCount": 7, "byteCount": 512}, "dimensions": {"namespaceId": "ns2", "date": "2023-06-02"}},
+							{"max": {"keyCount": 100, "byteCount": 2048}, "dimensions": {"namespaceId": "ns1", "date": "2023-06-01"}}
+						]
+					}]
+				}
+			},
+			"errors": null
+		}`)
+	})
+
+	storage, err := client.GetWorkersKVNamespaceStorage(context.Background(), AccountIdentifier(testAccountID), "ns1", "ns2")
+	require.NoError(t, err)
+	assert.Equal(t, []WorkersKVNamespaceStorage{
+		{NamespaceID: "ns1", Date: "2023-06-02", KeyCount: 120, ByteCount: 4096},
+		{NamespaceID: "ns2", Date: "2023-06-02", KeyCount: 7, ByteCount: 512},
+	}, storage)
+}
+
 func TestWorkersKV_ListKeys(t *testing.T) {
 	setup()
 	defer teardown()
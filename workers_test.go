@@ -641,6 +641,55 @@ func TestUpdateWorkersScriptSettings(t *testing.T) {
 	}
 }
 
+func TestUpdateWorkersScriptSettings_TailConsumers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/workers/scripts/api/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"Bindings": null, "logpush": true, "tail_consumers": [{"service": "log-shipper", "environment": "production"}]}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"logpush": true, "tail_consumers": [{"service": "log-shipper", "environment": "production"}]}
+		}`)
+	})
+
+	consumers := []WorkersTailConsumer{{Service: "log-shipper", Environment: StringPtr("production")}}
+	res, err := client.UpdateWorkersScriptSettings(context.Background(), AccountIdentifier(testAccountID), UpdateWorkersScriptSettingsParams{
+		ScriptName:    "api",
+		Logpush:       BoolPtr(true),
+		TailConsumers: &consumers,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, BoolPtr(true), res.Logpush)
+		assert.Equal(t, &consumers, res.TailConsumers)
+	}
+
+	self := []WorkersTailConsumer{{Service: "api"}}
+	_, err = client.UpdateWorkersScriptSettings(context.Background(), AccountIdentifier(testAccountID), UpdateWorkersScriptSettingsParams{
+		ScriptName:    "api",
+		TailConsumers: &self,
+	})
+	assert.EqualError(t, err, "worker api cannot be its own tail consumer")
+
+	missing := []WorkersTailConsumer{{Environment: StringPtr("production")}}
+	_, err = client.UpdateWorkersScriptSettings(context.Background(), AccountIdentifier(testAccountID), UpdateWorkersScriptSettingsParams{
+		ScriptName:    "api",
+		TailConsumers: &missing,
+	})
+	assert.ErrorIs(t, err, ErrMissingTailConsumerService)
+
+	_, err = client.UpdateWorkersScriptSettings(context.Background(), AccountIdentifier(testAccountID), UpdateWorkersScriptSettingsParams{Logpush: BoolPtr(true)})
+	assert.ErrorIs(t, err, ErrMissingScriptName)
+
+	_, err = client.GetWorkersScriptSettings(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingScriptName)
+}
+
 func TestListWorkers(t *testing.T) {
 	setup()
 	defer teardown()
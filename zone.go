@@ -570,16 +570,34 @@ func (api *API) ZoneSetType(ctx context.Context, zoneID string, zoneType string)
 	return zone, nil
 }
 
-// ZoneSetVanityNS sets custom nameservers for the zone.
-// These names must be within the same zone.
+// zoneVanityNSPayload always sends the vanity nameservers, so an empty list
+// removes them.
+type zoneVanityNSPayload struct {
+	VanityNS []string `json:"vanity_name_servers"`
+}
+
+// ZoneSetVanityNS sets custom nameservers for the zone. Passing no
+// nameservers removes them. Use ValidateZoneVanityNameservers beforehand to
+// check that nameservers within the zone have glue records.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-patch
 func (api *API) ZoneSetVanityNS(ctx context.Context, zoneID string, ns []string) (Zone, error) {
-	zoneopts := ZoneOptions{VanityNS: ns}
-	zone, err := api.EditZone(ctx, zoneID, zoneopts)
+	if ns == nil {
+		ns = []string{}
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, "/zones/"+zoneID, zoneVanityNSPayload{VanityNS: ns})
 	if err != nil {
 		return Zone{}, err
 	}
 
-	return zone, nil
+	var r ZoneResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Zone{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
 }
 
 // ZoneSetPlan sets the rate plan of an existing zone.
@@ -38,6 +38,12 @@ type DeleteZoneHoldParams struct {
 
 type GetZoneHoldParams struct{}
 
+// UpdateZoneHoldParams represents params for the Update Zone Hold endpoint.
+type UpdateZoneHoldParams struct {
+	IncludeSubdomains *bool      `json:"include_subdomains,omitempty"`
+	HoldAfter         *time.Time `json:"hold_after,omitempty"`
+}
+
 // CreateZoneHold enforces a zone hold on the zone, blocking the creation and
 // activation of zone.
 //
@@ -47,6 +53,10 @@ func (api *API) CreateZoneHold(ctx context.Context, rc *ResourceContainer, param
 		return ZoneHold{}, ErrRequiredZoneLevelResourceContainer
 	}
 
+	if rc.Identifier == "" {
+		return ZoneHold{}, ErrMissingZoneID
+	}
+
 	uri := buildURI(fmt.Sprintf("/zones/%s/hold", rc.Identifier), params)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
 	if err != nil {
@@ -65,12 +75,16 @@ func (api *API) CreateZoneHold(ctx context.Context, rc *ResourceContainer, param
 // DeleteZoneHold removes enforcement of a zone hold on the zone, permanently or
 // temporarily, allowing the creation and activation of zones with this hostname.
 //
-// API reference:https://developers.cloudflare.com/api/operations/zones-0-hold-delete
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-hold-delete
 func (api *API) DeleteZoneHold(ctx context.Context, rc *ResourceContainer, params DeleteZoneHoldParams) (ZoneHold, error) {
 	if rc.Level != ZoneRouteLevel {
 		return ZoneHold{}, ErrRequiredZoneLevelResourceContainer
 	}
 
+	if rc.Identifier == "" {
+		return ZoneHold{}, ErrMissingZoneID
+	}
+
 	uri := buildURI(fmt.Sprintf("/zones/%s/hold", rc.Identifier), params)
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
@@ -86,6 +100,34 @@ func (api *API) DeleteZoneHold(ctx context.Context, rc *ResourceContainer, param
 	return response.Result, nil
 }
 
+// UpdateZoneHold changes whether an existing zone hold covers subdomains and
+// when a temporarily removed hold is re-enforced.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-hold-patch
+func (api *API) UpdateZoneHold(ctx context.Context, rc *ResourceContainer, params UpdateZoneHoldParams) (ZoneHold, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ZoneHold{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ZoneHold{}, ErrMissingZoneID
+	}
+
+	uri := fmt.Sprintf("/zones/%s/hold", rc.Identifier)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	if err != nil {
+		return ZoneHold{}, err
+	}
+
+	response := &ZoneHoldResponse{}
+	err = json.Unmarshal(res, &response)
+	if err != nil {
+		return ZoneHold{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return response.Result, nil
+}
+
 // GetZoneHold retrieves whether the zone is subject to a zone hold, and the
 // metadata about the hold.
 //
@@ -95,6 +137,10 @@ func (api *API) GetZoneHold(ctx context.Context, rc *ResourceContainer, params G
 		return ZoneHold{}, ErrRequiredZoneLevelResourceContainer
 	}
 
+	if rc.Identifier == "" {
+		return ZoneHold{}, ErrMissingZoneID
+	}
+
 	uri := fmt.Sprintf("/zones/%s/hold", rc.Identifier)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
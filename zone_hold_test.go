@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -122,3 +123,43 @@ func TestGetZoneHold(t *testing.T) {
 		assert.Equal(t, want, out)
 	}
 }
+
+func TestUpdateZoneHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/zones/%s/hold", testZoneID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"include_subdomains":true,"hold_after":"2023-03-20T15:12:32Z"}`, string(body))
+		fmt.Fprint(w, `
+	{
+	  "success": true,
+	  "errors": [],
+	  "messages": [],
+	  "result": {
+		"hold": true,
+		"hold_after": "2023-03-20T15:12:32Z",
+		"include_subdomains": true
+	  }
+	}`)
+	})
+
+	_, err := client.UpdateZoneHold(context.Background(), ZoneIdentifier(""), UpdateZoneHoldParams{})
+	assert.Equal(t, ErrMissingZoneID, err)
+
+	holdAfter, _ := time.Parse(time.RFC3339Nano, "2023-03-20T15:12:32Z")
+	out, err := client.UpdateZoneHold(context.Background(), ZoneIdentifier(testZoneID), UpdateZoneHoldParams{
+		IncludeSubdomains: BoolPtr(true),
+		HoldAfter:         &holdAfter,
+	})
+	want := ZoneHold{
+		IncludeSubdomains: BoolPtr(true),
+		Hold:              BoolPtr(true),
+		HoldAfter:         &holdAfter,
+	}
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, out)
+	}
+}
@@ -0,0 +1,217 @@
+package cloudflare
+
+import (
+	"context"
+)
+
+// TypedZoneSettings holds commonly used zone settings with their Go types.
+// When reading, a nil field means the setting was not returned for the zone
+// or has a value the field cannot hold; when updating, a nil field is left
+// unchanged. String settings are not checked and accept any value the API
+// does.
+type TypedZoneSettings struct {
+	// SSL is the SSL/TLS encryption mode, such as "off", "flexible", "full"
+	// or "strict".
+	SSL *string
+
+	// MinTLSVersion is the minimum TLS version accepted from visitors, such
+	// as "1.0", "1.1", "1.2" or "1.3".
+	MinTLSVersion *string
+
+	// TLS13 is, for example, "on", "off" or "zrt" (TLS 1.3 with 0-RTT
+	// session resumption).
+	TLS13 *string
+
+	// SecurityLevel is, for example, "off", "essentially_off", "low",
+	// "medium", "high" or "under_attack".
+	SecurityLevel *string
+
+	AlwaysUseHTTPS          *bool
+	AutomaticHTTPSRewrites  *bool
+	HTTP3                   *bool
+	ZeroRTT                 *bool
+	Brotli                  *bool
+	IPv6                    *bool
+	WebSockets              *bool
+	OpportunisticEncryption *bool
+
+	// BrowserCacheTTL is the browser cache TTL in seconds, 0 respecting the
+	// origin's headers.
+	BrowserCacheTTL *int
+}
+
+// zoneSettingField maps a TypedZoneSettings field to its zone setting ID.
+type zoneSettingField struct {
+	id string
+
+	// value returns the API value of the field, or nil if it is unset.
+	value func(*TypedZoneSettings) interface{}
+
+	// set stores an API value in the field, skipping values of an
+	// unexpected type.
+	set func(*TypedZoneSettings, interface{})
+}
+
+func stringZoneSettingField(id string, field func(*TypedZoneSettings) **string) zoneSettingField {
+	return zoneSettingField{
+		id: id,
+		value: func(s *TypedZoneSettings) interface{} {
+			if v := *field(s); v != nil {
+				return *v
+			}
+			return nil
+		},
+		set: func(s *TypedZoneSettings, v interface{}) {
+			if str, ok := v.(string); ok {
+				*field(s) = &str
+			}
+		},
+	}
+}
+
+func onOffZoneSettingField(id string, field func(*TypedZoneSettings) **bool) zoneSettingField {
+	return zoneSettingField{
+		id: id,
+		value: func(s *TypedZoneSettings) interface{} {
+			if v := *field(s); v != nil {
+				if *v {
+					return "on"
+				}
+				return "off"
+			}
+			return nil
+		},
+		set: func(s *TypedZoneSettings, v interface{}) {
+			if str, ok := v.(string); ok && (str == "on" || str == "off") {
+				b := str == "on"
+				*field(s) = &b
+			}
+		},
+	}
+}
+
+func intZoneSettingField(id string, field func(*TypedZoneSettings) **int) zoneSettingField {
+	return zoneSettingField{
+		id: id,
+		value: func(s *TypedZoneSettings) interface{} {
+			if v := *field(s); v != nil {
+				return *v
+			}
+			return nil
+		},
+		set: func(s *TypedZoneSettings, v interface{}) {
+			var i int
+			switch n := v.(type) {
+			case float64:
+				i = int(n)
+			case int:
+				i = n
+			case int64:
+				i = int(n)
+			case uint64:
+				i = int(n)
+			default:
+				return
+			}
+			*field(s) = &i
+		},
+	}
+}
+
+var typedZoneSettingFields = []zoneSettingField{
+	stringZoneSettingField("ssl", func(s *TypedZoneSettings) **string { return &s.SSL }),
+	stringZoneSettingField("min_tls_version", func(s *TypedZoneSettings) **string { return &s.MinTLSVersion }),
+	stringZoneSettingField("tls_1_3", func(s *TypedZoneSettings) **string { return &s.TLS13 }),
+	stringZoneSettingField("security_level", func(s *TypedZoneSettings) **string { return &s.SecurityLevel }),
+	onOffZoneSettingField("always_use_https", func(s *TypedZoneSettings) **bool { return &s.AlwaysUseHTTPS }),
+	onOffZoneSettingField("automatic_https_rewrites", func(s *TypedZoneSettings) **bool { return &s.AutomaticHTTPSRewrites }),
+	onOffZoneSettingField("http3", func(s *TypedZoneSettings) **bool { return &s.HTTP3 }),
+	onOffZoneSettingField("0rtt", func(s *TypedZoneSettings) **bool { return &s.ZeroRTT }),
+	onOffZoneSettingField("brotli", func(s *TypedZoneSettings) **bool { return &s.Brotli }),
+	onOffZoneSettingField("ipv6", func(s *TypedZoneSettings) **bool { return &s.IPv6 }),
+	onOffZoneSettingField("websockets", func(s *TypedZoneSettings) **bool { return &s.WebSockets }),
+	onOffZoneSettingField("opportunistic_encryption", func(s *TypedZoneSettings) **bool { return &s.OpportunisticEncryption }),
+	intZoneSettingField("browser_cache_ttl", func(s *TypedZoneSettings) **int { return &s.BrowserCacheTTL }),
+}
+
+// typedZoneSettingsFrom converts zone settings to TypedZoneSettings,
+// ignoring settings it has no field for.
+func typedZoneSettingsFrom(settings []ZoneSetting) TypedZoneSettings {
+	byID := make(map[string]interface{}, len(settings))
+	for _, s := range settings {
+		byID[s.ID] = s.Value
+	}
+
+	var typed TypedZoneSettings
+	for _, f := range typedZoneSettingFields {
+		v, ok := byID[f.id]
+		if !ok || v == nil {
+			continue
+		}
+		f.set(&typed, v)
+	}
+
+	return typed
+}
+
+// GetTypedZoneSettings returns the settings of a zone covered by
+// TypedZoneSettings.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-get-all-zone-settings
+func (api *API) GetTypedZoneSettings(ctx context.Context, rc *ResourceContainer) (TypedZoneSettings, error) {
+	if rc.Level != ZoneRouteLevel {
+		return TypedZoneSettings{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return TypedZoneSettings{}, ErrMissingZoneID
+	}
+
+	res, err := api.ZoneSettings(ctx, rc.Identifier)
+	if err != nil {
+		return TypedZoneSettings{}, err
+	}
+
+	return typedZoneSettingsFrom(res.Result), nil
+}
+
+// BulkUpdateTypedZoneSettings compares the non-nil fields of settings with
+// the zone's current settings and changes those that differ in a single
+// request. No request is made if nothing differs. The resulting settings are
+// returned.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zone-settings-edit-zone-settings-info
+func (api *API) BulkUpdateTypedZoneSettings(ctx context.Context, rc *ResourceContainer, settings TypedZoneSettings) (TypedZoneSettings, error) {
+	current, err := api.GetTypedZoneSettings(ctx, rc)
+	if err != nil {
+		return TypedZoneSettings{}, err
+	}
+
+	var changed []ZoneSetting
+	for _, f := range typedZoneSettingFields {
+		desired := f.value(&settings)
+		if desired == nil || desired == f.value(&current) {
+			continue
+		}
+		changed = append(changed, ZoneSetting{ID: f.id, Value: desired})
+	}
+
+	if len(changed) == 0 {
+		return current, nil
+	}
+
+	res, err := api.UpdateZoneSettings(ctx, rc.Identifier, changed)
+	if err != nil {
+		return TypedZoneSettings{}, err
+	}
+
+	// The response only includes the changed settings.
+	updated := typedZoneSettingsFrom(res.Result)
+	for _, f := range typedZoneSettingFields {
+		if v := f.value(&updated); v != nil {
+			f.set(&current, v)
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,134 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+const typedZoneSettingsJSON = `{
+	"success": true,
+	"errors": [],
+	"messages": [],
+	"result": [
+		{"id": "ssl", "value": "full", "editable": true},
+		{"id": "min_tls_version", "value": "1.0", "editable": true},
+		{"id": "http3", "value": "off", "editable": true},
+		{"id": "0rtt", "value": "on", "editable": true},
+		{"id": "browser_cache_ttl", "value": 14400, "editable": true},
+		{"id": "rocket_loader", "value": "off", "editable": true}
+	]
+}`
+
+func TestGetTypedZoneSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, typedZoneSettingsJSON)
+	})
+
+	want := TypedZoneSettings{
+		SSL:             StringPtr("full"),
+		MinTLSVersion:   StringPtr("1.0"),
+		HTTP3:           BoolPtr(false),
+		ZeroRTT:         BoolPtr(true),
+		BrowserCacheTTL: IntPtr(14400),
+	}
+
+	actual, err := client.GetTypedZoneSettings(context.Background(), ZoneIdentifier(testZoneID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.GetTypedZoneSettings(context.Background(), AccountIdentifier(testAccountID))
+	assert.Equal(t, ErrRequiredZoneLevelResourceContainer, err)
+}
+
+func TestBulkUpdateTypedZoneSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, typedZoneSettingsJSON)
+			return
+		}
+
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		var req struct {
+			Items []ZoneSetting `json:"items"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, []ZoneSetting{
+			{ID: "min_tls_version", Value: "1.2"},
+			{ID: "http3", Value: "on"},
+		}, req.Items)
+
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "min_tls_version", "value": "1.2", "editable": true},
+				{"id": "http3", "value": "on", "editable": true}
+			]
+		}`)
+	})
+
+	actual, err := client.BulkUpdateTypedZoneSettings(context.Background(), ZoneIdentifier(testZoneID), TypedZoneSettings{
+		SSL:           StringPtr("full"),
+		MinTLSVersion: StringPtr("1.2"),
+		HTTP3:         BoolPtr(true),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, TypedZoneSettings{
+			SSL:             StringPtr("full"),
+			MinTLSVersion:   StringPtr("1.2"),
+			HTTP3:           BoolPtr(true),
+			ZeroRTT:         BoolPtr(true),
+			BrowserCacheTTL: IntPtr(14400),
+		}, actual)
+	}
+}
+
+func TestBulkUpdateTypedZoneSettingsUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, typedZoneSettingsJSON)
+	})
+
+	actual, err := client.BulkUpdateTypedZoneSettings(context.Background(), ZoneIdentifier(testZoneID), TypedZoneSettings{
+		SSL:             StringPtr("full"),
+		BrowserCacheTTL: IntPtr(14400),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "full", *actual.SSL)
+	}
+}
+
+func TestTypedZoneSettingsFromUnknownValues(t *testing.T) {
+	actual := typedZoneSettingsFrom([]ZoneSetting{
+		{ID: "ssl", Value: "origin_pull"},
+		{ID: "min_tls_version", Value: "1.4"},
+		{ID: "http3", Value: "auto"},
+		{ID: "browser_cache_ttl", Value: "respect"},
+	})
+	assert.Equal(t, TypedZoneSettings{
+		SSL:           StringPtr("origin_pull"),
+		MinTLSVersion: StringPtr("1.4"),
+	}, actual)
+}
@@ -0,0 +1,210 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Kinds of configuration compared by DiffZoneConfigSnapshots.
+const (
+	ZoneConfigKindSetting   = "setting"
+	ZoneConfigKindRuleset   = "ruleset"
+	ZoneConfigKindDNSRecord = "dns_record"
+)
+
+// ZoneConfigChangeType is how a piece of configuration changed between two
+// snapshots.
+type ZoneConfigChangeType string
+
+// Types of ZoneConfigChange.
+const (
+	ZoneConfigChangeAdded    ZoneConfigChangeType = "added"
+	ZoneConfigChangeRemoved  ZoneConfigChangeType = "removed"
+	ZoneConfigChangeModified ZoneConfigChangeType = "modified"
+)
+
+// ZoneConfigSnapshot is the configuration of a zone at a point in time. It
+// omits fields that change without the configuration changing, such as
+// modification times and ruleset versions, so that snapshots of an
+// unchanged zone are equal.
+type ZoneConfigSnapshot struct {
+	ZoneID  string    `json:"zone_id"`
+	TakenAt time.Time `json:"taken_at"`
+
+	// Settings holds the value of each zone setting by ID.
+	Settings map[string]interface{} `json:"settings"`
+
+	// Rulesets holds the zone's rulesets by ID.
+	Rulesets map[string]Ruleset `json:"rulesets"`
+
+	// DNSRecords holds the zone's DNS records by ID.
+	DNSRecords map[string]DNSRecord `json:"dns_records"`
+}
+
+// ZoneConfigChange is a difference between two zone snapshots. Before is
+// nil for added configuration and After is nil for removed configuration.
+type ZoneConfigChange struct {
+	Kind   string
+	Key    string
+	Type   ZoneConfigChangeType
+	Before interface{}
+	After  interface{}
+}
+
+// GetZoneConfigSnapshot takes a snapshot of the settings, rulesets and DNS
+// records of a zone.
+func (api *API) GetZoneConfigSnapshot(ctx context.Context, rc *ResourceContainer) (ZoneConfigSnapshot, error) {
+	if rc.Level != ZoneRouteLevel {
+		return ZoneConfigSnapshot{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ZoneConfigSnapshot{}, ErrMissingZoneID
+	}
+
+	snapshot := ZoneConfigSnapshot{
+		ZoneID:     rc.Identifier,
+		TakenAt:    time.Now().UTC(),
+		Settings:   make(map[string]interface{}),
+		Rulesets:   make(map[string]Ruleset),
+		DNSRecords: make(map[string]DNSRecord),
+	}
+
+	settings, err := api.ZoneSettings(ctx, rc.Identifier)
+	if err != nil {
+		return ZoneConfigSnapshot{}, fmt.Errorf("listing zone settings: %w", err)
+	}
+	for _, s := range settings.Result {
+		snapshot.Settings[s.ID] = s.Value
+	}
+
+	rulesets, err := api.ListRulesets(ctx, rc, ListRulesetsParams{})
+	if err != nil {
+		return ZoneConfigSnapshot{}, fmt.Errorf("listing rulesets: %w", err)
+	}
+	for _, r := range rulesets {
+		// Managed rulesets are listed too, but are not part of the zone's
+		// configuration.
+		if r.Kind != string(RulesetKindZone) {
+			continue
+		}
+
+		ruleset, err := api.GetRuleset(ctx, rc, r.ID)
+		if err != nil {
+			return ZoneConfigSnapshot{}, fmt.Errorf("getting ruleset %s: %w", r.ID, err)
+		}
+
+		ruleset.Version = nil
+		ruleset.LastUpdated = nil
+		for i := range ruleset.Rules {
+			ruleset.Rules[i].Version = nil
+			ruleset.Rules[i].LastUpdated = nil
+		}
+		snapshot.Rulesets[ruleset.ID] = ruleset
+	}
+
+	records, _, err := api.ListDNSRecords(ctx, rc, ListDNSRecordsParams{})
+	if err != nil {
+		return ZoneConfigSnapshot{}, fmt.Errorf("listing DNS records: %w", err)
+	}
+	for _, r := range records {
+		r.CreatedOn = time.Time{}
+		r.ModifiedOn = time.Time{}
+		r.Meta = nil
+		snapshot.DNSRecords[r.ID] = r
+	}
+
+	return snapshot, nil
+}
+
+// MarshalCanonical encodes the snapshot as indented JSON with sorted keys,
+// so that snapshots of the same configuration encode identically apart from
+// TakenAt.
+func (s ZoneConfigSnapshot) MarshalCanonical() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// DiffZoneConfigSnapshots compares two snapshots of a zone and returns the
+// settings, rulesets and DNS records added, removed or modified between
+// them, ordered by kind and key.
+func DiffZoneConfigSnapshots(before, after ZoneConfigSnapshot) ([]ZoneConfigChange, error) {
+	var changes []ZoneConfigChange
+
+	add := func(kind string, b, a map[string]interface{}) error {
+		keys := make(map[string]bool, len(b)+len(a))
+		for k := range b {
+			keys[k] = true
+		}
+		for k := range a {
+			keys[k] = true
+		}
+
+		for k := range keys {
+			bv, inBefore := b[k]
+			av, inAfter := a[k]
+
+			switch {
+			case !inBefore:
+				changes = append(changes, ZoneConfigChange{Kind: kind, Key: k, Type: ZoneConfigChangeAdded, After: av})
+			case !inAfter:
+				changes = append(changes, ZoneConfigChange{Kind: kind, Key: k, Type: ZoneConfigChangeRemoved, Before: bv})
+			default:
+				equal, err := jsonEqual(bv, av)
+				if err != nil {
+					return fmt.Errorf("comparing %s %s: %w", kind, k, err)
+				}
+				if !equal {
+					changes = append(changes, ZoneConfigChange{Kind: kind, Key: k, Type: ZoneConfigChangeModified, Before: bv, After: av})
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := add(ZoneConfigKindSetting, before.Settings, after.Settings); err != nil {
+		return nil, err
+	}
+	if err := add(ZoneConfigKindRuleset, toInterfaceMap(before.Rulesets), toInterfaceMap(after.Rulesets)); err != nil {
+		return nil, err
+	}
+	if err := add(ZoneConfigKindDNSRecord, toInterfaceMap(before.DNSRecords), toInterfaceMap(after.DNSRecords)); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes, nil
+}
+
+func toInterfaceMap[T any](m map[string]T) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// jsonEqual reports whether a and b encode to the same JSON, which treats
+// values decoded from JSON and their typed equivalents alike.
+func jsonEqual(a, b interface{}) (bool, error) {
+	aj, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bj, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aj, bj), nil
+}
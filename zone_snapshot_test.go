@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetZoneConfigSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": [
+				{"id": "ssl", "value": "strict", "editable": true, "modified_on": "2023-01-01T00:00:00Z"},
+				{"id": "browser_cache_ttl", "value": 14400, "editable": true}
+			]
+		}`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": [
+				{"id": "2f2feab2026849078ba485f918791bdc", "name": "zone", "kind": "zone", "phase": "http_request_firewall_custom"},
+				{"id": "efb7b8c949ac4650a09736fc376e9aee", "name": "Cloudflare Managed Ruleset", "kind": "managed", "phase": "http_request_firewall_managed"}
+			]
+		}`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/2f2feab2026849078ba485f918791bdc", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": {
+				"id": "2f2feab2026849078ba485f918791bdc",
+				"name": "zone",
+				"kind": "zone",
+				"version": "3",
+				"last_updated": "2023-01-01T00:00:00Z",
+				"phase": "http_request_firewall_custom",
+				"rules": [{"id": "62449e2e0de149619edb35e59c10d801", "version": "1", "action": "block", "expression": "ip.src eq 192.0.2.1", "last_updated": "2023-01-01T00:00:00Z"}]
+			}
+		}`)
+	})
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true, "errors": [], "messages": [],
+			"result": [
+				{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "example.com", "content": "198.51.100.4", "ttl": 1, "proxied": true, "modified_on": "2023-01-01T00:00:00Z"}
+			],
+			"result_info": {"count": 1, "page": 1, "per_page": 100, "total_count": 1, "total_pages": 1}
+		}`)
+	})
+
+	snapshot, err := client.GetZoneConfigSnapshot(context.Background(), ZoneIdentifier(testZoneID))
+	require.NoError(t, err)
+
+	assert.Equal(t, "strict", snapshot.Settings["ssl"])
+	assert.Len(t, snapshot.Rulesets, 1)
+	ruleset := snapshot.Rulesets["2f2feab2026849078ba485f918791bdc"]
+	assert.Nil(t, ruleset.Version)
+	assert.Nil(t, ruleset.LastUpdated)
+	assert.Nil(t, ruleset.Rules[0].Version)
+	assert.True(t, snapshot.DNSRecords["372e67954025e0ba6aaa6d586b9e0b59"].ModifiedOn.IsZero())
+
+	b, err := snapshot.MarshalCanonical()
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "2023-01-01")
+
+	_, err = client.GetZoneConfigSnapshot(context.Background(), AccountIdentifier(testAccountID))
+	assert.Equal(t, ErrRequiredZoneLevelResourceContainer, err)
+}
+
+func TestDiffZoneConfigSnapshots(t *testing.T) {
+	before := ZoneConfigSnapshot{
+		Settings: map[string]interface{}{"ssl": "full", "http3": "on", "browser_cache_ttl": float64(14400)},
+		Rulesets: map[string]Ruleset{
+			"r1": {ID: "r1", Rules: []RulesetRule{{Action: "block", Expression: "ip.src eq 192.0.2.1"}}},
+		},
+		DNSRecords: map[string]DNSRecord{
+			"d1": {ID: "d1", Type: "A", Name: "example.com", Content: "198.51.100.4"},
+			"d2": {ID: "d2", Type: "CNAME", Name: "www.example.com", Content: "example.com"},
+		},
+	}
+
+	after := ZoneConfigSnapshot{
+		Settings: map[string]interface{}{"ssl": "strict", "http3": "on", "browser_cache_ttl": 14400},
+		Rulesets: map[string]Ruleset{
+			"r1": {ID: "r1", Rules: []RulesetRule{{Action: "block", Expression: "ip.src eq 192.0.2.2"}}},
+		},
+		DNSRecords: map[string]DNSRecord{
+			"d1": {ID: "d1", Type: "A", Name: "example.com", Content: "198.51.100.4"},
+			"d3": {ID: "d3", Type: "TXT", Name: "example.com", Content: "v=spf1 -all"},
+		},
+	}
+
+	changes, err := DiffZoneConfigSnapshots(before, after)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ZoneConfigChange{
+		{Kind: ZoneConfigKindDNSRecord, Key: "d2", Type: ZoneConfigChangeRemoved, Before: before.DNSRecords["d2"]},
+		{Kind: ZoneConfigKindDNSRecord, Key: "d3", Type: ZoneConfigChangeAdded, After: after.DNSRecords["d3"]},
+		{Kind: ZoneConfigKindRuleset, Key: "r1", Type: ZoneConfigChangeModified, Before: before.Rulesets["r1"], After: after.Rulesets["r1"]},
+		{Kind: ZoneConfigKindSetting, Key: "ssl", Type: ZoneConfigChangeModified, Before: "full", After: "strict"},
+	}, changes)
+
+	changes, err = DiffZoneConfigSnapshots(before, before)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListZoneSummaries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("content-type", "application/json")
+
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "zone-%s",
+				"name": "example-%s.com",
+				"status": "active",
+				"paused": false,
+				"type": "full",
+				"account": {"id": "%s", "name": "Example"},
+				"name_servers": ["ns1.cloudflare.com"],
+				"meta": {"page_rule_quota": 3}
+			}],
+			"result_info": {"page": %s, "per_page": 50, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page, page, testAccountID, page)
+	}
+
+	mux.HandleFunc("/zones", handler)
+
+	zones, err := client.ListZoneSummaries(context.Background(), WithZoneFilters("", "", "active"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ZoneSummary{
+			{ID: "zone-1", Name: "example-1.com", Status: "active", Type: "full", AccountID: testAccountID},
+			{ID: "zone-2", Name: "example-2.com", Status: "active", Type: "full", AccountID: testAccountID},
+		}, zones)
+	}
+
+	_, err = client.ListZoneSummaries(context.Background(), WithPagination(PaginationOptions{Page: 2}))
+	assert.EqualError(t, err, errManualPagination)
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrMissingVanityNameservers = errors.New("at least two vanity nameservers are required")
+
+// GetZoneVanityNameservers returns the vanity nameservers of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/zones-0-get
+func (api *API) GetZoneVanityNameservers(ctx context.Context, rc *ResourceContainer) ([]string, error) {
+	if rc.Level != ZoneRouteLevel {
+		return []string{}, ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return []string{}, ErrMissingZoneID
+	}
+
+	zone, err := api.ZoneDetails(ctx, rc.Identifier)
+	if err != nil {
+		return []string{}, err
+	}
+
+	return zone.VanityNS, nil
+}
+
+// ValidateZoneVanityNameservers checks vanity nameservers before they are
+// set with ZoneSetVanityNS: at least two distinct hostnames are required,
+// and nameservers within the zone itself need glue, so each of them must
+// have an A or AAAA record in the zone. No nameservers are valid, as
+// setting none removes the vanity nameservers.
+//
+// Zones using account custom nameservers are configured with
+// UpdateCustomNameserverZoneMetadata instead.
+func (api *API) ValidateZoneVanityNameservers(ctx context.Context, rc *ResourceContainer, nameservers []string) error {
+	if rc.Level != ZoneRouteLevel {
+		return ErrRequiredZoneLevelResourceContainer
+	}
+
+	if rc.Identifier == "" {
+		return ErrMissingZoneID
+	}
+
+	if len(nameservers) == 0 {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(nameservers))
+	for _, ns := range nameservers {
+		ns = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(ns), "."))
+		if ns == "" || !strings.Contains(ns, ".") {
+			return fmt.Errorf("invalid vanity nameserver %q", ns)
+		}
+		if contains(normalized, ns) {
+			return fmt.Errorf("duplicate vanity nameserver %q", ns)
+		}
+		normalized = append(normalized, ns)
+	}
+
+	if len(normalized) == 1 {
+		return ErrMissingVanityNameservers
+	}
+
+	return api.checkVanityNameserverGlue(ctx, rc, normalized)
+}
+
+// checkVanityNameserverGlue checks that nameservers within the zone have
+// address records the glue at the registrar can point to.
+func (api *API) checkVanityNameserverGlue(ctx context.Context, rc *ResourceContainer, nameservers []string) error {
+	zone, err := api.ZoneDetails(ctx, rc.Identifier)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, ns := range nameservers {
+		if ns != zone.Name && !strings.HasSuffix(ns, "."+zone.Name) {
+			continue
+		}
+
+		records, _, err := api.ListDNSRecords(ctx, rc, ListDNSRecordsParams{Name: ns})
+		if err != nil {
+			return err
+		}
+
+		hasAddress := false
+		for _, r := range records {
+			if r.Type == "A" || r.Type == "AAAA" {
+				hasAddress = true
+				break
+			}
+		}
+
+		if !hasAddress {
+			missing = append(missing, ns)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("vanity nameservers missing A or AAAA records in zone %s: %s", zone.Name, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
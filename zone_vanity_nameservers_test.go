@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateZoneVanityNameservers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "%s", "name": "example.com", "vanity_name_servers": []}
+		}`, testZoneID)
+	})
+
+	glue := `[]`
+	mux.HandleFunc("/zones/"+testZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ns1.example.com", r.URL.Query().Get("name"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": %s,
+			"result_info": {"page": 1, "per_page": 100, "count": 1, "total_count": 1, "total_pages": 1}
+		}`, glue)
+	})
+
+	nameservers := []string{"NS1.example.com.", "ns2.example.net"}
+
+	err := client.ValidateZoneVanityNameservers(context.Background(), ZoneIdentifier(testZoneID), nameservers)
+	assert.EqualError(t, err, "vanity nameservers missing A or AAAA records in zone example.com: ns1.example.com")
+
+	glue = `[{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "ns1.example.com", "content": "198.51.100.4"}]`
+	err = client.ValidateZoneVanityNameservers(context.Background(), ZoneIdentifier(testZoneID), nameservers)
+	assert.NoError(t, err)
+
+	err = client.ValidateZoneVanityNameservers(context.Background(), ZoneIdentifier(testZoneID), []string{"ns1.example.com"})
+	assert.ErrorIs(t, err, ErrMissingVanityNameservers)
+
+	err = client.ValidateZoneVanityNameservers(context.Background(), ZoneIdentifier(testZoneID), nil)
+	assert.NoError(t, err)
+}
+
+func TestZoneSetVanityNS(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vanity := `["ns1.example.com", "ns2.example.net"]`
+	mux.HandleFunc("/zones/"+testZoneID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"vanity_name_servers": `+vanity+`}`, string(b))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "%s", "name": "example.com", "vanity_name_servers": %s}
+		}`, testZoneID, vanity)
+	})
+
+	zone, err := client.ZoneSetVanityNS(context.Background(), testZoneID, []string{"ns1.example.com", "ns2.example.net"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"ns1.example.com", "ns2.example.net"}, zone.VanityNS)
+	}
+
+	vanity = `[]`
+	zone, err = client.ZoneSetVanityNS(context.Background(), testZoneID, nil)
+	if assert.NoError(t, err) {
+		assert.Empty(t, zone.VanityNS)
+	}
+}
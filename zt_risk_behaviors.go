@@ -84,10 +84,17 @@ func (p RiskLevel) MarshalJSON() ([]byte, error) {
 	return json.Marshal(p.String())
 }
 
+// String returns the API name of the risk level, or "" if it is unset or
+// unknown.
 func (p RiskLevel) String() string {
+	if p < Low || p > High {
+		return ""
+	}
 	return [...]string{"low", "medium", "high"}[p-1]
 }
 
+// UnmarshalJSON decodes an API risk level. An empty or null value is the
+// zero RiskLevel.
 func (p *RiskLevel) UnmarshalJSON(data []byte) error {
 	var (
 		s   string
@@ -97,6 +104,10 @@ func (p *RiskLevel) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+	if s == "" {
+		*p = 0
+		return nil
+	}
 	v, err := RiskLevelFromString(s)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -157,3 +158,15 @@ func TestStringFromRiskLevel(t *testing.T) {
 		t.Errorf("got %#v, wanted %#v", got, want)
 	}
 }
+
+func TestRiskLevelUnset(t *testing.T) {
+	var b Behaviors
+	err := json.Unmarshal([]byte(`{"behaviors": {"a": {"risk_level": ""}, "b": {"risk_level": null}}}`), &b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, RiskLevel(0), b.Behaviors["a"].RiskLevel)
+		assert.Equal(t, RiskLevel(0), b.Behaviors["b"].RiskLevel)
+	}
+
+	assert.Equal(t, "", RiskLevel(0).String())
+	assert.Equal(t, "", RiskLevel(4).String())
+}
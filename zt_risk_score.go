@@ -0,0 +1,141 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingRiskScoreUserID = errors.New("missing required user ID")
+)
+
+// RiskScoreUserSummary is the current risk score of a single user.
+type RiskScoreUserSummary struct {
+	UserID       string     `json:"user_id"`
+	Name         string     `json:"name"`
+	Email        string     `json:"email"`
+	EventCount   int        `json:"event_count"`
+	LastEvent    *time.Time `json:"last_event,omitempty"`
+	MaxRiskLevel RiskLevel  `json:"max_risk_level"`
+}
+
+// RiskScoreEvent is a risk behavior that was observed for a user.
+type RiskScoreEvent struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	RiskLevel    RiskLevel              `json:"risk_level"`
+	Timestamp    *time.Time             `json:"timestamp,omitempty"`
+	EventDetails map[string]interface{} `json:"event_details,omitempty"`
+}
+
+// RiskScoreUserDetails contains the risk events recorded for a user.
+type RiskScoreUserDetails struct {
+	Name   string           `json:"name"`
+	Email  string           `json:"email"`
+	Events []RiskScoreEvent `json:"events"`
+}
+
+// RiskScoreSummaryResponse is the API response containing the risk score of
+// every user in an account.
+type RiskScoreSummaryResponse struct {
+	Result struct {
+		Users []RiskScoreUserSummary `json:"users"`
+	} `json:"result"`
+	Response
+}
+
+// RiskScoreUserDetailsResponse is the API response containing the risk
+// events of a user.
+type RiskScoreUserDetailsResponse struct {
+	Result RiskScoreUserDetails `json:"result"`
+	Response
+}
+
+type ListRiskScoreUsersParams struct {
+	// MinRiskLevel excludes users whose highest risk level is below it. The
+	// API does not support filtering so it is applied to the response.
+	MinRiskLevel RiskLevel
+}
+
+// ListRiskScoreUsers returns the risk score summary of all users in an
+// account.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-zt-risk-score-summary-get-for-all-users
+func (api *API) ListRiskScoreUsers(ctx context.Context, rc *ResourceContainer, params ListRiskScoreUsersParams) ([]RiskScoreUserSummary, error) {
+	if rc.Identifier == "" {
+		return []RiskScoreUserSummary{}, ErrMissingResourceIdentifier
+	}
+
+	uri := buildURI(fmt.Sprintf("/%s/%s/zt_risk_scoring/summary", rc.Level, rc.Identifier), nil)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []RiskScoreUserSummary{}, err
+	}
+
+	var summaryResponse RiskScoreSummaryResponse
+	err = json.Unmarshal(res, &summaryResponse)
+	if err != nil {
+		return []RiskScoreUserSummary{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	users := make([]RiskScoreUserSummary, 0, len(summaryResponse.Result.Users))
+	for _, u := range summaryResponse.Result.Users {
+		if u.MaxRiskLevel >= params.MinRiskLevel {
+			users = append(users, u)
+		}
+	}
+
+	return users, nil
+}
+
+// GetRiskScoreUser returns the risk events recorded for a user.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-zt-risk-score-get-risk-events
+func (api *API) GetRiskScoreUser(ctx context.Context, rc *ResourceContainer, userID string) (RiskScoreUserDetails, error) {
+	if rc.Identifier == "" {
+		return RiskScoreUserDetails{}, ErrMissingResourceIdentifier
+	}
+
+	if userID == "" {
+		return RiskScoreUserDetails{}, ErrMissingRiskScoreUserID
+	}
+
+	uri := buildURI(fmt.Sprintf("/%s/%s/zt_risk_scoring/%s", rc.Level, rc.Identifier, userID), nil)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return RiskScoreUserDetails{}, err
+	}
+
+	var detailsResponse RiskScoreUserDetailsResponse
+	err = json.Unmarshal(res, &detailsResponse)
+	if err != nil {
+		return RiskScoreUserDetails{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return detailsResponse.Result, nil
+}
+
+// ResetRiskScoreUser clears the risk score of a user.
+//
+// API reference: https://developers.cloudflare.com/api/operations/dlp-zt-risk-score-reset-post
+func (api *API) ResetRiskScoreUser(ctx context.Context, rc *ResourceContainer, userID string) error {
+	if rc.Identifier == "" {
+		return ErrMissingResourceIdentifier
+	}
+
+	if userID == "" {
+		return ErrMissingRiskScoreUserID
+	}
+
+	uri := buildURI(fmt.Sprintf("/%s/%s/zt_risk_scoring/%s/reset", rc.Level, rc.Identifier, userID), nil)
+
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	return err
+}
@@ -0,0 +1,133 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRiskScoreUsers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"users": [
+					{
+						"user_id": "f2108713-1206-4e84-8b80-0e71a6a1c67b",
+						"name": "Jane Doe",
+						"email": "jane@example.com",
+						"event_count": 3,
+						"last_event": "2024-01-10T12:00:00Z",
+						"max_risk_level": "high"
+					},
+					{
+						"user_id": "a1b2c3d4-1206-4e84-8b80-0e71a6a1c67b",
+						"name": "John Doe",
+						"email": "john@example.com",
+						"event_count": 1,
+						"last_event": "2024-01-09T12:00:00Z",
+						"max_risk_level": "low"
+					}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/summary", handler)
+
+	lastEvent, _ := time.Parse(time.RFC3339, "2024-01-10T12:00:00Z")
+	want := []RiskScoreUserSummary{{
+		UserID:       "f2108713-1206-4e84-8b80-0e71a6a1c67b",
+		Name:         "Jane Doe",
+		Email:        "jane@example.com",
+		EventCount:   3,
+		LastEvent:    &lastEvent,
+		MaxRiskLevel: High,
+	}}
+
+	actual, err := client.ListRiskScoreUsers(context.Background(), AccountIdentifier(testAccountID), ListRiskScoreUsersParams{MinRiskLevel: Medium})
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+
+	actual, err = client.ListRiskScoreUsers(context.Background(), AccountIdentifier(testAccountID), ListRiskScoreUsersParams{})
+	require.NoError(t, err)
+	assert.Len(t, actual, 2)
+}
+
+func TestGetRiskScoreUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "Jane Doe",
+				"email": "jane@example.com",
+				"events": [
+					{
+						"id": "5e0a8b8e-d0d3-4b39-9bd8-6e2b0f1f6f57",
+						"name": "Impossible travel",
+						"risk_level": "high",
+						"timestamp": "2024-01-10T12:00:00Z",
+						"event_details": { "country": "NZ" }
+					}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/f2108713-1206-4e84-8b80-0e71a6a1c67b", handler)
+
+	timestamp, _ := time.Parse(time.RFC3339, "2024-01-10T12:00:00Z")
+	want := RiskScoreUserDetails{
+		Name:  "Jane Doe",
+		Email: "jane@example.com",
+		Events: []RiskScoreEvent{{
+			ID:           "5e0a8b8e-d0d3-4b39-9bd8-6e2b0f1f6f57",
+			Name:         "Impossible travel",
+			RiskLevel:    High,
+			Timestamp:    &timestamp,
+			EventDetails: map[string]interface{}{"country": "NZ"},
+		}},
+	}
+
+	actual, err := client.GetRiskScoreUser(context.Background(), AccountIdentifier(testAccountID), "f2108713-1206-4e84-8b80-0e71a6a1c67b")
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+
+	_, err = client.GetRiskScoreUser(context.Background(), AccountIdentifier(testAccountID), "")
+	assert.ErrorIs(t, err, ErrMissingRiskScoreUserID)
+}
+
+func TestResetRiskScoreUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{ "success": true, "errors": [], "messages": [], "result": null }`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/f2108713-1206-4e84-8b80-0e71a6a1c67b/reset", handler)
+
+	err := client.ResetRiskScoreUser(context.Background(), AccountIdentifier(testAccountID), "f2108713-1206-4e84-8b80-0e71a6a1c67b")
+	assert.NoError(t, err)
+}